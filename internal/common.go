@@ -160,6 +160,7 @@ func getDownloaderForRSSImpl(rssCfg models.RSSConfig, siteName string) (download
 	}
 
 	config := downloader.NewGenericConfig(dlType, dlSetting.URL, dlSetting.Username, dlSetting.Password, dlSetting.AutoStart)
+	config.DryRun = dlSetting.DryRun
 	if dm != nil {
 		dl, err := dm.CreateFromConfig(config, dlSetting.Name)
 		if err != nil {
@@ -858,7 +859,9 @@ func downloadWorkerUnified(
 
 			// 获取种子的标签/副标题用于过滤匹配
 			detailTag := detail.GetSubTitle()
-			sizeGB := float64(detail.SizeBytes) / 1024 / 1024 / 1024
+			sizeBytes := detail.SizeBytes
+			sizeGB := float64(sizeBytes) / 1024 / 1024 / 1024
+			isOfficial := detail.IsOfficial
 
 			// Sprint 2: 'filtered' 模式通知钩子。需要详情后才能匹配（subtitle/size）
 			// 与渲染模板。复用 GetTorrentDetails 已有的站点级 PersistentRateLimiter，
@@ -867,7 +870,7 @@ func downloadWorkerUnified(
 				(rssCfg.NotifyMode == "filtered" || rssCfg.NotifyMode == "both") &&
 				filterSvc != nil && rssCfg.ID != 0 {
 				matched, rule := filterSvc.ShouldNotifyForRSSWithInput(
-					filter.MatchInput{Title: title, Tag: detailTag, SizeGB: sizeGB},
+					filter.MatchInput{Title: title, Tag: detailTag, Subtitle: detailTag, SizeGB: sizeGB, SizeBytes: sizeBytes, IsOfficial: isOfficial},
 					isFree, rssCfg.ID,
 				)
 				if matched {
@@ -891,7 +894,7 @@ func downloadWorkerUnified(
 			var decision filter.Decision
 			if filterSvc != nil && rssCfg.ID != 0 && hasAssociatedRules {
 				decision = filterSvc.Decide(filter.DecisionContext{
-					Input:      filter.MatchInput{Title: title, Tag: detailTag, SizeGB: sizeGB},
+					Input:      filter.MatchInput{Title: title, Tag: detailTag, Subtitle: detailTag, SizeGB: sizeGB, SizeBytes: sizeBytes, IsOfficial: isOfficial},
 					IsFree:     isFree,
 					CanFinish:  canFinished,
 					GlobalSize: gl.TorrentSizeGB,
@@ -899,7 +902,7 @@ func downloadWorkerUnified(
 				}, rssCfg.ID)
 			} else {
 				decision = filter.DecideWithoutRules(filter.DecisionContext{
-					Input:      filter.MatchInput{Title: title, Tag: detailTag, SizeGB: sizeGB},
+					Input:      filter.MatchInput{Title: title, Tag: detailTag, Subtitle: detailTag, SizeGB: sizeGB, SizeBytes: sizeBytes, IsOfficial: isOfficial},
 					IsFree:     isFree,
 					CanFinish:  canFinished,
 					GlobalSize: gl.TorrentSizeGB,
@@ -1483,12 +1486,17 @@ func downloadWorker[T models.ResType](
 
 			// 获取种子的标签/副标题用于过滤匹配
 			detailTag := detail.GetSubTitle()
-			sizeGB := float64(detail.GetSizeBytes()) / 1024 / 1024 / 1024
+			sizeBytes := detail.GetSizeBytes()
+			sizeGB := float64(sizeBytes) / 1024 / 1024 / 1024
+			isOfficial := false
+			if oc, ok := any(detail).(models.OfficialChecker); ok {
+				isOfficial = oc.IsOfficialUpload()
+			}
 
 			var decision filter.Decision
 			if filterSvc != nil && rssCfg.ID != 0 && hasAssociatedRules {
 				decision = filterSvc.Decide(filter.DecisionContext{
-					Input:      filter.MatchInput{Title: title, Tag: detailTag, SizeGB: sizeGB},
+					Input:      filter.MatchInput{Title: title, Tag: detailTag, Subtitle: detailTag, SizeGB: sizeGB, SizeBytes: sizeBytes, IsOfficial: isOfficial},
 					IsFree:     isFree,
 					CanFinish:  canFinished,
 					GlobalSize: gl.TorrentSizeGB,
@@ -1496,7 +1504,7 @@ func downloadWorker[T models.ResType](
 				}, rssCfg.ID)
 			} else {
 				decision = filter.DecideWithoutRules(filter.DecisionContext{
-					Input:      filter.MatchInput{Title: title, Tag: detailTag, SizeGB: sizeGB},
+					Input:      filter.MatchInput{Title: title, Tag: detailTag, Subtitle: detailTag, SizeGB: sizeGB, SizeBytes: sizeBytes, IsOfficial: isOfficial},
 					IsFree:     isFree,
 					CanFinish:  canFinished,
 					GlobalSize: gl.TorrentSizeGB,
@@ -356,6 +356,79 @@ func TestDecide_RequireFree(t *testing.T) {
 	})
 }
 
+func TestDecide_RequireOfficial(t *testing.T) {
+	db, cleanup := setupServiceTestDBWithAssociations(t)
+	defer cleanup()
+	svc := NewFilterService(db)
+	rss := createTestRSSSubscription(t, db, "rss-official")
+
+	createRuleForDecide(t, db, svc, rss.ID, &models.FilterRule{
+		Name: "official-only", Pattern: "movie", PatternType: models.PatternKeyword,
+		MatchField: models.MatchFieldBoth, RequireOfficial: true,
+		Enabled: true, Priority: 100,
+	})
+
+	tests := []struct {
+		name       string
+		isOfficial bool
+		wantDL     bool
+	}{
+		{"official upload — filter accepts", true, true},
+		{"unofficial upload — filter rejects", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := svc.Decide(DecisionContext{
+				Input:      MatchInput{Title: "movie", SizeGB: 10, IsOfficial: tt.isOfficial},
+				IsFree:     false,
+				CanFinish:  true,
+				GlobalSize: 100,
+				FilterMode: models.FilterModeFilterOnly,
+			}, rss.ID)
+			assert.Equal(t, tt.wantDL, d.ShouldDownload)
+			assert.NotNil(t, d.MatchedRule)
+			if !tt.wantDL {
+				assert.Contains(t, d.Reason, "官方")
+			}
+		})
+	}
+}
+
+func TestShouldDownload_RequireOfficial(t *testing.T) {
+	db, cleanup := setupServiceTestDBWithAssociations(t)
+	defer cleanup()
+	svc := NewFilterService(db)
+	rss := createTestRSSSubscription(t, db, "rss-official-entry")
+
+	createRuleForDecide(t, db, svc, rss.ID, &models.FilterRule{
+		Name: "official-only", Pattern: "movie", PatternType: models.PatternKeyword,
+		MatchField: models.MatchFieldBoth, RequireOfficial: true,
+		Enabled: true, Priority: 100,
+	})
+
+	for _, isOfficial := range []bool{true, false} {
+		input := MatchInput{Title: "movie", IsOfficial: isOfficial}
+
+		ok, rule := svc.ShouldDownloadWithInput(input, true, nil, nil)
+		assert.Equal(t, isOfficial, ok)
+		assert.NotNil(t, rule)
+
+		ok, rule = svc.ShouldDownloadForRSSWithInput(input, true, rss.ID)
+		assert.Equal(t, isOfficial, ok)
+		assert.NotNil(t, rule)
+
+		result := svc.(*filterService).MatchTorrentWithInput(input, true, nil, nil)
+		assert.True(t, result.Matched)
+		assert.Equal(t, isOfficial, result.ShouldDownload)
+	}
+}
+
+func TestFilterRule_MatchesOfficial(t *testing.T) {
+	assert.True(t, (&models.FilterRule{}).MatchesOfficial(false))
+	assert.True(t, (&models.FilterRule{RequireOfficial: true}).MatchesOfficial(true))
+	assert.False(t, (&models.FilterRule{RequireOfficial: true}).MatchesOfficial(false))
+}
+
 func TestDecide_FilterOnlyMode(t *testing.T) {
 	db, cleanup := setupServiceTestDBWithAssociations(t)
 	defer cleanup()
@@ -0,0 +1,89 @@
+package filter
+
+import (
+	"regexp"
+	"strings"
+)
+
+// GlobMatcher implements shell-style glob matching against the whole title.
+// Unlike WildcardMatcher, the pattern is anchored at both ends, so
+// "*.2160p.*BluRay*" only matches titles containing ".2160p." followed by
+// "BluRay", and "Movie*" only matches titles that start with "Movie".
+//   - * matches any sequence of characters (including empty)
+//   - ? matches exactly one character
+//   - \ escapes the next character, so \* and \? match a literal * or ?
+//
+// Matching is case-insensitive.
+type GlobMatcher struct {
+	pattern string
+	regex   *regexp.Regexp
+}
+
+// NewGlobMatcher creates a new GlobMatcher, compiling the glob to an
+// anchored regular expression once.
+func NewGlobMatcher(pattern string) (*GlobMatcher, error) {
+	if pattern == "" {
+		return nil, ErrEmptyPattern
+	}
+	if len(pattern) > MaxPatternLength {
+		return nil, ErrPatternTooLong
+	}
+
+	regex, err := regexp.Compile("(?is)^" + globToRegex(pattern) + "$")
+	if err != nil {
+		return nil, ErrInvalidPattern
+	}
+
+	return &GlobMatcher{
+		pattern: pattern,
+		regex:   regex,
+	}, nil
+}
+
+// globToRegex translates glob wildcards to regex, quoting everything else.
+// A trailing backslash is kept as a literal backslash.
+func globToRegex(pattern string) string {
+	var b strings.Builder
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				b.WriteString(regexp.QuoteMeta(string(runes[i])))
+			} else {
+				b.WriteString(`\\`)
+			}
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return b.String()
+}
+
+// Match returns true if the whole title matches the glob pattern.
+func (m *GlobMatcher) Match(title string) bool {
+	return m.regex.MatchString(title)
+}
+
+// Validate checks if the pattern is valid.
+func (m *GlobMatcher) Validate() error {
+	if m.pattern == "" {
+		return ErrEmptyPattern
+	}
+	return nil
+}
+
+// Pattern returns the original pattern string.
+func (m *GlobMatcher) Pattern() string {
+	return m.pattern
+}
+
+// Type returns the pattern type.
+func (m *GlobMatcher) Type() PatternType {
+	return PatternGlob
+}
@@ -0,0 +1,84 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunerpy/pt-tools/models"
+)
+
+func TestGlobMatcherUnit(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		title    string
+		expected bool
+	}{
+		{"star matches sequence", "*.2160p.*BluRay*", "Movie.2025.2160p.UHD.BluRay.REMUX", true},
+		{"star is case-insensitive", "*.2160p.*bluray*", "Movie.2025.2160P.UHD.BLURAY", true},
+		{"anchored: missing middle", "*.2160p.*BluRay*", "Movie.2025.1080p.BluRay", false},
+		{"star matches empty", "Movie*", "Movie", true},
+		{"question matches one char", "S0?E01*", "S01E01.1080p", true},
+		{"question needs exactly one", "S0?E01", "S0E01", false},
+		{"question matches multibyte rune", "第?集", "第五集", true},
+		{"leading wildcard", "*REMUX", "Movie.2160p.REMUX", true},
+		{"leading wildcard is anchored at end", "*REMUX", "Movie.REMUX.mkv", false},
+		{"trailing wildcard", "Movie.2025*", "Movie.2025.1080p", true},
+		{"trailing wildcard is anchored at start", "Movie.2025*", "The.Movie.2025", false},
+		{"no wildcard needs exact title", "Movie", "Movie.2025", false},
+		{"escaped star is literal", `Best\*Of*`, "Best*Of.2025", true},
+		{"escaped star does not match sequence", `Best\*Of*`, "BestXOf.2025", false},
+		{"escaped question is literal", `Why\?`, "Why?", true},
+		{"regex metacharacters are literal", "[HDR]+*", "[HDR]+.Movie", true},
+		{"trailing backslash is literal", `path\`, `path\`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewGlobMatcher(tt.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, m.Match(tt.title))
+		})
+	}
+}
+
+func TestGlobMatcherValidation(t *testing.T) {
+	_, err := NewGlobMatcher("")
+	assert.ErrorIs(t, err, ErrEmptyPattern)
+
+	long := make([]byte, MaxPatternLength+1)
+	for i := range long {
+		long[i] = '*'
+	}
+	_, err = NewGlobMatcher(string(long))
+	assert.ErrorIs(t, err, ErrPatternTooLong)
+
+	m, err := NewMatcher(PatternGlob, "*x*")
+	require.NoError(t, err)
+	assert.NoError(t, m.Validate())
+	assert.Equal(t, "*x*", m.Pattern())
+	assert.Equal(t, PatternGlob, m.Type())
+}
+
+func TestFilterService_GlobRule(t *testing.T) {
+	db, cleanup := setupServiceTestDB(t)
+	defer cleanup()
+
+	rule := &models.FilterRule{
+		Name:        "UHD BluRay",
+		Pattern:     "*.2160p.*BluRay*",
+		PatternType: models.PatternGlob,
+		MatchField:  models.MatchFieldTitle,
+		Enabled:     true,
+		Priority:    100,
+	}
+	require.NoError(t, db.Create(rule).Error)
+	svc := NewFilterService(db)
+
+	_, matched := svc.MatchRules("Movie.2025.2160p.UHD.BluRay.REMUX", nil, nil)
+	assert.True(t, matched)
+	_, matched = svc.MatchRules("Movie.2025.2160p.WEB-DL", nil, nil)
+	assert.False(t, matched)
+}
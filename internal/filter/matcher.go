@@ -15,6 +15,8 @@ const (
 	PatternWildcard PatternType = "wildcard"
 	// PatternRegex uses regular expressions for matching.
 	PatternRegex PatternType = "regex"
+	// PatternGlob uses shell-style globs anchored to the whole title.
+	PatternGlob PatternType = "glob"
 )
 
 // Pattern matching errors.
@@ -57,6 +59,8 @@ func NewMatcher(patternType PatternType, pattern string) (PatternMatcher, error)
 		return NewWildcardMatcher(pattern)
 	case PatternRegex:
 		return NewRegexMatcher(pattern)
+	case PatternGlob:
+		return NewGlobMatcher(pattern)
 	default:
 		return nil, ErrUnknownType
 	}
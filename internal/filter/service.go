@@ -32,8 +32,15 @@ func purposeMatches(rulePurpose string, want Purpose) bool {
 type MatchInput struct {
 	Title string
 	Tag   string
+	// Subtitle is the torrent's subtitle (副标题). Empty when unknown.
+	Subtitle string
 	// SizeGB is the torrent size in GB. Zero means unknown (skip size checks).
 	SizeGB float64
+	// SizeBytes is the torrent size in bytes, checked against the rule's
+	// MinSizeBytes / MaxSizeBytes. Zero means unknown (skip size checks).
+	SizeBytes int64
+	// IsOfficial marks an official/original upload, checked by rules with RequireOfficial.
+	IsOfficial bool
 }
 
 // DecisionContext bundles the full set of inputs required to make a download decision.
@@ -61,7 +68,7 @@ type FilterService interface {
 	MatchRules(title string, siteID, rssID *uint) (*models.FilterRule, bool)
 
 	// MatchRulesWithInput checks if input matches any enabled filter rule.
-	// Supports matching against title, tag, or both based on rule configuration.
+	// Supports matching against title, tag, subtitle, or all of them based on rule configuration.
 	MatchRulesWithInput(input MatchInput, siteID, rssID *uint) (*models.FilterRule, bool)
 
 	// MatchRulesForRSS checks if a torrent title matches any filter rule associated with the RSS.
@@ -70,7 +77,7 @@ type FilterService interface {
 	MatchRulesForRSS(title string, rssID uint) (*models.FilterRule, bool)
 
 	// MatchRulesForRSSWithInput checks if input matches any filter rule associated with the RSS.
-	// Supports matching against title, tag, or both based on rule configuration.
+	// Supports matching against title, tag, subtitle, or all of them based on rule configuration.
 	MatchRulesForRSSWithInput(input MatchInput, rssID uint) (*models.FilterRule, bool)
 
 	// ShouldDownload determines if a torrent should be downloaded based on filter rules.
@@ -190,14 +197,22 @@ func (s *filterService) matchesInput(matcher PatternMatcher, rule *models.Filter
 		return matcher.Match(input.Title)
 	case models.MatchFieldTag:
 		return matcher.Match(input.Tag)
+	case models.MatchFieldSubtitle:
+		return input.Subtitle != "" && matcher.Match(input.Subtitle)
 	case models.MatchFieldBoth:
-		return matcher.Match(input.Title) || matcher.Match(input.Tag)
+		return matchesAnyField(matcher, input)
 	default:
 		// Default to both for unknown values
-		return matcher.Match(input.Title) || matcher.Match(input.Tag)
+		return matchesAnyField(matcher, input)
 	}
 }
 
+// matchesAnyField checks the title, tag and (when known) subtitle.
+func matchesAnyField(matcher PatternMatcher, input MatchInput) bool {
+	return matcher.Match(input.Title) || matcher.Match(input.Tag) ||
+		(input.Subtitle != "" && matcher.Match(input.Subtitle))
+}
+
 // MatchRulesForRSS checks if a torrent title matches any filter rule associated with the RSS.
 func (s *filterService) MatchRulesForRSS(title string, rssID uint) (*models.FilterRule, bool) {
 	// For backward compatibility, use title-only matching
@@ -258,17 +273,31 @@ func (s *filterService) ShouldDownload(title string, isFree bool, siteID, rssID
 }
 
 // ShouldDownloadWithInput determines if a torrent should be downloaded based on filter rules.
+// A notify-only rule match is reported with false so callers can still notify.
 func (s *filterService) ShouldDownloadWithInput(input MatchInput, isFree bool, siteID, rssID *uint) (bool, *models.FilterRule) {
-	rule, matched := s.MatchRulesWithInput(input, siteID, rssID)
+	rule, action, matched := s.matchRuleWithAction(input, siteID, rssID)
 	if !matched {
 		return false, nil
 	}
+	if action == PurposeNotify {
+		return false, rule
+	}
 
 	// If rule requires free, check free status
 	if rule.RequireFree && !isFree {
 		return false, rule
 	}
 
+	// A size outside the rule's byte range matches but does not download
+	if !rule.MatchesSizeBytes(input.SizeBytes) {
+		return false, rule
+	}
+
+	// Rules requiring an official upload match but do not download others
+	if !rule.MatchesOfficial(input.IsOfficial) {
+		return false, rule
+	}
+
 	return true, rule
 }
 
@@ -278,21 +307,34 @@ func (s *filterService) ShouldDownloadForRSS(title string, isFree bool, rssID ui
 }
 
 // ShouldDownloadForRSSWithInput determines if a torrent should be downloaded based on RSS-associated filter rules.
+// A notify-only rule match is reported with false so callers can still notify.
 func (s *filterService) ShouldDownloadForRSSWithInput(input MatchInput, isFree bool, rssID uint) (bool, *models.FilterRule) {
-	rule, matched := s.MatchRulesForRSSWithInput(input, rssID)
+	rule, action, matched := s.matchRuleForRSSWithAction(input, rssID)
 	if !matched {
 		return false, nil
 	}
+	if action == PurposeNotify {
+		return false, rule
+	}
 
 	// If rule requires free, check free status
 	if rule.RequireFree && !isFree {
 		return false, rule
 	}
 
+	// A size outside the rule's byte range matches but does not download
+	if !rule.MatchesSizeBytes(input.SizeBytes) {
+		return false, rule
+	}
+
+	// Rules requiring an official upload match but do not download others
+	if !rule.MatchesOfficial(input.IsOfficial) {
+		return false, rule
+	}
+
 	return true, rule
 }
 
-// ShouldNotifyForRSS 见接口定义。
 // ShouldNotifyForRSS 见接口定义。
 func (s *filterService) ShouldNotifyForRSS(title string, isFree bool, rssID uint) (bool, *models.FilterRule) {
 	return s.ShouldNotifyForRSSWithInput(MatchInput{Title: title}, isFree, rssID)
@@ -380,11 +422,47 @@ func (s *filterService) ruleApplies(rule *models.FilterRule, siteID, rssID *uint
 
 // MatchResult represents the result of a filter match.
 type MatchResult struct {
-	Matched        bool
-	Rule           *models.FilterRule
+	Matched bool
+	Rule    *models.FilterRule
+	// Action is what the matched rule asks for: PurposeDownload, or PurposeNotify
+	// when only a notify-only rule matched. Empty when nothing matched.
+	Action         Purpose
 	ShouldDownload bool
 }
 
+// matchRuleWithAction prefers a download rule and falls back to a notify-only
+// rule, reporting which of the two matched.
+func (s *filterService) matchRuleWithAction(input MatchInput, siteID, rssID *uint) (*models.FilterRule, Purpose, bool) {
+	if rule, ok := s.matchRulesWithInputForPurpose(input, siteID, rssID, PurposeDownload); ok {
+		return rule, PurposeDownload, true
+	}
+	if rule, ok := s.matchRulesWithInputForPurpose(input, siteID, rssID, PurposeNotify); ok {
+		return rule, PurposeNotify, true
+	}
+	return nil, "", false
+}
+
+// matchRuleForRSSWithAction is matchRuleWithAction over RSS-associated rules.
+func (s *filterService) matchRuleForRSSWithAction(input MatchInput, rssID uint) (*models.FilterRule, Purpose, bool) {
+	if rule, ok := s.matchRulesForRSSWithInputForPurpose(input, rssID, PurposeDownload); ok {
+		return rule, PurposeDownload, true
+	}
+	if rule, ok := s.matchRulesForRSSWithInputForPurpose(input, rssID, PurposeNotify); ok {
+		return rule, PurposeNotify, true
+	}
+	return nil, "", false
+}
+
+// newMatchResult builds a MatchResult; notify-only matches never download.
+func newMatchResult(rule *models.FilterRule, action Purpose, input MatchInput, isFree bool) MatchResult {
+	return MatchResult{
+		Matched:        true,
+		Rule:           rule,
+		Action:         action,
+		ShouldDownload: action == PurposeDownload && (!rule.RequireFree || isFree) && rule.MatchesSizeBytes(input.SizeBytes) && rule.MatchesOfficial(input.IsOfficial),
+	}
+}
+
 // MatchTorrent is a convenience method that returns a complete match result.
 func (s *filterService) MatchTorrent(title string, isFree bool, siteID, rssID *uint) MatchResult {
 	return s.MatchTorrentWithInput(MatchInput{Title: title}, isFree, siteID, rssID)
@@ -392,17 +470,11 @@ func (s *filterService) MatchTorrent(title string, isFree bool, siteID, rssID *u
 
 // MatchTorrentWithInput is a convenience method that returns a complete match result with multi-field support.
 func (s *filterService) MatchTorrentWithInput(input MatchInput, isFree bool, siteID, rssID *uint) MatchResult {
-	rule, matched := s.MatchRulesWithInput(input, siteID, rssID)
+	rule, action, matched := s.matchRuleWithAction(input, siteID, rssID)
 	if !matched {
 		return MatchResult{Matched: false}
 	}
-
-	shouldDownload := !rule.RequireFree || isFree
-	return MatchResult{
-		Matched:        true,
-		Rule:           rule,
-		ShouldDownload: shouldDownload,
-	}
+	return newMatchResult(rule, action, input, isFree)
 }
 
 // MatchTorrentForRSS is a convenience method that returns a complete match result using RSS associations.
@@ -412,17 +484,11 @@ func (s *filterService) MatchTorrentForRSS(title string, isFree bool, rssID uint
 
 // MatchTorrentForRSSWithInput is a convenience method that returns a complete match result using RSS associations with multi-field support.
 func (s *filterService) MatchTorrentForRSSWithInput(input MatchInput, isFree bool, rssID uint) MatchResult {
-	rule, matched := s.MatchRulesForRSSWithInput(input, rssID)
+	rule, action, matched := s.matchRuleForRSSWithAction(input, rssID)
 	if !matched {
 		return MatchResult{Matched: false}
 	}
-
-	shouldDownload := !rule.RequireFree || isFree
-	return MatchResult{
-		Matched:        true,
-		Rule:           rule,
-		ShouldDownload: shouldDownload,
-	}
+	return newMatchResult(rule, action, input, isFree)
 }
 
 // Download source tags persisted on TorrentInfo.DownloadSource.
@@ -476,8 +542,10 @@ func (s *filterService) Decide(ctx DecisionContext, rssID uint) Decision {
 			if rule.RequireFree && !ctx.IsFree {
 				// Don't approve via filter channel, but keep the matchedRule for
 				// logging; the free channel may still approve below.
-			} else if !rule.MatchesSize(ctx.Input.SizeGB) {
+			} else if !rule.MatchesSize(ctx.Input.SizeGB) || !rule.MatchesSizeBytes(ctx.Input.SizeBytes) {
 				// Rule matched text but not size — same handling as above.
+			} else if !rule.MatchesOfficial(ctx.Input.IsOfficial) {
+				// Rule matched but requires an official upload — same handling as above.
 			} else {
 				return Decision{
 					ShouldDownload: true,
@@ -508,7 +576,7 @@ func (s *filterService) Decide(ctx DecisionContext, rssID uint) Decision {
 		ShouldDownload: false,
 		MatchedRule:    matchedRule,
 		Source:         SourceNone,
-		Reason:         buildDecisionReason(mode, matchedRule, ctx.IsFree, ctx.CanFinish, hasRules),
+		Reason:         buildDecisionReason(mode, matchedRule, ctx.IsFree, ctx.CanFinish, hasRules, ctx.Input.IsOfficial),
 	}
 }
 
@@ -556,7 +624,7 @@ func DecideWithoutRules(ctx DecisionContext) Decision {
 	}
 }
 
-func buildDecisionReason(mode models.FilterMode, rule *models.FilterRule, isFree, canFinish, hasRules bool) string {
+func buildDecisionReason(mode models.FilterMode, rule *models.FilterRule, isFree, canFinish, hasRules, isOfficial bool) string {
 	switch mode {
 	case models.FilterModeFilterOnly:
 		if rule == nil {
@@ -565,6 +633,9 @@ func buildDecisionReason(mode models.FilterMode, rule *models.FilterRule, isFree
 		if rule.RequireFree && !isFree {
 			return "匹配规则要求免费，但种子非免费"
 		}
+		if !rule.MatchesOfficial(isOfficial) {
+			return "匹配规则要求官方种子，但种子非官方"
+		}
 		return "匹配规则但大小不符合规则约束"
 	case models.FilterModeFreeOnly:
 		if !isFree {
@@ -585,6 +656,12 @@ func buildDecisionReason(mode models.FilterMode, rule *models.FilterRule, isFree
 			}
 			return "匹配规则但大小不符合；且非免费或无法完成"
 		}
+		if rule != nil && !rule.MatchesOfficial(isOfficial) {
+			if hasRules {
+				return "匹配规则要求官方种子但种子非官方；RSS 关联了过滤规则，非匹配的免费种子不再自动下载"
+			}
+			return "匹配规则要求官方种子但种子非官方；且非免费或无法完成"
+		}
 		if hasRules {
 			if isFree && !canFinish {
 				return "未匹配过滤规则且免费期剩余时间不足（RSS 关联了规则，未匹配种子不自动下载）"
@@ -1413,6 +1413,207 @@ func TestProperty_MultiFieldMatching(t *testing.T) {
 	properties.TestingRun(t)
 }
 
+// subtitleFixture returns title/tag/subtitle values that contain "test" only
+// where requested.
+func subtitleFixture(titleMatches, tagMatches, subtitleMatches bool) MatchInput {
+	input := MatchInput{Title: "other title", Tag: "other tag", Subtitle: "other subtitle"}
+	if titleMatches {
+		input.Title = "test title"
+	}
+	if tagMatches {
+		input.Tag = "test tag"
+	}
+	if subtitleMatches {
+		input.Subtitle = "test subtitle"
+	}
+	return input
+}
+
+// TestProperty_SubtitleFieldMatching tests subtitle-aware multi-field matching
+// *For any* torrent with title, tag and subtitle fields:
+// - When `match_field='subtitle'`, the rule matches if and only if the subtitle matches the pattern
+// - When `match_field='both'`, the rule matches if the title, tag OR subtitle matches the pattern
+// - The title-only MatchRules entry point never consults the subtitle
+func TestProperty_SubtitleFieldMatching(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 100
+	properties := gopter.NewProperties(parameters)
+
+	newService := func(field models.MatchField) (FilterService, func()) {
+		db, cleanup := setupServiceTestDB(t)
+		db.Create(&models.FilterRule{
+			Name:        "Subtitle Rule",
+			Pattern:     "test",
+			PatternType: models.PatternKeyword,
+			MatchField:  field,
+			Enabled:     true,
+			Priority:    100,
+		})
+		return NewFilterService(db), cleanup
+	}
+
+	properties.Property("match_field=subtitle only matches subtitle", prop.ForAll(
+		func(titleMatches, tagMatches, subtitleMatches bool) bool {
+			svc, cleanup := newService(models.MatchFieldSubtitle)
+			defer cleanup()
+
+			input := subtitleFixture(titleMatches, tagMatches, subtitleMatches)
+			_, matched := svc.MatchRulesWithInput(input, nil, nil)
+
+			return matched == subtitleMatches
+		},
+		gen.Bool(),
+		gen.Bool(),
+		gen.Bool(),
+	))
+
+	properties.Property("match_field=both also considers subtitle", prop.ForAll(
+		func(titleMatches, tagMatches, subtitleMatches bool) bool {
+			svc, cleanup := newService(models.MatchFieldBoth)
+			defer cleanup()
+
+			input := subtitleFixture(titleMatches, tagMatches, subtitleMatches)
+			_, matched := svc.MatchRulesWithInput(input, nil, nil)
+
+			return matched == (titleMatches || tagMatches || subtitleMatches)
+		},
+		gen.Bool(),
+		gen.Bool(),
+		gen.Bool(),
+	))
+
+	properties.Property("MatchRules leaves subtitle empty", prop.ForAll(
+		func(titleMatches bool) bool {
+			svc, cleanup := newService(models.MatchFieldSubtitle)
+			defer cleanup()
+
+			title := subtitleFixture(titleMatches, false, false).Title
+			_, matched := svc.MatchRules(title, nil, nil)
+
+			return !matched
+		},
+		gen.Bool(),
+	))
+
+	properties.TestingRun(t)
+}
+
+func TestMatchRulesForRSSWithInput_Subtitle(t *testing.T) {
+	db, cleanup := setupServiceTestDBWithAssociations(t)
+	defer cleanup()
+
+	rule := &models.FilterRule{
+		Name:        "RSS Subtitle Rule",
+		Pattern:     "2160p",
+		PatternType: models.PatternKeyword,
+		MatchField:  models.MatchFieldSubtitle,
+		Enabled:     true,
+		Priority:    100,
+	}
+	require.NoError(t, db.Create(rule).Error)
+	require.NoError(t, db.Create(&models.RSSFilterAssociation{RSSID: 7, FilterRuleID: rule.ID}).Error)
+	svc := NewFilterService(db)
+
+	_, matched := svc.MatchRulesForRSSWithInput(MatchInput{Title: "Movie", Subtitle: "中字 2160p"}, 7)
+	assert.True(t, matched)
+	_, matched = svc.MatchRulesForRSSWithInput(MatchInput{Title: "Movie 2160p", Tag: "2160p"}, 7)
+	assert.False(t, matched)
+}
+
+// TestProperty_SizeRangeBytes tests the rule's inclusive byte-size range
+// *For any* rule bounds and torrent size:
+// - A pattern match downloads if and only if min <= size <= max, with zero bounds unbounded
+// - A size miss still reports the matched rule, like RequireFree
+// - An unknown (zero) size never rejects the match
+func TestProperty_SizeRangeBytes(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 100
+	properties := gopter.NewProperties(parameters)
+
+	newService := func(minBytes, maxBytes int64) (FilterService, uint, func()) {
+		db, cleanup := setupServiceTestDBWithAssociations(t)
+		rule := &models.FilterRule{
+			Name:         "Size Range Rule",
+			Pattern:      "remux",
+			PatternType:  models.PatternKeyword,
+			MinSizeBytes: minBytes,
+			MaxSizeBytes: maxBytes,
+			Enabled:      true,
+			Priority:     100,
+		}
+		db.Create(rule)
+		db.Create(&models.RSSFilterAssociation{RSSID: 1, FilterRuleID: rule.ID})
+		return NewFilterService(db), rule.ID, cleanup
+	}
+
+	properties.Property("download only within inclusive bounds", prop.ForAll(
+		func(minBytes, maxBytes, size int64) bool {
+			svc, ruleID, cleanup := newService(minBytes, maxBytes)
+			defer cleanup()
+
+			input := MatchInput{Title: "Movie 2160p REMUX", SizeBytes: size}
+			want := (minBytes == 0 || size >= minBytes) && (maxBytes == 0 || size <= maxBytes)
+
+			ok, rule := svc.ShouldDownloadWithInput(input, true, nil, nil)
+			okRSS, ruleRSS := svc.ShouldDownloadForRSSWithInput(input, true, 1)
+			return ok == want && okRSS == want &&
+				rule != nil && rule.ID == ruleID && ruleRSS != nil && ruleRSS.ID == ruleID
+		},
+		gen.Int64Range(0, 200),
+		gen.Int64Range(0, 200),
+		gen.Int64Range(1, 200),
+	))
+
+	properties.Property("unbounded or unknown size always downloads", prop.ForAll(
+		func(bound, size int64) bool {
+			unbounded, _, cleanup := newService(0, 0)
+			defer cleanup()
+			bounded, _, cleanup2 := newService(bound, bound)
+			defer cleanup2()
+
+			ok, _ := unbounded.ShouldDownloadWithInput(MatchInput{Title: "remux", SizeBytes: size}, true, nil, nil)
+			okUnknown, _ := bounded.ShouldDownloadWithInput(MatchInput{Title: "remux"}, true, nil, nil)
+			return ok && okUnknown
+		},
+		gen.Int64Range(1, 1<<40),
+		gen.Int64Range(1, 1<<40),
+	))
+
+	properties.TestingRun(t)
+}
+
+func TestDecide_SizeRangeBytes(t *testing.T) {
+	db, cleanup := setupServiceTestDBWithAssociations(t)
+	defer cleanup()
+
+	const gb = int64(1) << 30
+	rule := &models.FilterRule{
+		Name:         "4K REMUX 40-120GB",
+		Pattern:      "remux",
+		PatternType:  models.PatternKeyword,
+		MinSizeBytes: 40 * gb,
+		MaxSizeBytes: 120 * gb,
+		Enabled:      true,
+		Priority:     100,
+	}
+	require.NoError(t, db.Create(rule).Error)
+	require.NoError(t, db.Create(&models.RSSFilterAssociation{RSSID: 3, FilterRuleID: rule.ID}).Error)
+	svc := NewFilterService(db)
+
+	ctx := DecisionContext{
+		Input:      MatchInput{Title: "Movie REMUX", SizeBytes: 60 * gb},
+		IsFree:     true,
+		FilterMode: models.FilterModeFilterOnly,
+	}
+	assert.True(t, svc.Decide(ctx, 3).ShouldDownload)
+
+	ctx.Input.SizeBytes = 20 * gb
+	d := svc.Decide(ctx, 3)
+	assert.False(t, d.ShouldDownload)
+	require.NotNil(t, d.MatchedRule)
+	assert.Equal(t, rule.ID, d.MatchedRule.ID)
+}
+
 // TestMultiFieldMatchingUnit provides unit tests for multi-field matching
 func TestMultiFieldMatchingUnit(t *testing.T) {
 	t.Run("MatchRulesWithInput with title-only rule", func(t *testing.T) {
@@ -1620,7 +1821,8 @@ func TestPurposeSplit(t *testing.T) {
 
 		shouldDl, dlRule := svc.ShouldDownloadForRSS("test title", true, rss.ID)
 		assert.False(t, shouldDl)
-		assert.Nil(t, dlRule)
+		require.NotNil(t, dlRule, "notify-only match is still reported")
+		assert.Equal(t, rule.ID, dlRule.ID)
 
 		shouldNotify, notifyRule := svc.ShouldNotifyForRSS("test title", true, rss.ID)
 		assert.True(t, shouldNotify)
@@ -1788,3 +1990,73 @@ func TestShouldNotifyForRSS(t *testing.T) {
 		assert.False(t, ok)
 	})
 }
+
+// TestNotifyOnlyRuleAction 通知规则命中时报告匹配但不触发下载
+func TestNotifyOnlyRuleAction(t *testing.T) {
+	db, cleanup := setupServiceTestDBWithAssociations(t)
+	defer cleanup()
+
+	notifyRule := &models.FilterRule{
+		Name:        "notify-only",
+		Pattern:     "Series",
+		PatternType: models.PatternKeyword,
+		MatchField:  models.MatchFieldTitle,
+		Enabled:     true,
+		Priority:    10,
+		Purpose:     "notify",
+	}
+	require.NoError(t, db.Create(notifyRule).Error)
+	downloadRule := &models.FilterRule{
+		Name:        "download",
+		Pattern:     "Movie",
+		PatternType: models.PatternKeyword,
+		MatchField:  models.MatchFieldTitle,
+		Enabled:     true,
+		Priority:    20,
+		Purpose:     "download",
+	}
+	require.NoError(t, db.Create(downloadRule).Error)
+
+	rss := createTestRSSSubscription(t, db, "rss-action")
+	for _, r := range []*models.FilterRule{notifyRule, downloadRule} {
+		require.NoError(t, db.Create(&models.RSSFilterAssociation{RSSID: rss.ID, FilterRuleID: r.ID}).Error)
+	}
+	svc := NewFilterService(db).(*filterService)
+
+	t.Run("notify rule matches but does not download", func(t *testing.T) {
+		ok, rule := svc.ShouldDownload("Some Series S01", true, nil, nil)
+		assert.False(t, ok)
+		require.NotNil(t, rule)
+		assert.Equal(t, notifyRule.ID, rule.ID)
+
+		result := svc.MatchTorrent("Some Series S01", true, nil, nil)
+		assert.True(t, result.Matched)
+		assert.Equal(t, PurposeNotify, result.Action)
+		assert.False(t, result.ShouldDownload)
+	})
+
+	t.Run("notify rule via RSS association", func(t *testing.T) {
+		ok, rule := svc.ShouldDownloadForRSS("Some Series S01", true, rss.ID)
+		assert.False(t, ok)
+		require.NotNil(t, rule)
+		assert.Equal(t, notifyRule.ID, rule.ID)
+
+		result := svc.MatchTorrentForRSS("Some Series S01", true, rss.ID)
+		assert.True(t, result.Matched)
+		assert.Equal(t, PurposeNotify, result.Action)
+		assert.False(t, result.ShouldDownload)
+	})
+
+	t.Run("download rule reports download action", func(t *testing.T) {
+		result := svc.MatchTorrentForRSS("Some Movie 2024", true, rss.ID)
+		assert.True(t, result.Matched)
+		assert.Equal(t, PurposeDownload, result.Action)
+		assert.True(t, result.ShouldDownload)
+	})
+
+	t.Run("no match has no action", func(t *testing.T) {
+		result := svc.MatchTorrent("unrelated", true, nil, nil)
+		assert.False(t, result.Matched)
+		assert.Empty(t, result.Action)
+	})
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/sunerpy/pt-tools/internal/events"
 	"github.com/sunerpy/pt-tools/models"
 	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+	"github.com/sunerpy/pt-tools/thirdpart/downloader/aria2"
+	"github.com/sunerpy/pt-tools/thirdpart/downloader/deluge"
 	"github.com/sunerpy/pt-tools/thirdpart/downloader/qbit"
 	"github.com/sunerpy/pt-tools/thirdpart/downloader/transmission"
 )
@@ -318,6 +320,12 @@ func createDownloaderInstanceForPush(config models.DownloaderSetting) (downloade
 	case "transmission":
 		dlConfig := transmission.NewTransmissionConfigWithAutoStart(config.URL, config.Username, config.Password, config.AutoStart)
 		return transmission.NewTransmissionClient(dlConfig, config.Name)
+	case "aria2":
+		dlConfig := aria2.NewAria2ConfigWithAutoStart(config.URL, config.Password, config.AutoStart)
+		return aria2.NewAria2Client(dlConfig, config.Name)
+	case "deluge":
+		dlConfig := deluge.NewDelugeConfigWithAutoStart(config.URL, config.Password, config.AutoStart)
+		return deluge.NewDelugeClient(dlConfig, config.Name)
 	default:
 		return nil, fmt.Errorf("不支持的下载器类型: %s", config.Type)
 	}
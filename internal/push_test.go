@@ -829,8 +829,18 @@ func TestCreateDownloaderInstanceForPush(t *testing.T) {
 		Name: "tr", Type: "Transmission", URL: "http://127.0.0.1:0", AutoStart: true,
 	})
 
+	// aria2 branch executes.
+	_, _ = createDownloaderInstanceForPush(models.DownloaderSetting{
+		Name: "ar", Type: "aria2", URL: "http://127.0.0.1:0",
+	})
+
+	// deluge branch executes.
+	_, _ = createDownloaderInstanceForPush(models.DownloaderSetting{
+		Name: "de", Type: "deluge", URL: "http://127.0.0.1:0",
+	})
+
 	// unsupported -> error.
-	_, err := createDownloaderInstanceForPush(models.DownloaderSetting{Name: "x", Type: "deluge"})
+	_, err := createDownloaderInstanceForPush(models.DownloaderSetting{Name: "x", Type: "utorrent"})
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "不支持的下载器类型")
 }
@@ -268,6 +268,20 @@ func (mr *MockDownloaderMockRecorder) GetClientLabels() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientLabels", reflect.TypeOf((*MockDownloader)(nil).GetClientLabels))
 }
 
+// Capabilities mocks base method
+func (m *MockDownloader) Capabilities() downloader.Capabilities {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capabilities")
+	ret0, _ := ret[0].(downloader.Capabilities)
+	return ret0
+}
+
+// Capabilities indicates an expected call of Capabilities
+func (mr *MockDownloaderMockRecorder) Capabilities() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capabilities", reflect.TypeOf((*MockDownloader)(nil).Capabilities))
+}
+
 // GetType mocks base method
 func (m *MockDownloader) GetType() downloader.DownloaderType {
 	m.ctrl.T.Helper()
@@ -14,6 +14,8 @@ const (
 	PatternWildcard PatternType = "wildcard"
 	// PatternRegex uses regular expressions for matching.
 	PatternRegex PatternType = "regex"
+	// PatternGlob uses shell-style * and ? globs anchored to the whole title.
+	PatternGlob PatternType = "glob"
 )
 
 // MatchField represents which fields to match against.
@@ -24,24 +26,38 @@ const (
 	MatchFieldTitle MatchField = "title"
 	// MatchFieldTag matches only against the tag field.
 	MatchFieldTag MatchField = "tag"
-	// MatchFieldBoth matches against both title and tag fields (default).
+	// MatchFieldSubtitle matches only against the subtitle (副标题) field.
+	MatchFieldSubtitle MatchField = "subtitle"
+	// MatchFieldBoth matches against the title, tag and subtitle fields (default).
 	MatchFieldBoth MatchField = "both"
 )
 
+// IsValid reports whether f is a supported match field.
+func (f MatchField) IsValid() bool {
+	switch f {
+	case MatchFieldTitle, MatchFieldTag, MatchFieldSubtitle, MatchFieldBoth:
+		return true
+	}
+	return false
+}
+
 // FilterRule represents a user-defined filter rule for RSS items.
 type FilterRule struct {
-	ID          uint        `gorm:"primaryKey" json:"id"`
-	Name        string      `gorm:"size:128;not null" json:"name"`
-	Pattern     string      `gorm:"size:512;not null" json:"pattern"`
-	PatternType PatternType `gorm:"size:16;not null;default:'keyword'" json:"pattern_type"`
-	MatchField  MatchField  `gorm:"size:16;not null;default:'both'" json:"match_field"`
-	RequireFree bool        `gorm:"default:true" json:"require_free"`
-	MinSizeGB   int         `gorm:"default:0" json:"min_size_gb"`
-	MaxSizeGB   int         `gorm:"default:0" json:"max_size_gb"`
-	Enabled     bool        `gorm:"default:true" json:"enabled"`
-	SiteID      *uint       `gorm:"index" json:"site_id"`
-	RSSID       *uint       `gorm:"index" json:"rss_id"`
-	Priority    int         `gorm:"default:100" json:"priority"`
+	ID              uint        `gorm:"primaryKey" json:"id"`
+	Name            string      `gorm:"size:128;not null" json:"name"`
+	Pattern         string      `gorm:"size:512;not null" json:"pattern"`
+	PatternType     PatternType `gorm:"size:16;not null;default:'keyword'" json:"pattern_type"`
+	MatchField      MatchField  `gorm:"size:16;not null;default:'both'" json:"match_field"`
+	RequireFree     bool        `gorm:"default:true" json:"require_free"`
+	MinSizeGB       int         `gorm:"default:0" json:"min_size_gb"`
+	MaxSizeGB       int         `gorm:"default:0" json:"max_size_gb"`
+	MinSizeBytes    int64       `gorm:"default:0" json:"min_size_bytes"`
+	MaxSizeBytes    int64       `gorm:"default:0" json:"max_size_bytes"`
+	RequireOfficial bool        `gorm:"default:false" json:"require_official"` // 仅匹配官方/原创种子
+	Enabled         bool        `gorm:"default:true" json:"enabled"`
+	SiteID          *uint       `gorm:"index" json:"site_id"`
+	RSSID           *uint       `gorm:"index" json:"rss_id"`
+	Priority        int         `gorm:"default:100" json:"priority"`
 	// Purpose 区分规则用途：
 	//   "download" — 仅用于下载（默认，向后兼容空值）
 	//   "notify"   — 仅用于通知（filtered 模式）
@@ -64,6 +80,28 @@ func (r *FilterRule) MatchesSize(sizeGB float64) bool {
 	return true
 }
 
+// MatchesSizeBytes reports whether the torrent size (in bytes) satisfies this
+// rule's optional MinSizeBytes / MaxSizeBytes bounds, both inclusive. Zero on
+// either side means "no bound"; a zero size is unknown and always passes.
+func (r *FilterRule) MatchesSizeBytes(sizeBytes int64) bool {
+	if sizeBytes <= 0 {
+		return true
+	}
+	if r.MinSizeBytes > 0 && sizeBytes < r.MinSizeBytes {
+		return false
+	}
+	if r.MaxSizeBytes > 0 && sizeBytes > r.MaxSizeBytes {
+		return false
+	}
+	return true
+}
+
+// MatchesOfficial reports whether a torrent's official flag satisfies the rule.
+// Rules without RequireOfficial accept any torrent.
+func (r *FilterRule) MatchesOfficial(isOfficial bool) bool {
+	return !r.RequireOfficial || isOfficial
+}
+
 // TableName returns the table name for FilterRule.
 func (FilterRule) TableName() string {
 	return "filter_rules"
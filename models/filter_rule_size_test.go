@@ -48,6 +48,30 @@ func TestFilterRule_MatchesSize_AllCombinations(t *testing.T) {
 	}
 }
 
+func TestFilterRule_MatchesSizeBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		min  int64
+		max  int64
+		size int64
+		want bool
+	}{
+		{"no bounds", 0, 0, 1 << 40, true},
+		{"unknown size ignores bounds", 100, 200, 0, true},
+		{"min / below", 100, 0, 99, false},
+		{"min / at boundary", 100, 0, 100, true},
+		{"max / at boundary", 0, 200, 200, true},
+		{"max / above", 0, 200, 201, false},
+		{"both / middle", 100, 200, 150, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &FilterRule{MinSizeBytes: tt.min, MaxSizeBytes: tt.max}
+			assert.Equal(t, tt.want, rule.MatchesSizeBytes(tt.size))
+		})
+	}
+}
+
 // ============================================================================
 // NormalizeFilterMode — enum validation and fallback
 // ============================================================================
@@ -32,6 +32,12 @@ type PHPTorrentInfo struct {
 	Leechers  int          // 下载人数
 	Completed float64      // 最大完成百分比
 	HR        bool         // 是否为 HR（Hit & Run）
+	Official  bool         // 是否为官方/原创种子
+}
+
+// IsOfficialUpload 是否为官方/原创种子
+func (p PHPTorrentInfo) IsOfficialUpload() bool {
+	return p.Official
 }
 
 func (p PHPTorrentInfo) IsFree() bool {
@@ -28,6 +28,12 @@ type TorrentMetadata interface {
 	// GetSizeBytes 获取种子大小（字节），用于过滤规则的大小匹配
 	GetSizeBytes() int64
 }
+
+// OfficialChecker 可选接口：判断种子是否为官方/原创发布，用于 require_official 规则
+type OfficialChecker interface {
+	IsOfficialUpload() bool
+}
+
 type FreeDownChecker interface {
 	IsFree() bool
 	CanbeFinished(logger *zap.SugaredLogger, enabled bool, speedLimit, sizeLimitGB int) bool
@@ -81,6 +81,7 @@ type DownloaderSetting struct {
 	IsDefault   bool      `json:"is_default"`
 	Enabled     bool      `json:"enabled"`
 	AutoStart   bool      `json:"auto_start"`                              // 推送种子后自动开始下载
+	DryRun      bool      `json:"dry_run"`                                 // 演练模式：只记录将要添加的种子，不实际提交
 	ExtraConfig string    `gorm:"type:text" json:"extra_config,omitempty"` // JSON格式的额外配置
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
@@ -335,9 +335,8 @@ func (c *CleanupMonitor) splitProtected(cfg *models.SettingsGlobal, torrents []d
 		}
 
 		if cfg.CleanupProtectHR {
-			if hrInfo, ok := hrInfoMap[strings.ToLower(t.InfoHash)]; ok && hrInfo.HasHR {
-				requiredSeedTimeS := int64(hrInfo.HRSeedTimeH) * 3600
-				if requiredSeedTimeS > 0 && t.SeedingTime < requiredSeedTimeS {
+			if hrInfo, ok := hrInfoMap[strings.ToLower(t.InfoHash)]; ok {
+				if hrInfo.TimeToSatisfy(t.SeedingTime) > 0 {
 					c.logger.Debugf("[自动删种] H&R 保护: %s (需做种%dh, 已做种%.1fh)",
 						t.Name, hrInfo.HRSeedTimeH, float64(t.SeedingTime)/3600)
 					protected = append(protected, t)
@@ -366,13 +365,8 @@ func (c *CleanupMonitor) splitProtected(cfg *models.SettingsGlobal, torrents []d
 	return protected, candidates
 }
 
-type hrInfo struct {
-	HasHR       bool
-	HRSeedTimeH int
-}
-
-func (c *CleanupMonitor) getHRInfoMap() map[string]hrInfo {
-	result := make(map[string]hrInfo)
+func (c *CleanupMonitor) getHRInfoMap() map[string]v2.HRInfo {
+	result := make(map[string]v2.HRInfo)
 
 	siteDefMap := make(map[string]*v2.SiteDefinition)
 	for _, def := range v2.GetDefinitionRegistry().GetAll() {
@@ -396,10 +390,10 @@ func (c *CleanupMonitor) getHRInfoMap() map[string]hrInfo {
 	for _, r := range records {
 		hash := strings.ToLower(r.TorrentHash)
 		if r.HasHR {
-			result[hash] = hrInfo{HasHR: true, HRSeedTimeH: r.HRSeedTimeH}
+			result[hash] = v2.HRInfo{HasHR: true, HRSeedTimeH: r.HRSeedTimeH}
 		} else if def, ok := siteDefMap[r.SiteName]; ok {
 			// Use per-torrent size-based calculation when available
-			result[hash] = hrInfo{HasHR: true, HRSeedTimeH: def.CalcHRSeedTimeH(r.TorrentSize)}
+			result[hash] = v2.HRInfo{HasHR: true, HRSeedTimeH: def.CalcHRSeedTimeH(r.TorrentSize)}
 		}
 	}
 	return result
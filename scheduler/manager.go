@@ -12,6 +12,8 @@ import (
 	"github.com/sunerpy/pt-tools/internal/events"
 	"github.com/sunerpy/pt-tools/models"
 	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+	"github.com/sunerpy/pt-tools/thirdpart/downloader/aria2"
+	"github.com/sunerpy/pt-tools/thirdpart/downloader/deluge"
 	"github.com/sunerpy/pt-tools/thirdpart/downloader/qbit"
 	"github.com/sunerpy/pt-tools/thirdpart/downloader/transmission"
 )
@@ -258,6 +260,8 @@ func (m *Manager) initDownloaderManager() {
 	// 注册下载器工厂
 	m.downloaderManager.RegisterFactory(downloader.DownloaderQBittorrent, createQBitFactory())
 	m.downloaderManager.RegisterFactory(downloader.DownloaderTransmission, createTransmissionFactory())
+	m.downloaderManager.RegisterFactory(downloader.DownloaderDeluge, createDelugeFactory())
+	m.downloaderManager.RegisterFactory(downloader.DownloaderAria2, createAria2Factory())
 
 	// 从数据库加载下载器配置
 	var downloaderSettings []models.DownloaderSetting
@@ -278,6 +282,7 @@ func (m *Manager) initDownloaderManager() {
 		}
 
 		config := downloader.NewGenericConfig(dlType, ds.URL, ds.Username, ds.Password, ds.AutoStart)
+		config.DryRun = ds.DryRun
 		if err := m.downloaderManager.RegisterConfig(ds.Name, config, ds.IsDefault); err != nil {
 			global.GetSlogger().Errorf("注册下载器配置失败: %s, %v", ds.Name, err)
 			continue
@@ -316,6 +321,7 @@ func (m *Manager) checkDownloaderHealthAsync(setting models.DownloaderSetting) {
 		}
 
 		config := downloader.NewGenericConfig(dlType, ds.URL, ds.Username, ds.Password, ds.AutoStart)
+		config.DryRun = ds.DryRun
 		dl, err := m.downloaderManager.CreateFromConfig(config, ds.Name)
 		if err != nil {
 			global.GetSlogger().Errorf("[下载器健康检查] %s 创建实例失败: %v", ds.Name, err)
@@ -393,6 +399,8 @@ func (m *Manager) initPeerRatioMonitor() {
 func createQBitFactory() downloader.DownloaderFactory {
 	return func(config downloader.DownloaderConfig, name string) (downloader.Downloader, error) {
 		qbitConfig := qbit.NewQBitConfigWithAutoStart(config.GetURL(), config.GetUsername(), config.GetPassword(), config.GetAutoStart())
+		qbitConfig.DryRun = config.GetDryRun()
+		qbitConfig.Timeout = downloader.RequestTimeout(config)
 		return qbit.NewQbitClient(qbitConfig, name)
 	}
 }
@@ -401,10 +409,30 @@ func createQBitFactory() downloader.DownloaderFactory {
 func createTransmissionFactory() downloader.DownloaderFactory {
 	return func(config downloader.DownloaderConfig, name string) (downloader.Downloader, error) {
 		transConfig := transmission.NewTransmissionConfigWithAutoStart(config.GetURL(), config.GetUsername(), config.GetPassword(), config.GetAutoStart())
+		transConfig.DryRun = config.GetDryRun()
+		transConfig.Timeout = downloader.RequestTimeout(config)
 		return transmission.NewTransmissionClient(transConfig, name)
 	}
 }
 
+// createDelugeFactory 创建 Deluge 工厂
+func createDelugeFactory() downloader.DownloaderFactory {
+	return func(config downloader.DownloaderConfig, name string) (downloader.Downloader, error) {
+		delugeConfig := deluge.NewDelugeConfigWithAutoStart(config.GetURL(), config.GetPassword(), config.GetAutoStart())
+		delugeConfig.Timeout = downloader.RequestTimeout(config)
+		return deluge.NewDelugeClient(delugeConfig, name)
+	}
+}
+
+// createAria2Factory 创建 Aria2 工厂，密码字段作为 RPC 令牌
+func createAria2Factory() downloader.DownloaderFactory {
+	return func(config downloader.DownloaderConfig, name string) (downloader.Downloader, error) {
+		aria2Config := aria2.NewAria2ConfigWithAutoStart(config.GetURL(), config.GetPassword(), config.GetAutoStart())
+		aria2Config.Timeout = downloader.RequestTimeout(config)
+		return aria2.NewAria2Client(aria2Config, name)
+	}
+}
+
 func validRSS(raw string) bool {
 	if raw == "" {
 		return false
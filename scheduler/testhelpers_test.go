@@ -168,6 +168,10 @@ func (f *schedFakeDownloader) GetClientPaths() ([]string, error) { return nil, n
 
 func (f *schedFakeDownloader) GetClientLabels() ([]string, error) { return nil, nil }
 
+func (f *schedFakeDownloader) Capabilities() downloader.Capabilities {
+	return downloader.Capabilities{}
+}
+
 func (f *schedFakeDownloader) GetType() downloader.DownloaderType { return f.dlType }
 
 func (f *schedFakeDownloader) GetName() string { return f.name }
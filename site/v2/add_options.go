@@ -0,0 +1,102 @@
+package v2
+
+import (
+	"strings"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+// AddOptionsPolicy configures how OptionsFromTorrent maps a TorrentItem to
+// downloader options
+type AddOptionsPolicy struct {
+	// Base is copied into every result (paused state, speed limits, queue position...)
+	Base downloader.AddTorrentOptions
+	// CategoryMap maps site categories to downloader categories
+	CategoryMap map[string]string
+	// DefaultCategory is used when the item's category is not in CategoryMap;
+	// empty keeps Base.Category
+	DefaultCategory string
+	// IncludeItemTags adds the item's Tags to the downloader tags
+	IncludeItemTags bool
+	// IncludeSiteTag adds the item's SourceSite as a tag
+	IncludeSiteTag bool
+	// ExtraTags are always added
+	ExtraTags []string
+	// PathTemplate builds the save path. Supported placeholders:
+	// {site}, {category} (the resolved category) and {discount}.
+	// Empty keeps Base.SavePath
+	PathTemplate string
+	// FreePathTemplate replaces PathTemplate for items with an active free discount
+	FreePathTemplate string
+}
+
+// OptionsFromTorrent builds AddTorrentOptions for item according to policy
+func OptionsFromTorrent(item TorrentItem, policy AddOptionsPolicy) downloader.AddTorrentOptions {
+	opts := policy.Base
+
+	if mapped, ok := policy.CategoryMap[item.Category]; ok {
+		opts.Category = mapped
+	} else if policy.DefaultCategory != "" {
+		opts.Category = policy.DefaultCategory
+	}
+
+	var tags []string
+	if opts.Tags != "" {
+		tags = append(tags, strings.Split(opts.Tags, ",")...)
+	}
+	tags = append(tags, policy.ExtraTags...)
+	if policy.IncludeSiteTag {
+		tags = append(tags, item.SourceSite)
+	}
+	if policy.IncludeItemTags {
+		tags = append(tags, item.Tags...)
+	}
+	opts.Tags = joinTags(tags)
+
+	template := policy.PathTemplate
+	if policy.FreePathTemplate != "" && item.IsFree() && item.IsDiscountActive() {
+		template = policy.FreePathTemplate
+	}
+	if template != "" {
+		opts.SavePath = strings.NewReplacer(
+			"{site}", pathSegment(item.SourceSite),
+			"{category}", pathSegment(opts.Category),
+			"{discount}", pathSegment(string(item.DiscountLevel)),
+		).Replace(template)
+	}
+
+	return opts
+}
+
+// OptionsFromTorrents maps each item with OptionsFromTorrent, preserving order
+func OptionsFromTorrents(items []TorrentItem, policy AddOptionsPolicy) []downloader.AddTorrentOptions {
+	out := make([]downloader.AddTorrentOptions, len(items))
+	for i, item := range items {
+		out[i] = OptionsFromTorrent(item, policy)
+	}
+	return out
+}
+
+// joinTags trims and de-duplicates tags into the comma-separated form downloaders expect.
+// Commas inside a tag would split it, so they are dropped.
+func joinTags(tags []string) string {
+	seen := make(map[string]struct{}, len(tags))
+	out := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(strings.ReplaceAll(tag, ",", ""))
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		out = append(out, tag)
+	}
+	return strings.Join(out, ",")
+}
+
+// pathSegment makes a value safe to use as a single path component
+func pathSegment(s string) string {
+	return strings.NewReplacer("/", "_", "\\", "_").Replace(strings.TrimSpace(s))
+}
@@ -0,0 +1,85 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+func testAddOptionsPolicy() AddOptionsPolicy {
+	return AddOptionsPolicy{
+		Base: downloader.AddTorrentOptions{
+			AddAtPaused:         true,
+			Tags:                "pt-tools",
+			UploadSpeedLimitKBs: 512,
+		},
+		CategoryMap:      map[string]string{"Movies": "movie", "TV Series": "tv"},
+		DefaultCategory:  "other",
+		IncludeItemTags:  true,
+		IncludeSiteTag:   true,
+		PathTemplate:     "/data/{site}/{category}",
+		FreePathTemplate: "/data/free/{site}/{category}",
+	}
+}
+
+func TestOptionsFromTorrent_Free2160p(t *testing.T) {
+	item := TorrentItem{
+		ID:              "101",
+		Title:           "Some Movie 2025 2160p UHD BluRay HDR",
+		SourceSite:      "hdsky",
+		Category:        "Movies",
+		Tags:            []string{"2160p", "HDR", "pt-tools"},
+		DiscountLevel:   DiscountFree,
+		DiscountEndTime: time.Now().Add(24 * time.Hour),
+	}
+
+	opts := OptionsFromTorrent(item, testAddOptionsPolicy())
+
+	assert.Equal(t, "movie", opts.Category)
+	assert.Equal(t, "pt-tools,hdsky,2160p,HDR", opts.Tags, "base tag first, duplicates dropped")
+	assert.Equal(t, "/data/free/hdsky/movie", opts.SavePath)
+	assert.True(t, opts.AddAtPaused, "base options are kept")
+	assert.Equal(t, 512, opts.UploadSpeedLimitKBs)
+}
+
+func TestOptionsFromTorrent_NonFreeAndExpired(t *testing.T) {
+	policy := testAddOptionsPolicy()
+
+	paid := OptionsFromTorrent(TorrentItem{SourceSite: "hdsky", Category: "TV Series"}, policy)
+	assert.Equal(t, "tv", paid.Category)
+	assert.Equal(t, "/data/hdsky/tv", paid.SavePath)
+
+	expired := OptionsFromTorrent(TorrentItem{
+		SourceSite:      "hdsky",
+		Category:        "Documentary",
+		DiscountLevel:   DiscountFree,
+		DiscountEndTime: time.Now().Add(-time.Hour),
+	}, policy)
+	assert.Equal(t, "other", expired.Category, "unmapped category falls back to the default")
+	assert.Equal(t, "/data/hdsky/other", expired.SavePath, "expired free uses the regular template")
+}
+
+func TestOptionsFromTorrent_EmptyPolicyKeepsBase(t *testing.T) {
+	policy := AddOptionsPolicy{Base: downloader.AddTorrentOptions{Category: "pt", SavePath: "/downloads", Tags: "a"}}
+
+	opts := OptionsFromTorrent(TorrentItem{Category: "Movies", Tags: []string{"x"}}, policy)
+	assert.Equal(t, policy.Base, opts)
+}
+
+func TestOptionsFromTorrents(t *testing.T) {
+	items := []TorrentItem{
+		{SourceSite: "a", Category: "Movies"},
+		{SourceSite: "b/c", Category: "TV Series", Tags: []string{"a,b"}},
+	}
+
+	got := OptionsFromTorrents(items, testAddOptionsPolicy())
+	require.Len(t, got, 2)
+	assert.Equal(t, "/data/a/movie", got[0].SavePath)
+	assert.Equal(t, "/data/b_c/tv", got[1].SavePath, "path separators in values are escaped")
+	assert.Equal(t, "pt-tools,b/c,ab", got[1].Tags)
+	assert.Empty(t, OptionsFromTorrents(nil, testAddOptionsPolicy()))
+}
@@ -22,8 +22,24 @@ type BaseSite[Req any, Res any] struct {
 	creds    Credentials
 	loggedIn bool
 	mu       sync.RWMutex
+	// respectCrawlDelay applies the driver's robots.txt Crawl-delay before the first request
+	respectCrawlDelay bool
+	// crawlDelayMu guards crawlDelayDone and crawlDelayRetryAt; it is held
+	// during the fetch so concurrent first requests wait for the delay
+	crawlDelayMu      sync.Mutex
+	crawlDelayDone    bool
+	crawlDelayRetryAt time.Time
 }
 
+const (
+	// crawlDelayFetchTimeout bounds the robots.txt fetch, which runs on its
+	// own context so a short caller deadline cannot skip it
+	crawlDelayFetchTimeout = 10 * time.Second
+	// crawlDelayRetryInterval is how long a failed robots.txt fetch waits
+	// before it is tried again
+	crawlDelayRetryInterval = 10 * time.Minute
+)
+
 // BaseSiteConfig holds configuration for creating a BaseSite
 type BaseSiteConfig struct {
 	ID        string
@@ -31,7 +47,10 @@ type BaseSiteConfig struct {
 	Kind      SiteKind
 	RateLimit float64 // Requests per second
 	RateBurst int     // Maximum burst size
-	Logger    *zap.Logger
+	// RespectCrawlDelay slows the rate limiter to the site's robots.txt
+	// Crawl-delay, when the driver implements CrawlDelayFetcher
+	RespectCrawlDelay bool
+	Logger            *zap.Logger
 }
 
 // NewBaseSite creates a new BaseSite with the given driver and configuration
@@ -52,15 +71,67 @@ func NewBaseSite[Req, Res any](driver Driver[Req, Res], config BaseSiteConfig) *
 	}
 
 	return &BaseSite[Req, Res]{
-		id:      config.ID,
-		name:    config.Name,
-		kind:    config.Kind,
-		driver:  driver,
-		limiter: rate.NewLimiter(rate.Limit(rateLimit), rateBurst),
-		logger:  logger,
+		id:                config.ID,
+		name:              config.Name,
+		kind:              config.Kind,
+		driver:            driver,
+		limiter:           rate.NewLimiter(rate.Limit(rateLimit), rateBurst),
+		logger:            logger,
+		respectCrawlDelay: config.RespectCrawlDelay,
 	}
 }
 
+// ApplyCrawlDelay limits requests to one per delay, on the site limiter and on
+// the driver's own limiter when it has one. It only ever slows the limiters
+// down; a delay faster than the configured rate is ignored.
+func (b *BaseSite[Req, Res]) ApplyCrawlDelay(delay time.Duration) {
+	if delay <= 0 {
+		return
+	}
+	if applier, ok := any(b.driver).(CrawlDelayApplier); ok {
+		applier.ApplyCrawlDelay(delay)
+	}
+	if !slowLimiter(b.limiter, delay) {
+		return
+	}
+	b.logger.Info("Applied robots.txt crawl delay", zap.String("site", b.name), zap.Duration("delay", delay))
+}
+
+// wait blocks on the rate limiter, first applying the site's crawl delay
+// when RespectCrawlDelay is set
+func (b *BaseSite[Req, Res]) wait(ctx context.Context) error {
+	if b.respectCrawlDelay {
+		b.ensureCrawlDelay()
+	}
+	return b.limiter.Wait(ctx)
+}
+
+// ensureCrawlDelay fetches and applies the crawl delay until it succeeds once.
+// A failed fetch is retried after crawlDelayRetryInterval.
+func (b *BaseSite[Req, Res]) ensureCrawlDelay() {
+	b.crawlDelayMu.Lock()
+	defer b.crawlDelayMu.Unlock()
+	if b.crawlDelayDone || time.Now().Before(b.crawlDelayRetryAt) {
+		return
+	}
+	fetcher, ok := any(b.driver).(CrawlDelayFetcher)
+	if !ok {
+		b.crawlDelayDone = true
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), crawlDelayFetchTimeout)
+	defer cancel()
+	delay, err := fetcher.FetchCrawlDelay(ctx)
+	if err != nil {
+		b.crawlDelayRetryAt = time.Now().Add(crawlDelayRetryInterval)
+		b.logger.Debug("Failed to fetch crawl delay", zap.String("site", b.name), zap.Error(err))
+		return
+	}
+	b.crawlDelayDone = true
+	b.ApplyCrawlDelay(delay)
+}
+
 // ID returns the unique site identifier
 func (b *BaseSite[Req, Res]) ID() string {
 	return b.id
@@ -102,7 +173,7 @@ func (b *BaseSite[Req, Res]) Search(ctx context.Context, query SearchQuery) ([]T
 	}
 
 	// Rate limiting
-	if err := b.limiter.Wait(ctx); err != nil {
+	if err := b.wait(ctx); err != nil {
 		b.logger.Warn("Rate limit wait failed", zap.Error(err))
 		return nil, fmt.Errorf("rate limit: %w", err)
 	}
@@ -135,6 +206,9 @@ func (b *BaseSite[Req, Res]) Search(ctx context.Context, query SearchQuery) ([]T
 		b.logger.Error("Failed to parse search response", zap.Error(err))
 		return nil, fmt.Errorf("parse search: %w", err)
 	}
+	if query.MinSnatched > 0 {
+		items = filterMinSnatched(items, query.MinSnatched)
+	}
 
 	// Set source site for all items
 	for i := range items {
@@ -151,10 +225,21 @@ func (b *BaseSite[Req, Res]) Search(ctx context.Context, query SearchQuery) ([]T
 	return items, nil
 }
 
+// filterMinSnatched keeps the items snatched at least minSnatched times
+func filterMinSnatched(items []TorrentItem, minSnatched int) []TorrentItem {
+	filtered := items[:0]
+	for _, item := range items {
+		if item.Snatched >= minSnatched {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
 // GetUserInfo fetches the current user's information
 func (b *BaseSite[Req, Res]) GetUserInfo(ctx context.Context) (UserInfo, error) {
 	// Rate limiting
-	if err := b.limiter.Wait(ctx); err != nil {
+	if err := b.wait(ctx); err != nil {
 		return UserInfo{}, fmt.Errorf("rate limit: %w", err)
 	}
 
@@ -185,7 +270,7 @@ func (b *BaseSite[Req, Res]) GetUserInfo(ctx context.Context) (UserInfo, error)
 // Download downloads a torrent file by ID
 func (b *BaseSite[Req, Res]) Download(ctx context.Context, torrentID string) ([]byte, error) {
 	// Rate limiting
-	if err := b.limiter.Wait(ctx); err != nil {
+	if err := b.wait(ctx); err != nil {
 		return nil, fmt.Errorf("rate limit: %w", err)
 	}
 
@@ -250,7 +335,7 @@ func (b *BaseSite[Req, Res]) GetRateLimiter() *rate.Limiter {
 
 // DownloadWithHash downloads a torrent using hash if driver supports it
 func (b *BaseSite[Req, Res]) DownloadWithHash(ctx context.Context, torrentID, hash string) ([]byte, error) {
-	if err := b.limiter.Wait(ctx); err != nil {
+	if err := b.wait(ctx); err != nil {
 		return nil, fmt.Errorf("rate limit: %w", err)
 	}
 
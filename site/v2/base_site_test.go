@@ -135,6 +135,37 @@ func TestBaseSite_Search(t *testing.T) {
 	driver.AssertExpectations(t)
 }
 
+func TestBaseSite_Search_MinSnatched(t *testing.T) {
+	driver := &MockDriver{}
+	site := NewBaseSite(driver, BaseSiteConfig{
+		ID:        "test-site",
+		Name:      "Test Site",
+		Kind:      SiteNexusPHP,
+		RateLimit: 100,
+		RateBurst: 100,
+		Logger:    zap.NewNop(),
+	})
+
+	query := SearchQuery{Keyword: "test", MinSnatched: 10}
+	driver.On("PrepareSearch", query).Return("prepared-request", nil)
+	driver.On("Execute", mock.Anything, "prepared-request").Return("response", nil)
+	driver.On("ParseSearch", "response").Return([]TorrentItem{
+		{ID: "1", Title: "Popular", Snatched: 120},
+		{ID: "2", Title: "Niche", Snatched: 3},
+		{ID: "3", Title: "Threshold", Snatched: 10},
+		{ID: "4", Title: "Unknown"},
+	}, nil)
+
+	items, err := site.Search(context.Background(), query)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "1", items[0].ID)
+	assert.Equal(t, "3", items[1].ID, "rows exactly at the threshold are kept")
+
+	_, err = site.Search(context.Background(), SearchQuery{Keyword: "test", MinSnatched: -1})
+	assert.ErrorContains(t, err, "minSnatched")
+}
+
 func TestBaseSite_Search_InvalidQuery(t *testing.T) {
 	driver := &MockDriver{}
 	site := NewBaseSite(driver, BaseSiteConfig{
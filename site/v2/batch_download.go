@@ -14,6 +14,8 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
 )
 
 // Batch download errors
@@ -51,8 +53,9 @@ type FreeTorrentBatchResult struct {
 
 // BatchDownloadService provides batch download functionality for free torrents
 type BatchDownloadService struct {
-	site   Site
-	logger *zap.Logger
+	site         Site
+	logger       *zap.Logger
+	filenameOpts downloader.FilenameOptions
 }
 
 // NewBatchDownloadService creates a new batch download service
@@ -61,9 +64,19 @@ func NewBatchDownloadService(site Site, logger *zap.Logger) *BatchDownloadServic
 		logger = zap.NewNop()
 	}
 	return &BatchDownloadService{
-		site:   site,
-		logger: logger,
+		site:         site,
+		logger:       logger,
+		filenameOpts: downloader.DefaultFilenameOptions(),
+	}
+}
+
+// SetFilenameOptions configures how torrent files are named on disk.
+// The extension defaults to .torrent when left empty.
+func (s *BatchDownloadService) SetFilenameOptions(opts downloader.FilenameOptions) {
+	if opts.Extension == "" {
+		opts.Extension = ".torrent"
 	}
+	s.filenameOpts = opts
 }
 
 // FetchFreeTorrents fetches all free torrents from the site
@@ -152,7 +165,7 @@ func (s *BatchDownloadService) DownloadFreeTorrents(
 		}
 
 		// Save to temp file
-		filename := fmt.Sprintf("%s.torrent", sanitizeFilename(t.Title))
+		filename := downloader.SanitizeFilename(t.Title, s.filenameOpts)
 		filepath := filepath.Join(tempDir, filename)
 		if writeErr := os.WriteFile(filepath, data, 0o644); writeErr != nil {
 			s.logger.Warn(
@@ -341,70 +354,3 @@ func addFileToZip(zw *zip.Writer, baseDir, filePath string) error {
 	_, err = io.Copy(writer, file)
 	return err
 }
-
-// sanitizeFilename removes or replaces invalid characters in a filename
-func sanitizeFilename(name string) string {
-	// Replace invalid characters with underscore
-	invalid := []string{"/", "\\", ":", "*", "?", "\"", "<", ">", "|"}
-	result := name
-	for _, char := range invalid {
-		result = replaceAll(result, char, "_")
-	}
-
-	// Limit length
-	if len(result) > 200 {
-		result = result[:200]
-	}
-
-	// Remove leading/trailing spaces and dots
-	result = trimChars(result, " .")
-
-	if result == "" {
-		result = "unnamed"
-	}
-
-	return result
-}
-
-// replaceAll replaces all occurrences of old with new in s
-func replaceAll(s, old, new string) string {
-	for {
-		idx := indexOf(s, old)
-		if idx == -1 {
-			break
-		}
-		s = s[:idx] + new + s[idx+len(old):]
-	}
-	return s
-}
-
-// indexOf returns the index of substr in s, or -1 if not found
-func indexOf(s, substr string) int {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
-			return i
-		}
-	}
-	return -1
-}
-
-// trimChars removes leading and trailing characters from s
-func trimChars(s, chars string) string {
-	for len(s) > 0 && containsChar(chars, s[0]) {
-		s = s[1:]
-	}
-	for len(s) > 0 && containsChar(chars, s[len(s)-1]) {
-		s = s[:len(s)-1]
-	}
-	return s
-}
-
-// containsChar checks if chars contains c
-func containsChar(chars string, c byte) bool {
-	for i := 0; i < len(chars); i++ {
-		if chars[i] == c {
-			return true
-		}
-	}
-	return false
-}
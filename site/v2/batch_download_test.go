@@ -17,6 +17,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
 )
 
 // TestBatchDownloadManifest tests manifest creation
@@ -268,6 +270,7 @@ type fakeBatchSite struct {
 	downloadErr error
 	data        []byte
 	downloadMap map[string]error // per-torrent download errors
+	userInfo    UserInfo
 }
 
 func (f *fakeBatchSite) ID() string { return f.id }
@@ -281,7 +284,7 @@ func (f *fakeBatchSite) Login(ctx context.Context, creds Credentials) error {
 }
 
 func (f *fakeBatchSite) GetUserInfo(ctx context.Context) (UserInfo, error) {
-	return UserInfo{}, nil
+	return f.userInfo, nil
 }
 
 func (f *fakeBatchSite) Close() error { return nil }
@@ -439,44 +442,6 @@ func TestBatchService_DownloadFreeTorrents_ContextCanceled(t *testing.T) {
 	assert.ErrorIs(t, err, context.Canceled)
 }
 
-func TestSanitizeFilename(t *testing.T) {
-	assert.Equal(t, "normal_name", sanitizeFilename("normal_name"))
-	assert.Equal(t, "a_b_c_d", sanitizeFilename("a/b\\c:d"))
-	assert.Equal(t, "no_stars_", sanitizeFilename("no*stars?"))
-	assert.Equal(t, "unnamed", sanitizeFilename("   "))
-	assert.Equal(t, "unnamed", sanitizeFilename("..."))
-	long := strings.Repeat("x", 300)
-	assert.Len(t, sanitizeFilename(long), 200)
-	// leading/trailing dots and spaces trimmed
-	assert.Equal(t, "trimmed", sanitizeFilename(" .trimmed. "))
-}
-
-func TestReplaceAll(t *testing.T) {
-	assert.Equal(t, "a_b_c", replaceAll("a-b-c", "-", "_"))
-	assert.Equal(t, "no change", replaceAll("no change", "x", "y"))
-	assert.Equal(t, "", replaceAll("aaa", "a", ""))
-}
-
-func TestIndexOf(t *testing.T) {
-	assert.Equal(t, 0, indexOf("hello", "he"))
-	assert.Equal(t, 2, indexOf("hello", "ll"))
-	assert.Equal(t, -1, indexOf("hello", "z"))
-	assert.Equal(t, -1, indexOf("ab", "abc"))
-}
-
-func TestTrimChars(t *testing.T) {
-	assert.Equal(t, "abc", trimChars("...abc...", "."))
-	assert.Equal(t, "abc", trimChars("  abc  ", " "))
-	assert.Equal(t, "", trimChars("....", "."))
-	assert.Equal(t, "abc", trimChars("abc", "x"))
-}
-
-func TestContainsChar(t *testing.T) {
-	assert.True(t, containsChar("abc", 'b'))
-	assert.False(t, containsChar("abc", 'z'))
-	assert.False(t, containsChar("", 'a'))
-}
-
 func TestAddFileToTar_Error(t *testing.T) {
 	// Nonexistent file should error.
 	var buf strings.Builder
@@ -489,3 +454,26 @@ func TestAddFileToZip_Error(t *testing.T) {
 	err := createZipArchive(filepath.Join(t.TempDir(), "out.zip"), t.TempDir(), []string{"/nonexistent/file.txt"})
 	assert.Error(t, err)
 }
+
+func TestDownloadFreeTorrents_FilenameOptions(t *testing.T) {
+	site := &fakeBatchSite{
+		id:    "test",
+		items: []TorrentItem{{ID: "1", Title: "Free Movie 2025", DiscountLevel: DiscountFree}},
+		data:  []byte("d4:infod4:name1:xee"),
+	}
+	svc := NewBatchDownloadService(site, zap.NewNop())
+	svc.SetFilenameOptions(downloader.FilenameOptions{Prefix: "test_", SpaceReplacement: "."})
+
+	result, err := svc.DownloadFreeTorrents(context.Background(), "zip", t.TempDir())
+	require.NoError(t, err)
+
+	r, err := zip.OpenReader(result.ArchivePath)
+	require.NoError(t, err)
+	defer r.Close()
+
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+	assert.Contains(t, names, "test_Free.Movie.2025.torrent")
+}
@@ -0,0 +1,60 @@
+package v2
+
+import (
+	"mime"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// charsetSniffLen is how much of the body is searched for a <meta> charset
+const charsetSniffLen = 4096
+
+// metaCharsetRegex matches both <meta charset="gbk"> and
+// <meta http-equiv="Content-Type" content="text/html; charset=gb2312">
+var metaCharsetRegex = regexp.MustCompile(`(?i)<meta[^>]+charset\s*=\s*["']?\s*([\w.:-]+)`)
+
+// detectCharset returns the charset declared by the Content-Type header or,
+// failing that, a <meta> tag near the start of body. Empty when undeclared.
+func detectCharset(contentType string, body []byte) string {
+	if contentType != "" {
+		if _, params, err := mime.ParseMediaType(contentType); err == nil {
+			if cs := strings.TrimSpace(params["charset"]); cs != "" {
+				return strings.ToLower(cs)
+			}
+		}
+	}
+	head := body
+	if len(head) > charsetSniffLen {
+		head = head[:charsetSniffLen]
+	}
+	if m := metaCharsetRegex.FindSubmatch(head); m != nil {
+		return strings.ToLower(string(m[1]))
+	}
+	return ""
+}
+
+// decodeHTML transcodes body to UTF-8. charset overrides detection; an
+// unknown or UTF-8 charset, or a decoding failure, returns body unchanged.
+func decodeHTML(body []byte, contentType, charset string) []byte {
+	if charset == "" {
+		charset = detectCharset(contentType, body)
+	}
+	charset = strings.ToLower(strings.TrimSpace(charset))
+	if charset == "" || charset == "utf-8" || charset == "utf8" {
+		return body
+	}
+	enc, err := htmlindex.Get(charset)
+	if err != nil {
+		return body
+	}
+	if name, _ := htmlindex.Name(enc); name == "utf-8" {
+		return body
+	}
+	decoded, err := enc.NewDecoder().Bytes(body)
+	if err != nil {
+		return body
+	}
+	return decoded
+}
@@ -0,0 +1,86 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+const gbkDetailsPage = `<html><head>
+<meta http-equiv="Content-Type" content="text/html; charset=gb2312">
+<title>种子详情</title></head><body>
+<table>
+<tr><td class="rowhead">最低分享率要求</td><td>0.8</td></tr>
+</table>
+</body></html>`
+
+// gbkUndeclaredPage has no <meta> charset of its own
+const gbkUndeclaredPage = `<html><body><table>
+<tr><td class="rowhead">最低分享率要求</td><td>0.8</td></tr>
+</table></body></html>`
+
+func gbkBytes(t *testing.T, s string) []byte {
+	t.Helper()
+	data, err := simplifiedchinese.GBK.NewEncoder().Bytes([]byte(s))
+	require.NoError(t, err)
+	return data
+}
+
+func TestDetectCharset(t *testing.T) {
+	assert.Equal(t, "gbk", detectCharset("text/html; charset=GBK", nil))
+	assert.Equal(t, "gb2312", detectCharset("text/html", []byte(`<meta http-equiv="Content-Type" content="text/html; charset=gb2312">`)))
+	assert.Equal(t, "gbk", detectCharset("", []byte(`<html><head><meta charset="gbk"></head>`)))
+	assert.Equal(t, "utf-8", detectCharset("text/html; charset=utf-8", []byte(`<meta charset="gbk">`)), "header wins over meta")
+	assert.Equal(t, "", detectCharset("text/html", []byte(`<html></html>`)))
+}
+
+func TestDecodeHTML(t *testing.T) {
+	raw := gbkBytes(t, "最低分享率")
+	assert.Equal(t, "最低分享率", string(decodeHTML(raw, "text/html; charset=gbk", "")))
+	assert.Equal(t, "最低分享率", string(decodeHTML(raw, "", "GB2312")), "explicit charset needs no declaration")
+
+	utf8 := []byte("最低分享率")
+	assert.Equal(t, utf8, decodeHTML(utf8, "text/html; charset=utf-8", ""))
+	assert.Equal(t, utf8, decodeHTML(utf8, "", ""))
+	assert.Equal(t, raw, decodeHTML(raw, "text/html; charset=x-unknown", ""), "unknown charsets are left alone")
+}
+
+func TestNexusPHPDriver_Execute_GBKPage(t *testing.T) {
+	tests := []struct {
+		name        string
+		page        string
+		contentType string
+		charset     string
+	}{
+		{"meta tag", gbkDetailsPage, "text/html", ""},
+		{"content type header", gbkUndeclaredPage, "text/html; charset=gbk", ""},
+		{"configured charset", gbkUndeclaredPage, "text/html", "gbk"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := gbkBytes(t, tt.page)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				_, _ = w.Write(body)
+			}))
+			defer server.Close()
+
+			d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", Charset: tt.charset})
+			req, err := d.PrepareDetail("1")
+			require.NoError(t, err)
+			res, err := d.Execute(context.Background(), req)
+			require.NoError(t, err)
+
+			detail, err := d.ParseDetail(res)
+			require.NoError(t, err)
+			assert.Equal(t, 0.8, detail.MinRatioRequired)
+			assert.Contains(t, string(res.RawBody), "最低分享率要求")
+		})
+	}
+}
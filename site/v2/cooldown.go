@@ -0,0 +1,127 @@
+package v2
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// DefaultCooldownPattern matches the anti-spam notices NexusPHP sites show
+// with a 200 status after rapid requests
+const DefaultCooldownPattern = `请稍后再试|请求过于频繁|操作过于频繁|(?i)too many requests|please wait \d+`
+
+// DefaultCooldownNotice selects the stdmsg box NexusPHP renders notices in
+const DefaultCooldownNotice = "td.text"
+
+// cooldownMessageLimit caps RateLimitError.Message, in runes
+const cooldownMessageLimit = 200
+
+var defaultCooldownRegex = regexp.MustCompile(DefaultCooldownPattern)
+
+// cooldownWaitRegex extracts the wait from a notice, e.g. "请 30 秒后再试" or "wait 2 minutes"
+var cooldownWaitRegex = regexp.MustCompile(`(?i)(\d+)\s*(秒|分钟|分|小时|seconds?|secs?|minutes?|mins?|hours?)`)
+
+// RateLimitError reports a cooldown notice from the site. It wraps
+// ErrRateLimited; Wait is zero when the notice gives no duration.
+type RateLimitError struct {
+	Wait    time.Duration
+	Message string
+}
+
+func (e *RateLimitError) Error() string {
+	if e.Wait > 0 {
+		return fmt.Sprintf("%s: retry after %s", ErrRateLimited, e.Wait)
+	}
+	return ErrRateLimited.Error()
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return ErrRateLimited
+}
+
+// ParseCooldownNotice reports whether text contains a cooldown notice matching
+// pattern (DefaultCooldownPattern when empty) and the wait it asks for
+func ParseCooldownNotice(text, pattern string) (time.Duration, bool) {
+	re := compileCooldownPattern(pattern)
+	if re == nil {
+		return 0, false
+	}
+	return matchCooldownNotice(text, re)
+}
+
+// compileCooldownPattern compiles pattern, DefaultCooldownPattern when empty;
+// nil means the pattern is invalid
+func compileCooldownPattern(pattern string) *regexp.Regexp {
+	if pattern == "" {
+		return defaultCooldownRegex
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil
+	}
+	return re
+}
+
+func matchCooldownNotice(text string, re *regexp.Regexp) (time.Duration, bool) {
+	loc := re.FindStringIndex(text)
+	if loc == nil {
+		return 0, false
+	}
+
+	// Prefer a duration near the notice over one elsewhere in the text
+	start := max(loc[0]-64, 0)
+	end := min(loc[1]+64, len(text))
+	if wait := parseCooldownWait(text[start:end]); wait > 0 {
+		return wait, true
+	}
+	return parseCooldownWait(text), true
+}
+
+func parseCooldownWait(text string) time.Duration {
+	m := cooldownWaitRegex.FindStringSubmatch(text)
+	if m == nil {
+		return 0
+	}
+	n, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0
+	}
+	unit := strings.ToLower(m[2])
+	switch {
+	case unit == "分钟" || unit == "分" || strings.HasPrefix(unit, "min"):
+		return time.Duration(n) * time.Minute
+	case unit == "小时" || strings.HasPrefix(unit, "hour"):
+		return time.Duration(n) * time.Hour
+	default:
+		return time.Duration(n) * time.Second
+	}
+}
+
+// cooldownError returns a RateLimitError when doc shows a cooldown notice
+// in its notice box (Selectors.CooldownNotice). Pages that list torrents are
+// never treated as notices.
+func (d *NexusPHPDriver) cooldownError(doc *goquery.Document) error {
+	if d.cooldownRegex == nil || d.findSearchRows(doc).Length() > 0 {
+		return nil
+	}
+	selector := d.Selectors.CooldownNotice
+	if selector == "" {
+		selector = DefaultCooldownNotice
+	}
+	text := strings.Join(strings.Fields(doc.Find(selector).Text()), " ")
+	if text == "" {
+		return nil
+	}
+	wait, ok := matchCooldownNotice(text, d.cooldownRegex)
+	if !ok {
+		return nil
+	}
+	if runes := []rune(text); len(runes) > cooldownMessageLimit {
+		text = string(runes[:cooldownMessageLimit]) + "..."
+	}
+	return &RateLimitError{Wait: wait, Message: text}
+}
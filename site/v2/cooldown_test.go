@@ -0,0 +1,127 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCooldownNotice(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		pattern string
+		wait    time.Duration
+		ok      bool
+	}{
+		{"seconds", "请 30 秒后再试，请稍后再试", "", 30 * time.Second, true},
+		{"minutes", "请求过于频繁，请2分钟后刷新", "", 2 * time.Minute, true},
+		{"english", "Too many requests, please retry in 10 seconds", "", 10 * time.Second, true},
+		{"no wait", "请稍后再试", "", 0, true},
+		{"custom pattern", "刷新冷却中：还剩 15 秒", "刷新冷却中", 15 * time.Second, true},
+		{"no notice", "种子列表 1.5 GB 30 秒前", "", 0, false},
+		{"invalid pattern", "请稍后再试", "(", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wait, ok := ParseCooldownNotice(tt.text, tt.pattern)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.wait, wait)
+		})
+	}
+}
+
+func TestRateLimitError_Is(t *testing.T) {
+	err := error(&RateLimitError{Wait: time.Minute})
+	assert.ErrorIs(t, err, ErrRateLimited)
+	assert.Contains(t, err.Error(), "1m0s")
+
+	var rl *RateLimitError
+	require.True(t, errors.As(err, &rl))
+	assert.Equal(t, time.Minute, rl.Wait)
+}
+
+func TestNexusPHPDriver_Execute_CooldownNotice(t *testing.T) {
+	body, err := os.ReadFile("testdata/nexusphp_cooldown.html")
+	require.NoError(t, err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	req, err := d.PrepareSearch(SearchQuery{Keyword: "test"})
+	require.NoError(t, err)
+
+	_, err = d.Execute(context.Background(), req)
+	require.ErrorIs(t, err, ErrRateLimited)
+
+	var rl *RateLimitError
+	require.True(t, errors.As(err, &rl))
+	assert.Equal(t, 45*time.Second, rl.Wait)
+}
+
+func TestNexusPHPDriver_Execute_CooldownIgnoredOnListing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><body><table class="torrents"><tbody>
+			<tr><td>Type</td><td>Name</td></tr>
+			<tr><td></td><td><a href="details.php?id=1">请稍后再试 Documentary</a></td></tr>
+		</tbody></table></body></html>`))
+	}))
+	t.Cleanup(server.Close)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	req, err := d.PrepareSearch(SearchQuery{Keyword: "test"})
+	require.NoError(t, err)
+
+	res, err := d.Execute(context.Background(), req)
+	require.NoError(t, err)
+	items, err := d.ParseSearch(res)
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+}
+
+func TestNexusPHPDriver_Execute_CooldownIgnoredInDescription(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><body><h1 id="top">Movie</h1>
+			<table><tr><td class="rowhead">简介</td><td class="rowfollow"><div id="kdescr">
+				服务器繁忙时请稍后再试，或 30 秒后刷新。
+			</div></td></tr></table></body></html>`))
+	}))
+	t.Cleanup(server.Close)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	req, err := d.PrepareDetail("1")
+	require.NoError(t, err)
+
+	_, err = d.Execute(context.Background(), req)
+	assert.NoError(t, err)
+}
+
+func TestNexusPHPDriver_CooldownError_CustomNoticeAndTruncation(t *testing.T) {
+	selectors := DefaultNexusPHPSelectors()
+	selectors.CooldownPattern = "刷新冷却中"
+	selectors.CooldownNotice = "div.notice"
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &selectors})
+	require.NotNil(t, d.cooldownRegex)
+
+	long := strings.Repeat("说明", 200)
+	doc := mustDoc(t, `<html><body><div class="notice">刷新冷却中：还剩 15 秒。`+long+`</div></body></html>`)
+	err := d.cooldownError(doc)
+	var rl *RateLimitError
+	require.True(t, errors.As(err, &rl))
+	assert.Equal(t, 15*time.Second, rl.Wait)
+	assert.Equal(t, cooldownMessageLimit+len("..."), len([]rune(rl.Message)))
+
+	// The default pattern no longer applies once a custom one is set
+	assert.NoError(t, d.cooldownError(mustDoc(t, `<html><body><div class="notice">请稍后再试</div></body></html>`)))
+}
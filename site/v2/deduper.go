@@ -148,3 +148,49 @@ func (d *Deduper) DeduplicateByTitle(items []TorrentItem, normalizer *Normalizer
 
 	return result
 }
+
+// DiffResults compares two result sets keyed by source site and torrent ID.
+// added holds items of cur missing from prev, removed holds items of prev
+// missing from cur, both in their original order. Items without an ID are
+// ignored since they cannot be matched across runs.
+func DiffResults(prev, cur []TorrentItem) (added, removed []TorrentItem) {
+	prevKeys := make(map[string]struct{}, len(prev))
+	for _, item := range prev {
+		if key := resultKey(item); key != "" {
+			prevKeys[key] = struct{}{}
+		}
+	}
+	curKeys := make(map[string]struct{}, len(cur))
+	for _, item := range cur {
+		key := resultKey(item)
+		if key == "" {
+			continue
+		}
+		if _, dup := curKeys[key]; dup {
+			continue
+		}
+		curKeys[key] = struct{}{}
+		if _, ok := prevKeys[key]; !ok {
+			added = append(added, item)
+		}
+	}
+	for _, item := range prev {
+		key := resultKey(item)
+		if key == "" {
+			continue
+		}
+		if _, ok := curKeys[key]; !ok {
+			removed = append(removed, item)
+			curKeys[key] = struct{}{} // report duplicates in prev once
+		}
+	}
+	return added, removed
+}
+
+// resultKey identifies a torrent across result sets
+func resultKey(item TorrentItem) string {
+	if item.ID == "" {
+		return ""
+	}
+	return item.SourceSite + "\x00" + item.ID
+}
@@ -280,3 +280,61 @@ func TestDeduper_MergeDuplicates_DiscountEndTime(t *testing.T) {
 	assert.Equal(t, Discount2xFree, result[0].DiscountLevel)
 	assert.Equal(t, later, result[0].DiscountEndTime)
 }
+
+func TestDiffResults(t *testing.T) {
+	ids := func(items []TorrentItem) []string {
+		var out []string
+		for _, item := range items {
+			out = append(out, item.SourceSite+"/"+item.ID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name        string
+		prev        []TorrentItem
+		cur         []TorrentItem
+		wantAdded   []string
+		wantRemoved []string
+	}{
+		{
+			name:      "first run reports everything as added",
+			cur:       []TorrentItem{{ID: "1", SourceSite: "a"}, {ID: "2", SourceSite: "a"}},
+			wantAdded: []string{"a/1", "a/2"},
+		},
+		{
+			name:        "additions and removals",
+			prev:        []TorrentItem{{ID: "1", SourceSite: "a"}, {ID: "2", SourceSite: "a"}},
+			cur:         []TorrentItem{{ID: "2", SourceSite: "a"}, {ID: "3", SourceSite: "a"}},
+			wantAdded:   []string{"a/3"},
+			wantRemoved: []string{"a/1"},
+		},
+		{
+			name: "unchanged items are not reported even if fields change",
+			prev: []TorrentItem{{ID: "1", SourceSite: "a", Seeders: 1}},
+			cur:  []TorrentItem{{ID: "1", SourceSite: "a", Seeders: 50}},
+		},
+		{
+			name:        "same ID on different sites are distinct",
+			prev:        []TorrentItem{{ID: "1", SourceSite: "a"}},
+			cur:         []TorrentItem{{ID: "1", SourceSite: "b"}},
+			wantAdded:   []string{"b/1"},
+			wantRemoved: []string{"a/1"},
+		},
+		{
+			name:        "duplicates and missing IDs",
+			prev:        []TorrentItem{{ID: "9", SourceSite: "a"}, {ID: "9", SourceSite: "a"}, {Title: "no id"}},
+			cur:         []TorrentItem{{ID: "4", SourceSite: "a"}, {ID: "4", SourceSite: "a"}, {Title: "no id"}},
+			wantAdded:   []string{"a/4"},
+			wantRemoved: []string{"a/9"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := DiffResults(tt.prev, tt.cur)
+			assert.Equal(t, tt.wantAdded, ids(added))
+			assert.Equal(t, tt.wantRemoved, ids(removed))
+		})
+	}
+}
@@ -155,14 +155,28 @@ func DiscountPriority(level DiscountLevel) int {
 		return 100 // Best: free download + 2x upload
 	case DiscountFree:
 		return 90 // Free download
+	case DiscountNeutral:
+		return 80 // Free download, upload not counted
+	case Discount2x25:
+		return 74 // 25% download + 2x upload
+	case Discount2x30:
+		return 72 // 30% download + 2x upload
 	case Discount2x50:
 		return 70 // 50% download + 2x upload
+	case DiscountPercent25:
+		return 65 // 25% download
 	case DiscountPercent30:
 		return 60 // 30% download
 	case DiscountPercent50:
 		return 50 // 50% download
+	case Discount2x70:
+		return 45 // 70% download + 2x upload
 	case DiscountPercent70:
 		return 40 // 70% download
+	case Discount2x75:
+		return 37 // 75% download + 2x upload
+	case DiscountPercent75:
+		return 35 // 75% download
 	case Discount2xUp:
 		return 30 // 2x upload only
 	default:
@@ -187,3 +201,17 @@ func CompareDiscounts(a, b DiscountLevel) int {
 func IsBetterDiscount(a, b DiscountLevel) bool {
 	return CompareDiscounts(a, b) > 0
 }
+
+// SummarizeDiscounts counts items per discount level. Items whose discount
+// has already expired, or that have no level, are counted as DiscountNone.
+func SummarizeDiscounts(items []TorrentItem) map[DiscountLevel]int {
+	summary := make(map[DiscountLevel]int)
+	for i := range items {
+		level := items[i].DiscountLevel
+		if level == "" || !items[i].IsDiscountActive() {
+			level = DiscountNone
+		}
+		summary[level]++
+	}
+	return summary
+}
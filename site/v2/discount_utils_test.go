@@ -218,10 +218,12 @@ func TestDiscountPriority(t *testing.T) {
 	// Verify priority ordering
 	assert.Greater(t, DiscountPriority(Discount2xFree), DiscountPriority(DiscountFree))
 	assert.Greater(t, DiscountPriority(DiscountFree), DiscountPriority(Discount2x50))
-	assert.Greater(t, DiscountPriority(Discount2x50), DiscountPriority(DiscountPercent30))
+	assert.Greater(t, DiscountPriority(Discount2x50), DiscountPriority(DiscountPercent25))
+	assert.Greater(t, DiscountPriority(DiscountPercent25), DiscountPriority(DiscountPercent30))
 	assert.Greater(t, DiscountPriority(DiscountPercent30), DiscountPriority(DiscountPercent50))
 	assert.Greater(t, DiscountPriority(DiscountPercent50), DiscountPriority(DiscountPercent70))
-	assert.Greater(t, DiscountPriority(DiscountPercent70), DiscountPriority(Discount2xUp))
+	assert.Greater(t, DiscountPriority(DiscountPercent70), DiscountPriority(DiscountPercent75))
+	assert.Greater(t, DiscountPriority(DiscountPercent75), DiscountPriority(Discount2xUp))
 	assert.Greater(t, DiscountPriority(Discount2xUp), DiscountPriority(DiscountNone))
 }
 
@@ -266,6 +268,28 @@ func TestSuggestBestDiscount_Cov5(t *testing.T) {
 	assert.Equal(t, DiscountPercent50, SuggestBestDiscount(DiscountPercent50, time.Now().Add(time.Minute), time.Hour))
 }
 
+func TestSummarizeDiscounts(t *testing.T) {
+	items := []TorrentItem{
+		{ID: "1", DiscountLevel: DiscountFree},
+		{ID: "2", DiscountLevel: DiscountFree, DiscountEndTime: time.Now().Add(time.Hour)},
+		{ID: "3", DiscountLevel: Discount2xFree},
+		{ID: "4", DiscountLevel: DiscountPercent50},
+		{ID: "5", DiscountLevel: DiscountNone},
+		{ID: "6"},
+		{ID: "7", DiscountLevel: DiscountFree, DiscountEndTime: time.Now().Add(-time.Minute)},
+	}
+
+	summary := SummarizeDiscounts(items)
+	assert.Equal(t, map[DiscountLevel]int{
+		DiscountFree:      2,
+		Discount2xFree:    1,
+		DiscountPercent50: 1,
+		DiscountNone:      3,
+	}, summary)
+
+	assert.Empty(t, SummarizeDiscounts(nil))
+}
+
 // ---------------------------------------------------------------------------
 // hddolby Search error + getOrRefreshDetailCache array fallback
 // ---------------------------------------------------------------------------
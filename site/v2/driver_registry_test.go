@@ -55,3 +55,12 @@ func TestListRegisteredSchemas(t *testing.T) {
 	}
 	assert.True(t, found)
 }
+
+func TestCreateSiteFromDefinition_MTeamSchemaAlias(t *testing.T) {
+	def := &SiteDefinition{ID: "mteam", Schema: Schema("MTeam")}
+	opts, _ := json.Marshal(MTorrentOptions{APIKey: "key"})
+	site, err := CreateSiteFromDefinition(def, SiteConfig{ID: "mteam", Name: "M-Team", BaseURL: "https://api.m-team.cc", Options: opts}, zap.NewNop())
+	require.NoError(t, err)
+	require.NotNil(t, site)
+	assert.Equal(t, SiteMTorrent, site.Kind())
+}
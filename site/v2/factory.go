@@ -14,6 +14,12 @@ func mergeSelectors(dst, src *SiteSelectors) {
 	if src.TableRows != "" {
 		dst.TableRows = src.TableRows
 	}
+	if len(src.AlternateTableRows) > 0 {
+		dst.AlternateTableRows = src.AlternateTableRows
+	}
+	if src.SearchIframe != "" {
+		dst.SearchIframe = src.SearchIframe
+	}
 	if src.Title != "" {
 		dst.Title = src.Title
 	}
@@ -23,6 +29,12 @@ func mergeSelectors(dst, src *SiteSelectors) {
 	if src.Size != "" {
 		dst.Size = src.Size
 	}
+	if src.SizeBytesAttr != "" {
+		dst.SizeBytesAttr = src.SizeBytesAttr
+	}
+	if src.SizeBase != 0 {
+		dst.SizeBase = src.SizeBase
+	}
 	if src.Seeders != "" {
 		dst.Seeders = src.Seeders
 	}
@@ -35,6 +47,9 @@ func mergeSelectors(dst, src *SiteSelectors) {
 	if src.DiscountIcon != "" {
 		dst.DiscountIcon = src.DiscountIcon
 	}
+	if len(src.ClassFreeMapping) > 0 {
+		dst.ClassFreeMapping = src.ClassFreeMapping
+	}
 	if src.DiscountEndTime != "" {
 		dst.DiscountEndTime = src.DiscountEndTime
 	}
@@ -50,9 +65,42 @@ func mergeSelectors(dst, src *SiteSelectors) {
 	if src.HRIcon != "" {
 		dst.HRIcon = src.HRIcon
 	}
+	if src.OfficialBadge != "" {
+		dst.OfficialBadge = src.OfficialBadge
+	}
+	if len(src.OfficialKeywords) > 0 {
+		dst.OfficialKeywords = src.OfficialKeywords
+	}
+	if src.ExclusiveBadge != "" {
+		dst.ExclusiveBadge = src.ExclusiveBadge
+	}
+	if len(src.ExclusiveKeywords) > 0 {
+		dst.ExclusiveKeywords = src.ExclusiveKeywords
+	}
+	if src.FirstReleaseBadge != "" {
+		dst.FirstReleaseBadge = src.FirstReleaseBadge
+	}
+	if len(src.FirstReleaseKeywords) > 0 {
+		dst.FirstReleaseKeywords = src.FirstReleaseKeywords
+	}
+	if src.PendingBadge != "" {
+		dst.PendingBadge = src.PendingBadge
+	}
+	if len(src.PendingKeywords) > 0 {
+		dst.PendingKeywords = src.PendingKeywords
+	}
 	if src.Subtitle != "" {
 		dst.Subtitle = src.Subtitle
 	}
+	if src.GroupLink != "" {
+		dst.GroupLink = src.GroupLink
+	}
+	if src.Cards != nil {
+		dst.Cards = src.Cards
+	}
+	if src.UploaderClass != "" {
+		dst.UploaderClass = src.UploaderClass
+	}
 	if src.UserInfoUsername != "" {
 		dst.UserInfoUsername = src.UserInfoUsername
 	}
@@ -71,12 +119,51 @@ func mergeSelectors(dst, src *SiteSelectors) {
 	if src.UserInfoRank != "" {
 		dst.UserInfoRank = src.UserInfoRank
 	}
+	if src.UserInfoMobileBlock != "" {
+		dst.UserInfoMobileBlock = src.UserInfoMobileBlock
+	}
+	if src.UserDetailsSeeding != "" {
+		dst.UserDetailsSeeding = src.UserDetailsSeeding
+	}
+	if src.SeedingHRStatus != "" {
+		dst.SeedingHRStatus = src.SeedingHRStatus
+	}
 	if src.DetailDownloadLink != "" {
 		dst.DetailDownloadLink = src.DetailDownloadLink
 	}
 	if src.DetailSubtitle != "" {
 		dst.DetailSubtitle = src.DetailSubtitle
 	}
+	if src.DetailPoster != "" {
+		dst.DetailPoster = src.DetailPoster
+	}
+	if src.DetailPeers != "" {
+		dst.DetailPeers = src.DetailPeers
+	}
+	if src.PeerListTable != "" {
+		dst.PeerListTable = src.PeerListTable
+	}
+	if src.DetailTorrentLinks != "" {
+		dst.DetailTorrentLinks = src.DetailTorrentLinks
+	}
+	if src.DetailMinRatio != "" {
+		dst.DetailMinRatio = src.DetailMinRatio
+	}
+	if src.CooldownPattern != "" {
+		dst.CooldownPattern = src.CooldownPattern
+	}
+	if src.CooldownNotice != "" {
+		dst.CooldownNotice = src.CooldownNotice
+	}
+	if src.StatsOnlineUsers != "" {
+		dst.StatsOnlineUsers = src.StatsOnlineUsers
+	}
+	if src.StatsTorrentsToday != "" {
+		dst.StatsTorrentsToday = src.StatsTorrentsToday
+	}
+	if src.StatsTotalTorrents != "" {
+		dst.StatsTotalTorrents = src.StatsTotalTorrents
+	}
 }
 
 type SiteConfig struct {
@@ -92,6 +179,24 @@ type SiteConfig struct {
 type NexusPHPOptions struct {
 	Cookie    string         `json:"cookie"`
 	Selectors *SiteSelectors `json:"selectors,omitempty"`
+	// DownloadURLParams is a query string (e.g. "type=torrent") set on download URLs
+	DownloadURLParams string `json:"downloadUrlParams,omitempty"`
+	// PromotionPath overrides the promotion listing page (default "/promotion.php")
+	PromotionPath string `json:"promotionPath,omitempty"`
+	// RespectCrawlDelay slows requests to the Crawl-delay declared in robots.txt
+	RespectCrawlDelay bool `json:"respectCrawlDelay,omitempty"`
+	// PeerListPath overrides the peer list page (default "/viewpeerlist.php")
+	PeerListPath string `json:"peerListPath,omitempty"`
+	// Passkey lets downloads use download.php?id=...&passkey=... directly
+	Passkey string `json:"passkey,omitempty"`
+	// Charset forces the page encoding (e.g. "gbk") instead of detecting it
+	Charset string `json:"charset,omitempty"`
+	// DownloadConfirmPath is visited before downloads on sites that gate
+	// download.php behind a JS confirm cookie; "{id}" is the torrent ID
+	DownloadConfirmPath string `json:"downloadConfirmPath,omitempty"`
+	// ThanksPath is posted the torrent ID on sites that hide the download
+	// link until the user says thanks (e.g. "/thanks.php")
+	ThanksPath string `json:"thanksPath,omitempty"`
 }
 
 type MTorrentOptions struct {
@@ -132,6 +237,7 @@ func NewSiteFactory(logger *zap.Logger) *SiteFactory {
 var typeToSchemaMap = map[string]Schema{
 	"nexusphp": SchemaNexusPHP,
 	"mtorrent": SchemaMTorrent,
+	"mteam":    SchemaMTorrent,
 	"unit3d":   SchemaUnit3D,
 	"gazelle":  SchemaGazelle,
 	"hddolby":  SchemaHDDolby,
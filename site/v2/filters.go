@@ -44,6 +44,7 @@ func initFilters() {
 			"default":         defaultFilter,
 			"multiply":        multiplyFilter,
 			"divide":          divideFilter,
+			"bonusPerGiB":     bonusPerGiBFilter,
 		}
 		customFilters = make(map[string]FilterFunc)
 	})
@@ -365,6 +366,33 @@ func divideFilter(value any, args ...any) any {
 	return num
 }
 
+// Bonus shop option patterns: the upload amount and the plain numbers around it
+var (
+	bonusShopSizeRe   = regexp.MustCompile(`(?i)([\d.]+)\s*([KMGTP]i?B)`)
+	bonusShopNumberRe = regexp.MustCompile(`\d[\d,]*(?:\.\d+)?`)
+)
+
+// bonusPerGiBFilter computes the bonus price per GiB from a bonus shop option
+// such as "10.0 GB上传量 ... 2,500.0": the first size is the upload amount and
+// the last number after it is the price. Returns 0 when either is missing.
+func bonusPerGiBFilter(value any, args ...any) any {
+	str := toString(value)
+	loc := bonusShopSizeRe.FindStringIndex(str)
+	if loc == nil {
+		return float64(0)
+	}
+	size := parseSize(str[loc[0]:loc[1]])
+	prices := bonusShopNumberRe.FindAllString(str[loc[1]:], -1)
+	if size <= 0 || len(prices) == 0 {
+		return float64(0)
+	}
+	price, err := strconv.ParseFloat(strings.ReplaceAll(prices[len(prices)-1], ",", ""), 64)
+	if err != nil {
+		return float64(0)
+	}
+	return price / (float64(size) / float64(GB))
+}
+
 // Helper functions for type conversion
 
 func toString(v any) string {
@@ -259,6 +259,7 @@ func (d *GazelleDriver) ParseSearch(res GazelleResponse) ([]TorrentItem, error)
 				Snatched:      t.Snatches,
 				SourceSite:    d.BaseURL,
 				Tags:          group.Tags,
+				GroupID:       strconv.Itoa(group.GroupID),
 				DiscountLevel: parseGazelleDiscount(t.IsFreeleech, t.IsNeutralLeech, t.IsPersonalFL),
 			}
 
@@ -210,6 +210,7 @@ func TestGazelleDriver_ParseSearch(t *testing.T) {
 	require.Len(t, items, 1)
 
 	assert.Equal(t, "12345", items[0].ID)
+	assert.Equal(t, "100", items[0].GroupID)
 	assert.Contains(t, items[0].Title, "Test Artist")
 	assert.Contains(t, items[0].Title, "Test Album")
 	assert.Contains(t, items[0].Title, "FLAC")
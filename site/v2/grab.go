@@ -0,0 +1,224 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+// Grab errors
+var (
+	// ErrTorrentNotFree is returned when a free-only grab targets a torrent without an active free discount
+	ErrTorrentNotFree = errors.New("torrent is not free")
+	// ErrFreeExpiresTooSoon is returned when the free period ends before the estimated download can complete
+	ErrFreeExpiresTooSoon = errors.New("free period expires before download can complete")
+	// ErrTorrentPending is returned when the torrent still awaits moderation
+	ErrTorrentPending = errors.New("torrent is pending approval")
+	// ErrRatioRiskTooHigh is returned when a non-free torrent would count too much
+	// download against the user's uploaded total
+	ErrRatioRiskTooHigh = errors.New("torrent size is too large for current uploaded amount")
+)
+
+// TorrentTransform post-processes downloaded torrent bytes before they are hashed and added,
+// e.g. to add a backup tracker or strip the private flag
+type TorrentTransform func(data []byte) ([]byte, error)
+
+// IdentityTransform returns the torrent bytes unchanged
+func IdentityTransform(data []byte) ([]byte, error) {
+	return data, nil
+}
+
+// GrabOptions configures AddFromSite
+type GrabOptions struct {
+	// AddOptions are passed through to the downloader
+	AddOptions downloader.AddTorrentOptions
+	// FreeOnly refuses torrents that are not free, or whose free period
+	// cannot cover the estimated download time
+	FreeOnly bool
+	// AssumedSpeed is the download speed (bytes/s) used to estimate download time.
+	// Zero means the downloader's current download speed is used.
+	AssumedSpeed int64
+	// FreeMargin is extra time the free period must cover beyond the estimate
+	FreeMargin time.Duration
+	// MaxSizeToUploaded refuses torrents whose counted download size exceeds
+	// this fraction of the user's uploaded total, e.g. 0.05 for 5%. Free
+	// torrents are never refused. Zero disables the guard.
+	MaxSizeToUploaded float64
+	// UserInfo supplies the uploaded total for MaxSizeToUploaded; nil means
+	// it is fetched from the site
+	UserInfo *UserInfo
+	// IncludePending grabs torrents awaiting moderation instead of refusing them
+	IncludePending bool
+	// Seen, when set, skips torrents grabbed before and records new grabs,
+	// so a torrent ID is never downloaded twice
+	Seen SeenStore
+	// Transform is applied to the validated torrent bytes; nil means IdentityTransform
+	Transform TorrentTransform
+	// Logger is optional
+	Logger *zap.Logger
+}
+
+// GrabResult describes the outcome of AddFromSite
+type GrabResult struct {
+	// InfoHash is the SHA1 info-hash of the torrent
+	InfoHash string `json:"infoHash"`
+	// Skipped is true when the torrent already exists in the downloader
+	Skipped bool `json:"skipped"`
+	// Message is a human readable summary
+	Message string `json:"message,omitempty"`
+}
+
+// AddFromSite downloads a torrent from the site and adds it to the downloader.
+// Guards run before the download; the torrent is hashed and checked for
+// existence before it is added, so repeated grabs are skipped. With
+// opts.Seen, torrent IDs grabbed before are skipped without downloading.
+func AddFromSite(ctx context.Context, site Site, dl downloader.Downloader, item TorrentItem, opts GrabOptions) (*GrabResult, error) {
+	logger := opts.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	if opts.Seen != nil && opts.Seen.Seen(site.ID(), item.ID) {
+		return &GrabResult{Skipped: true, Message: "torrent already grabbed"}, nil
+	}
+
+	if !opts.IncludePending && !item.IsApproved() {
+		return nil, ErrTorrentPending
+	}
+
+	if opts.FreeOnly {
+		if !item.IsFree() || !item.IsDiscountActive() {
+			return nil, ErrTorrentNotFree
+		}
+		speed := opts.AssumedSpeed
+		if speed <= 0 {
+			if status, err := dl.GetClientStatus(); err == nil {
+				speed = status.DlSpeed
+			} else {
+				logger.Debug("Failed to get downloader speed", zap.Error(err))
+			}
+		}
+		if err := CheckFreeWindow(item, speed, opts.FreeMargin, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.MaxSizeToUploaded > 0 {
+		info := opts.UserInfo
+		if info == nil {
+			fetched, err := site.GetUserInfo(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("get user info: %w", err)
+			}
+			info = &fetched
+		}
+		if err := CheckRatioRisk(item, *info, opts.MaxSizeToUploaded); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := site.Download(ctx, item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTorrentDownloadFailed, err)
+	}
+
+	if _, err := ParseTorrent(data); err != nil {
+		return nil, fmt.Errorf("validate torrent: %w", err)
+	}
+
+	transform := opts.Transform
+	if transform == nil {
+		transform = IdentityTransform
+	}
+	data, err = transform(data)
+	if err != nil {
+		return nil, fmt.Errorf("transform torrent: %w", err)
+	}
+
+	hash, err := ComputeTorrentHash(data)
+	if err != nil {
+		return nil, fmt.Errorf("compute torrent hash: %w", err)
+	}
+
+	exists, err := dl.CheckTorrentExists(hash)
+	if err != nil {
+		logger.Warn("Failed to check torrent existence", zap.String("hash", hash), zap.Error(err))
+	}
+	if exists {
+		if opts.Seen != nil {
+			opts.Seen.MarkSeen(site.ID(), item.ID)
+		}
+		return &GrabResult{InfoHash: hash, Skipped: true, Message: "torrent already exists in downloader"}, nil
+	}
+
+	result, err := dl.AddTorrentFileEx(data, opts.AddOptions)
+	if err != nil {
+		return nil, fmt.Errorf("add torrent: %w", err)
+	}
+	if !result.Success {
+		return nil, fmt.Errorf("add torrent: %v", result.Message)
+	}
+	if opts.Seen != nil {
+		opts.Seen.MarkSeen(site.ID(), item.ID)
+	}
+
+	logger.Info(
+		"Added torrent from site",
+		zap.String("site", site.ID()),
+		zap.String("id", item.ID),
+		zap.String("hash", hash),
+	)
+
+	return &GrabResult{InfoHash: hash, Message: "torrent added"}, nil
+}
+
+// CheckFreeWindow returns ErrFreeExpiresTooSoon when a torrent of the given size
+// cannot be downloaded at speed (bytes/s) before its free period ends.
+// A torrent without an end time, or an unknown speed, always passes.
+func CheckFreeWindow(item TorrentItem, speed int64, margin time.Duration, now time.Time) error {
+	if item.DiscountEndTime.IsZero() {
+		return nil
+	}
+	remaining := item.DiscountEndTime.Sub(now)
+	if remaining <= margin {
+		return fmt.Errorf("%w: %s remaining", ErrFreeExpiresTooSoon, remaining.Round(time.Second))
+	}
+	if speed <= 0 || item.SizeBytes <= 0 {
+		return nil
+	}
+	estimate := time.Duration(float64(item.SizeBytes) / float64(speed) * float64(time.Second))
+	if estimate+margin > remaining {
+		return fmt.Errorf("%w: needs %s, %s remaining",
+			ErrFreeExpiresTooSoon, estimate.Round(time.Second), remaining.Round(time.Second))
+	}
+	return nil
+}
+
+// CheckRatioRisk returns ErrRatioRiskTooHigh when the download a torrent counts
+// against the user, its size scaled by the active discount, exceeds
+// maxFraction of the uploaded total. Free torrents and a non-positive
+// maxFraction always pass.
+func CheckRatioRisk(item TorrentItem, info UserInfo, maxFraction float64) error {
+	if maxFraction <= 0 {
+		return nil
+	}
+	ratio := 1.0
+	if item.IsDiscountActive() {
+		ratio = item.DiscountLevel.GetDownloadRatio()
+	}
+	counted := int64(float64(item.SizeBytes) * ratio)
+	if counted <= 0 {
+		return nil
+	}
+	limit := int64(float64(info.Uploaded) * maxFraction)
+	if counted > limit {
+		return fmt.Errorf("%w: counts %s, limit %s",
+			ErrRatioRiskTooHigh, FormatBytes(counted), FormatBytes(limit))
+	}
+	return nil
+}
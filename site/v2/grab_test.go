@@ -0,0 +1,381 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/bencode"
+
+	"github.com/sunerpy/pt-tools/mocks"
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+func TestCheckFreeWindow(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	const mb = int64(1024 * 1024)
+
+	tests := []struct {
+		name    string
+		item    TorrentItem
+		speed   int64
+		margin  time.Duration
+		wantErr bool
+	}{
+		{
+			name:    "large torrent expiring soon is blocked",
+			item:    TorrentItem{SizeBytes: 50 * 1024 * mb, DiscountEndTime: now.Add(2 * time.Minute)},
+			speed:   10 * mb,
+			wantErr: true,
+		},
+		{
+			name:  "small torrent expiring soon is allowed",
+			item:  TorrentItem{SizeBytes: 100 * mb, DiscountEndTime: now.Add(2 * time.Minute)},
+			speed: 10 * mb,
+		},
+		{
+			name:  "no end time is allowed",
+			item:  TorrentItem{SizeBytes: 50 * 1024 * mb},
+			speed: mb,
+		},
+		{
+			name: "unknown speed is allowed",
+			item: TorrentItem{SizeBytes: 50 * 1024 * mb, DiscountEndTime: now.Add(2 * time.Minute)},
+		},
+		{
+			name:    "already expired is blocked",
+			item:    TorrentItem{SizeBytes: mb, DiscountEndTime: now.Add(-time.Minute)},
+			speed:   10 * mb,
+			wantErr: true,
+		},
+		{
+			name:    "margin is required beyond estimate",
+			item:    TorrentItem{SizeBytes: 100 * mb, DiscountEndTime: now.Add(2 * time.Minute)},
+			speed:   10 * mb,
+			margin:  2 * time.Minute,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckFreeWindow(tt.item, tt.speed, tt.margin, now)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrFreeExpiresTooSoon)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckRatioRisk(t *testing.T) {
+	const gb = int64(1024 * 1024 * 1024)
+	info := UserInfo{Uploaded: 1000 * gb}
+
+	tests := []struct {
+		name    string
+		item    TorrentItem
+		max     float64
+		wantErr bool
+	}{
+		{
+			name: "small non-free torrent is allowed",
+			item: TorrentItem{SizeBytes: 20 * gb},
+			max:  0.05,
+		},
+		{
+			name:    "huge non-free torrent is refused",
+			item:    TorrentItem{SizeBytes: 80 * gb},
+			max:     0.05,
+			wantErr: true,
+		},
+		{
+			name: "free torrent is always allowed",
+			item: TorrentItem{SizeBytes: 800 * gb, DiscountLevel: DiscountFree},
+			max:  0.05,
+		},
+		{
+			name: "active 50% discount halves the counted size",
+			item: TorrentItem{SizeBytes: 80 * gb, DiscountLevel: DiscountPercent50},
+			max:  0.05,
+		},
+		{
+			name:    "expired discount counts the full size",
+			item:    TorrentItem{SizeBytes: 80 * gb, DiscountLevel: DiscountPercent50, DiscountEndTime: time.Now().Add(-time.Hour)},
+			max:     0.05,
+			wantErr: true,
+		},
+		{
+			name: "zero fraction disables the guard",
+			item: TorrentItem{SizeBytes: 800 * gb},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckRatioRisk(tt.item, info, tt.max)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrRatioRiskTooHigh)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAddFromSite_RatioRiskTooHigh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+
+	site := &fakeBatchSite{
+		id:       "test",
+		data:     createTestTorrent("risky"),
+		userInfo: UserInfo{Uploaded: 100 * 1024 * 1024 * 1024},
+	}
+	item := TorrentItem{ID: "10", SizeBytes: 50 * 1024 * 1024 * 1024}
+
+	_, err := AddFromSite(context.Background(), site, mockDl, item, GrabOptions{MaxSizeToUploaded: 0.1})
+	assert.ErrorIs(t, err, ErrRatioRiskTooHigh)
+}
+
+func TestAddFromSite_RatioRiskSafe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+
+	data := createTestTorrent("safe")
+	hash, err := ComputeTorrentHash(data)
+	require.NoError(t, err)
+	mockDl.EXPECT().CheckTorrentExists(hash).Return(false, nil)
+	mockDl.EXPECT().AddTorrentFileEx(data, gomock.Any()).Return(downloader.AddTorrentResult{Success: true, Hash: hash}, nil)
+
+	site := &fakeBatchSite{id: "test", data: data}
+	item := TorrentItem{ID: "11", SizeBytes: 5 * 1024 * 1024 * 1024}
+
+	result, err := AddFromSite(context.Background(), site, mockDl, item, GrabOptions{
+		MaxSizeToUploaded: 0.1,
+		UserInfo:          &UserInfo{Uploaded: 100 * 1024 * 1024 * 1024},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, hash, result.InfoHash)
+}
+
+func TestAddFromSite_FreeExpiresTooSoon(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+	mockDl.EXPECT().GetClientStatus().Return(downloader.ClientStatus{DlSpeed: 10 * 1024 * 1024}, nil)
+
+	site := &fakeBatchSite{id: "test", data: createTestTorrent("big")}
+	item := TorrentItem{
+		ID:              "1",
+		SizeBytes:       80 * 1024 * 1024 * 1024,
+		DiscountLevel:   DiscountFree,
+		DiscountEndTime: time.Now().Add(2 * time.Minute),
+	}
+
+	_, err := AddFromSite(context.Background(), site, mockDl, item, GrabOptions{FreeOnly: true})
+	assert.ErrorIs(t, err, ErrFreeExpiresTooSoon)
+}
+
+func TestAddFromSite_SmallFreeTorrentAdded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+
+	data := createTestTorrent("small")
+	hash, err := ComputeTorrentHash(data)
+	require.NoError(t, err)
+
+	opts := downloader.AddTorrentOptions{Category: "pt", Tags: "free"}
+	mockDl.EXPECT().CheckTorrentExists(hash).Return(false, nil)
+	mockDl.EXPECT().AddTorrentFileEx(data, opts).Return(downloader.AddTorrentResult{Success: true, Hash: hash}, nil)
+
+	site := &fakeBatchSite{id: "test", data: data}
+	item := TorrentItem{
+		ID:              "2",
+		SizeBytes:       100 * 1024 * 1024,
+		DiscountLevel:   DiscountFree,
+		DiscountEndTime: time.Now().Add(2 * time.Minute),
+	}
+
+	result, err := AddFromSite(context.Background(), site, mockDl, item, GrabOptions{
+		AddOptions:   opts,
+		FreeOnly:     true,
+		AssumedSpeed: 10 * 1024 * 1024,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, hash, result.InfoHash)
+	assert.False(t, result.Skipped)
+}
+
+func TestAddFromSite_NeutralTorrentAdded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+
+	data := createTestTorrent("neutral")
+	hash, err := ComputeTorrentHash(data)
+	require.NoError(t, err)
+
+	mockDl.EXPECT().CheckTorrentExists(hash).Return(false, nil)
+	mockDl.EXPECT().AddTorrentFileEx(data, downloader.AddTorrentOptions{}).Return(downloader.AddTorrentResult{Success: true, Hash: hash}, nil)
+
+	site := &fakeBatchSite{id: "test", data: data}
+	item := TorrentItem{ID: "4", SizeBytes: 100 * 1024 * 1024, DiscountLevel: DiscountNeutral}
+	require.NoError(t, CheckRatioRisk(item, UserInfo{Uploaded: 1}, 0.01), "neutral download is not counted")
+
+	result, err := AddFromSite(context.Background(), site, mockDl, item, GrabOptions{
+		FreeOnly:     true,
+		AssumedSpeed: 10 * 1024 * 1024,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, hash, result.InfoHash)
+}
+
+func TestAddFromSite_NotFree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+
+	site := &fakeBatchSite{id: "test"}
+	_, err := AddFromSite(context.Background(), site, mockDl, TorrentItem{ID: "3", DiscountLevel: DiscountNone}, GrabOptions{FreeOnly: true})
+	assert.ErrorIs(t, err, ErrTorrentNotFree)
+}
+
+func TestAddFromSite_AlreadyExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+
+	data := createTestTorrent("dup")
+	hash, err := ComputeTorrentHash(data)
+	require.NoError(t, err)
+	mockDl.EXPECT().CheckTorrentExists(hash).Return(true, nil)
+
+	site := &fakeBatchSite{id: "test", data: data}
+	result, err := AddFromSite(context.Background(), site, mockDl, TorrentItem{ID: "4"}, GrabOptions{})
+	require.NoError(t, err)
+	assert.True(t, result.Skipped)
+}
+
+func TestAddFromSite_DownloadError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+
+	site := &fakeBatchSite{id: "test", downloadErr: errors.New("boom")}
+	_, err := AddFromSite(context.Background(), site, mockDl, TorrentItem{ID: "5"}, GrabOptions{})
+	assert.ErrorIs(t, err, ErrTorrentDownloadFailed)
+}
+
+func TestAddFromSite_TransformAppendsTracker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+
+	original := createTestTorrent("transform")
+	const backup = "http://backup.example.com/announce"
+
+	appendTracker := func(data []byte) ([]byte, error) {
+		var meta map[string]any
+		if err := bencode.DecodeBytes(data, &meta); err != nil {
+			return nil, err
+		}
+		announceList, _ := meta["announce-list"].([]any)
+		meta["announce-list"] = append(announceList, []any{backup})
+		return bencode.EncodeBytes(meta)
+	}
+	transformed, err := appendTracker(original)
+	require.NoError(t, err)
+	hash, err := ComputeTorrentHash(transformed)
+	require.NoError(t, err)
+
+	var added []byte
+	mockDl.EXPECT().CheckTorrentExists(hash).Return(false, nil)
+	mockDl.EXPECT().AddTorrentFileEx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(data []byte, _ downloader.AddTorrentOptions) (downloader.AddTorrentResult, error) {
+			added = data
+			return downloader.AddTorrentResult{Success: true}, nil
+		})
+
+	site := &fakeBatchSite{id: "test", data: original}
+	result, err := AddFromSite(context.Background(), site, mockDl, TorrentItem{ID: "6"}, GrabOptions{Transform: appendTracker})
+	require.NoError(t, err)
+	assert.Equal(t, hash, result.InfoHash)
+	assert.Equal(t, transformed, added)
+
+	parsed, err := ParseTorrent(added)
+	require.NoError(t, err)
+	require.NotEmpty(t, parsed.AnnounceList)
+	assert.Equal(t, []string{backup}, parsed.AnnounceList[len(parsed.AnnounceList)-1])
+}
+
+func TestAddFromSite_TransformError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+
+	site := &fakeBatchSite{id: "test", data: createTestTorrent("x")}
+	failing := func([]byte) ([]byte, error) { return nil, errors.New("bad transform") }
+	_, err := AddFromSite(context.Background(), site, mockDl, TorrentItem{ID: "7"}, GrabOptions{Transform: failing})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transform torrent")
+}
+
+func TestAddFromSite_InvalidTorrentRejectedBeforeTransform(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+
+	called := false
+	transform := func(data []byte) ([]byte, error) {
+		called = true
+		return data, nil
+	}
+	site := &fakeBatchSite{id: "test", data: []byte("<html>login</html>")}
+	_, err := AddFromSite(context.Background(), site, mockDl, TorrentItem{ID: "8"}, GrabOptions{Transform: transform})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "validate torrent")
+	assert.False(t, called)
+}
+
+func TestIdentityTransform(t *testing.T) {
+	data := createTestTorrent("id")
+	out, err := IdentityTransform(data)
+	require.NoError(t, err)
+	assert.Equal(t, data, out)
+}
+
+func TestAddFromSite_PendingSkipped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+
+	site := &fakeBatchSite{id: "test", data: createTestTorrent("pending")}
+	_, err := AddFromSite(context.Background(), site, mockDl, TorrentItem{ID: "9", Pending: true}, GrabOptions{})
+	assert.ErrorIs(t, err, ErrTorrentPending)
+}
+
+func TestAddFromSite_PendingIncluded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+
+	data := createTestTorrent("pending-ok")
+	hash, err := ComputeTorrentHash(data)
+	require.NoError(t, err)
+	mockDl.EXPECT().CheckTorrentExists(hash).Return(false, nil)
+	mockDl.EXPECT().AddTorrentFileEx(data, gomock.Any()).Return(downloader.AddTorrentResult{Success: true, Hash: hash}, nil)
+
+	site := &fakeBatchSite{id: "test", data: data}
+	result, err := AddFromSite(context.Background(), site, mockDl, TorrentItem{ID: "10", Pending: true}, GrabOptions{IncludePending: true})
+	require.NoError(t, err)
+	assert.Equal(t, hash, result.InfoHash)
+}
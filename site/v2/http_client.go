@@ -60,6 +60,9 @@ type SiteHTTPClient struct {
 	maxIdle   int
 	keepAlive bool
 	logger    *zap.Logger
+	jitter    time.Duration
+	sleep     func(ctx context.Context, d time.Duration) error
+	randInt63 func(n int64) int64
 }
 
 // SiteHTTPClientConfig holds configuration for SiteHTTPClient
@@ -71,6 +74,9 @@ type SiteHTTPClientConfig struct {
 	ProxyURL          string
 	UserAgent         string
 	Logger            *zap.Logger
+	// RequestJitter is the upper bound of a random delay applied before each GET.
+	// Zero disables jitter.
+	RequestJitter time.Duration
 }
 
 // DefaultSiteHTTPClientConfig returns default configuration
@@ -109,9 +115,36 @@ func NewSiteHTTPClient(config SiteHTTPClientConfig) *SiteHTTPClient {
 		maxIdle:   config.MaxIdleConns,
 		keepAlive: !config.DisableKeepAlives,
 		logger:    config.Logger,
+		jitter:    max(config.RequestJitter, 0),
+		sleep:     sleepContext,
+		randInt63: rand.Int63n,
 	}
 }
 
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// waitJitter sleeps for a random duration in [0, jitter] to desynchronize requests
+func (c *SiteHTTPClient) waitJitter(ctx context.Context) error {
+	if c.jitter <= 0 {
+		return nil
+	}
+	delay := time.Duration(c.randInt63(int64(c.jitter) + 1))
+	return c.sleep(ctx, delay)
+}
+
 // HTTPResponse wraps the response from requests library
 type HTTPResponse struct {
 	StatusCode int
@@ -187,8 +220,11 @@ func (c *SiteHTTPClient) DoRequest(ctx context.Context, method, url string, body
 	}, nil
 }
 
-// Get performs a GET request
+// Get performs a GET request, optionally preceded by a random jitter delay
 func (c *SiteHTTPClient) Get(ctx context.Context, url string, headers map[string]string) (*HTTPResponse, error) {
+	if err := c.waitJitter(ctx); err != nil {
+		return nil, err
+	}
 	return c.DoRequest(ctx, http.MethodGet, url, nil, headers)
 }
 
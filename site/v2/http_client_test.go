@@ -2,6 +2,7 @@ package v2
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"sync"
@@ -599,3 +600,159 @@ func TestSiteHTTPClient_PostJSONAndClose(t *testing.T) {
 	assert.True(t, resp.IsSuccess())
 	require.NoError(t, client.Close())
 }
+
+func TestSiteHTTPClient_Get_RequestJitter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	const bound = 250 * time.Millisecond
+	c := NewSiteHTTPClient(SiteHTTPClientConfig{Timeout: 5 * time.Second, UserAgent: "t", RequestJitter: bound})
+
+	var delays []time.Duration
+	c.sleep = func(_ context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+
+	for range 50 {
+		resp, err := c.Get(context.Background(), server.URL, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	require.Len(t, delays, 50)
+	for _, d := range delays {
+		assert.GreaterOrEqual(t, d, time.Duration(0))
+		assert.LessOrEqual(t, d, bound)
+	}
+}
+
+func TestSiteHTTPClient_Get_NoJitterByDefault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := NewSiteHTTPClient(SiteHTTPClientConfig{Timeout: 5 * time.Second, UserAgent: "t"})
+	called := false
+	c.sleep = func(_ context.Context, _ time.Duration) error {
+		called = true
+		return nil
+	}
+
+	_, err := c.Get(context.Background(), server.URL, nil)
+	require.NoError(t, err)
+	assert.False(t, called)
+}
+
+func TestSiteHTTPClient_Get_JitterRespectsContext(t *testing.T) {
+	c := NewSiteHTTPClient(SiteHTTPClientConfig{Timeout: 5 * time.Second, UserAgent: "t", RequestJitter: time.Hour})
+	c.randInt63 = func(n int64) int64 { return n - 1 }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := c.Get(ctx, "http://127.0.0.1:1", nil)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+// newConnCountingServer returns a server that counts newly accepted connections
+func newConnCountingServer(t testing.TB) (*httptest.Server, *atomic.Int64) {
+	var conns atomic.Int64
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	server.Start()
+	t.Cleanup(server.Close)
+	return server, &conns
+}
+
+func TestSiteHTTPClient_KeepAliveReusesConnections(t *testing.T) {
+	tests := []struct {
+		name      string
+		keepAlive bool
+		wantConns int64
+	}{
+		{name: "keep-alive reuses one connection", keepAlive: true, wantConns: 1},
+		{name: "disabled opens one per request", keepAlive: false, wantConns: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, conns := newConnCountingServer(t)
+			cfg := NexusPHPDriverConfig{KeepAlive: &tt.keepAlive}
+			client := NewSiteHTTPClient(cfg.httpClientConfig("test"))
+
+			for range 5 {
+				_, err := client.Get(context.Background(), server.URL, nil)
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantConns, conns.Load())
+		})
+	}
+}
+
+func TestNexusPHPDriverConfig_HTTPClientConfig(t *testing.T) {
+	disabled := false
+
+	tests := []struct {
+		name          string
+		config        NexusPHPDriverConfig
+		wantKeepAlive bool
+		wantMaxIdle   int
+	}{
+		{name: "sequential default", config: NexusPHPDriverConfig{}, wantKeepAlive: false, wantMaxIdle: 10},
+		{name: "small concurrency", config: NexusPHPDriverConfig{Concurrency: 4}, wantKeepAlive: true, wantMaxIdle: 10},
+		{name: "pool sized to concurrency", config: NexusPHPDriverConfig{Concurrency: 16}, wantKeepAlive: true, wantMaxIdle: 16},
+		{name: "keep-alive override", config: NexusPHPDriverConfig{Concurrency: 16, KeepAlive: &disabled}, wantKeepAlive: false, wantMaxIdle: 10},
+		{name: "explicit pool size", config: NexusPHPDriverConfig{Concurrency: 4, MaxIdleConns: 3}, wantKeepAlive: true, wantMaxIdle: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := tt.config.httpClientConfig("ua")
+			assert.Equal(t, tt.wantKeepAlive, !cfg.DisableKeepAlives)
+			assert.Equal(t, tt.wantMaxIdle, cfg.MaxIdleConns)
+			assert.Equal(t, "ua", cfg.UserAgent)
+		})
+	}
+}
+
+func TestUserInfoConcurrency(t *testing.T) {
+	assert.Equal(t, 1, userInfoConcurrency(nil))
+	assert.Equal(t, 1, userInfoConcurrency(&SiteDefinition{}))
+
+	def := &SiteDefinition{UserInfo: &UserInfoConfig{Process: []UserInfoProcess{
+		{RequestConfig: RequestConfig{URL: "/index.php"}},
+		{RequestConfig: RequestConfig{URL: "/userdetails.php"}},
+	}}}
+	assert.Equal(t, 4, userInfoConcurrency(def), "both process steps plus the seeding and leeching fetches")
+}
+
+func BenchmarkSiteHTTPClient_Get(b *testing.B) {
+	for _, keepAlive := range []bool{true, false} {
+		name := "keepalive"
+		if !keepAlive {
+			name = "no-keepalive"
+		}
+		b.Run(name, func(b *testing.B) {
+			server, _ := newConnCountingServer(b)
+			cfg := NexusPHPDriverConfig{KeepAlive: &keepAlive}
+			client := NewSiteHTTPClient(cfg.httpClientConfig("bench"))
+			ctx := context.Background()
+
+			b.ResetTimer()
+			for b.Loop() {
+				if _, err := client.Get(ctx, server.URL, nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
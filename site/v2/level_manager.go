@@ -237,3 +237,39 @@ func FormatBytes(bytes int64) string {
 		return fmt.Sprintf("%d B", bytes)
 	}
 }
+
+var (
+	binarySizeUnits  = []string{"KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+	decimalSizeUnits = []string{"KB", "MB", "GB", "TB", "PB", "EB"}
+)
+
+// HumanizeSize formats bytes as a human readable size. When binary is true
+// units are powers of 1024 with IEC suffixes ("1.50 GiB"), matching how
+// parseSize interprets site sizes; otherwise powers of 1000 ("1.50 GB").
+func HumanizeSize(bytes int64, binary bool) string {
+	base, units := float64(1000), decimalSizeUnits
+	if binary {
+		base, units = 1024, binarySizeUnits
+	}
+
+	sign := ""
+	value := float64(bytes)
+	if value < 0 {
+		sign, value = "-", -value
+	}
+	if value < base {
+		return fmt.Sprintf("%s%d B", sign, int64(value))
+	}
+
+	unit := -1
+	for value >= base && unit < len(units)-1 {
+		value /= base
+		unit++
+	}
+	// Rounding can carry into the next unit, e.g. 1023.999 KiB -> "1024.00 KiB"
+	if value >= base-0.005 && unit < len(units)-1 {
+		value /= base
+		unit++
+	}
+	return fmt.Sprintf("%s%.2f %s", sign, value, units[unit])
+}
@@ -1,6 +1,7 @@
 package v2
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -232,6 +233,38 @@ func TestFormatBytes(t *testing.T) {
 	}
 }
 
+func TestHumanizeSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		bytes    int64
+		binary   bool
+		expected string
+	}{
+		{"zero binary", 0, true, "0 B"},
+		{"zero decimal", 0, false, "0 B"},
+		{"below KiB", 1023, true, "1023 B"},
+		{"below KB", 999, false, "999 B"},
+		{"exact KiB", 1024, true, "1.00 KiB"},
+		{"exact KB", 1000, false, "1.00 KB"},
+		{"1024 bytes decimal", 1024, false, "1.02 KB"},
+		{"1.5 GiB", 1536 * MB, true, "1.50 GiB"},
+		{"1.5 GB", 1_500_000_000, false, "1.50 GB"},
+		{"GiB in decimal", GB, false, "1.07 GB"},
+		{"rounds up into next unit", MB - 1, true, "1.00 MiB"},
+		{"rounds up decimal", 999_999, false, "1.00 MB"},
+		{"TiB", 2 * TB, true, "2.00 TiB"},
+		{"PiB", 1024 * TB, true, "1.00 PiB"},
+		{"max int64", math.MaxInt64, true, "8.00 EiB"},
+		{"negative", -1536, true, "-1.50 KiB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, HumanizeSize(tt.bytes, tt.binary))
+		})
+	}
+}
+
 func TestLevelManager_Concurrent(t *testing.T) {
 	lm := NewLevelManager()
 
@@ -1104,6 +1104,9 @@ func (d *MTorrentDriver) getSiteID() string {
 
 func init() {
 	RegisterDriverForSchema("mTorrent", createMTorrentSite)
+	// "MTeam" is an alias: M-Team's JSON API (x-api-key auth, /api/torrent/search,
+	// /api/member/profile, /api/torrent/genDlToken) is what MTorrentDriver speaks
+	RegisterDriverForSchema("MTeam", createMTorrentSite)
 }
 
 func createMTorrentSite(config SiteConfig, logger *zap.Logger) (Site, error) {
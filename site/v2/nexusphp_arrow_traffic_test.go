@@ -0,0 +1,54 @@
+package v2
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractArrowTraffic(t *testing.T) {
+	tests := []struct {
+		text     string
+		up, down int64
+	}{
+		{"↑1.5TB ↓500GB", parseSize("1.5TB"), parseSize("500GB")},
+		{"▲ 2.00 TiB ▼ 12.5 GiB", parseSize("2.00 TiB"), parseSize("12.5 GiB")},
+		{"↓1,024 MB", 0, parseSize("1024 MB")},
+		{"no traffic here", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			up, down := extractArrowTraffic(tt.text)
+			assert.Equal(t, tt.up, up)
+			assert.Equal(t, tt.down, down)
+		})
+	}
+}
+
+func TestNexusPHPDriver_ArrowTraffic(t *testing.T) {
+	d := &NexusPHPDriver{}
+
+	t.Run("user details traffic row", func(t *testing.T) {
+		doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<table>
+			<tr><td class="rowhead">流量</td><td class="rowfollow">↑1.5TB ↓500GB</td></tr>
+		</table>`))
+		require.NoError(t, err)
+		info, err := d.ParseUserDetails(NexusPHPResponse{Document: doc})
+		require.NoError(t, err)
+		assert.Equal(t, parseSize("1.5TB"), info.Uploaded)
+		assert.Equal(t, parseSize("500GB"), info.Downloaded)
+	})
+
+	t.Run("compact info block", func(t *testing.T) {
+		doc, err := goquery.NewDocumentFromReader(strings.NewReader(
+			`<div id="info_block"><a class="User_Name" href="userdetails.php?id=3">me</a> ↑1.5TB ↓500GB</div>`))
+		require.NoError(t, err)
+		info, err := d.ParseUserInfo(NexusPHPResponse{Document: doc})
+		require.NoError(t, err)
+		assert.Equal(t, parseSize("1.5TB"), info.Uploaded)
+		assert.Equal(t, parseSize("500GB"), info.Downloaded)
+	})
+}
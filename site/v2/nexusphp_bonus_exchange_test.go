@@ -0,0 +1,109 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBonusPerGiBFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected float64
+	}{
+		{"one GB option", "1 1.0 GB上传量 如果你拥有足够的魔力值 1,200.0 交换", 1200},
+		{"five GB option", "5.0 GB上传量 5,500.0", 1100},
+		{"MiB option", "512 MB Upload 250", 500},
+		{"no size", "邀请名额 80,000.0", 0},
+		{"no price", "10.0 GB上传量", 0},
+		{"empty", "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, bonusPerGiBFilter(tt.input), 0.001)
+		})
+	}
+}
+
+func bonusShopDefinition() *SiteDefinition {
+	return &SiteDefinition{
+		ID: "bonusshop",
+		UserInfo: &UserInfoConfig{
+			Process: []UserInfoProcess{
+				{
+					RequestConfig: RequestConfig{URL: "/index.php", ResponseType: "document"},
+					Fields:        []string{"id", "name"},
+				},
+				{
+					RequestConfig: RequestConfig{URL: "/mybonus.php", ResponseType: "document"},
+					Fields:        []string{"bonusExchangeRate"},
+				},
+			},
+			Selectors: map[string]FieldSelector{
+				"id": {
+					Selector: []string{"#info_block a.User_Name"},
+					Attr:     "href",
+					Filters:  []Filter{{Name: "querystring", Args: []any{"id"}}},
+				},
+				"name": {Selector: []string{"#info_block a.User_Name"}},
+				"bonusExchangeRate": {
+					Selector: []string{"tr:has(h1:contains('GB上传量'))"},
+					Filters:  []Filter{{Name: "bonusPerGiB"}},
+				},
+			},
+		},
+	}
+}
+
+func TestNexusPHPDriver_GetUserInfo_BonusExchangeRate(t *testing.T) {
+	bonusPage, err := os.ReadFile("testdata/nexusphp_mybonus.html")
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/index.php":
+			_, _ = w.Write([]byte(`<html><body><div id="info_block"><a class="User_Name" href="userdetails.php?id=42">testuser</a></div></body></html>`))
+		case "/mybonus.php":
+			_, _ = w.Write(bonusPage)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	d.SetSiteDefinition(bonusShopDefinition())
+
+	info, err := d.GetUserInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "testuser", info.Username)
+	assert.InDelta(t, 1200, info.BonusExchangeRate, 0.001)
+}
+
+func TestNexusPHPDriver_GetUserInfo_BonusExchangeRateDefaultsToZero(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/index.php":
+			_, _ = w.Write([]byte(`<html><body><div id="info_block"><a class="User_Name" href="userdetails.php?id=42">testuser</a></div></body></html>`))
+		case "/mybonus.php":
+			_, _ = w.Write([]byte(`<html><body><p>魔力值商城暂未开放</p></body></html>`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	d.SetSiteDefinition(bonusShopDefinition())
+
+	info, err := d.GetUserInfo(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, info.BonusExchangeRate)
+}
@@ -0,0 +1,87 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cardListingHTML = `<html><body>
+<div class="torrent-list">
+	<div class="torrent-card">
+		<img class="cat" alt="Movies" />
+		<div class="card-title"><a href="details.php?id=101">Movie.2025.1080p.BluRay</a></div>
+		<div class="card-subtitle">电影 2025</div>
+		<img class="pro_free" src="pic/trans.gif" />
+		<ul class="card-stats">
+			<li class="size">4.5 GB</li>
+			<li class="seeders">12</li>
+			<li class="leechers">3</li>
+			<li class="snatched">40</li>
+		</ul>
+		<span class="hr">H&amp;R</span>
+	</div>
+	<div class="torrent-card">
+		<img class="cat" alt="TV" />
+		<div class="card-title"><a href="details.php?id=102">Show.S01.2160p</a></div>
+		<ul class="card-stats">
+			<li class="size">20 GB</li>
+			<li class="seeders">5</li>
+			<li class="leechers">0</li>
+			<li class="snatched">9</li>
+		</ul>
+	</div>
+</div>
+</body></html>`
+
+func TestNexusPHPDriver_ParseSearch_CardLayout(t *testing.T) {
+	sel := DefaultNexusPHPSelectors()
+	mergeSelectors(&sel, &SiteSelectors{Cards: &CardSelectors{
+		Item:     "div.torrent-card",
+		Title:    "div.card-title > a",
+		Subtitle: "div.card-subtitle",
+		Size:     "li.size",
+		Seeders:  "li.seeders",
+		Leechers: "li.leechers",
+		Snatched: "li.snatched",
+		Category: "img.cat",
+		HRIcon:   "span.hr",
+	}})
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, cardListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	first := items[0]
+	assert.Equal(t, "101", first.ID)
+	assert.Equal(t, "Movie.2025.1080p.BluRay", first.Title)
+	assert.Equal(t, "电影 2025", first.Subtitle)
+	assert.Equal(t, int64(4.5*1024*1024*1024), first.SizeBytes)
+	assert.Equal(t, 12, first.Seeders)
+	assert.Equal(t, 3, first.Leechers)
+	assert.Equal(t, 40, first.Snatched)
+	assert.Equal(t, "Movies", first.Category)
+	// The discount icon falls back to the table selector
+	assert.Equal(t, DiscountFree, first.DiscountLevel)
+	assert.True(t, first.HasHR)
+
+	second := items[1]
+	assert.Equal(t, "102", second.ID)
+	assert.Equal(t, int64(20*1024*1024*1024), second.SizeBytes)
+	assert.Equal(t, "TV", second.Category)
+	assert.Equal(t, DiscountNone, second.DiscountLevel)
+	assert.False(t, second.HasHR)
+}
+
+func TestNexusPHPDriver_ParseSearch_CardLayoutFallsBackToTable(t *testing.T) {
+	sel := DefaultNexusPHPSelectors()
+	sel.Cards = &CardSelectors{Item: "div.torrent-card", Title: "div.card-title > a"}
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, groupedListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 4)
+	assert.Equal(t, "Movie 2025 1080p", items[0].Title)
+}
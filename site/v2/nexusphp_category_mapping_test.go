@@ -0,0 +1,54 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const categoryListingHTML = `<html><body>
+<table class="torrents"><tbody>
+	<tr><td>Type</td><td>Name</td></tr>
+	<tr><td><img class="c_movie" src="pic/cattrans.gif" alt="电影" /></td><td><a href="details.php?id=1">Movie</a></td></tr>
+	<tr><td><img class="c_tvseries" src="pic/cattrans.gif" alt="影視劇集" /></td><td><a href="details.php?id=2">Series</a></td></tr>
+	<tr><td><img src="pic/category/anime.png" alt="" /></td><td><a href="details.php?id=3">Anime</a></td></tr>
+	<tr><td><img class="c_other" src="pic/cattrans.gif" alt="其他" /></td><td><a href="details.php?id=4">Other</a></td></tr>
+</tbody></table>
+</body></html>`
+
+func TestNexusPHPDriver_ParseSearch_NormalizedCategory(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	d.SetSiteDefinition(&SiteDefinition{
+		ID: "cats",
+		CategoryMapping: map[string]string{
+			"电影":         "movie",
+			"c_tv":       "tv",
+			"c_tvseries": "tv-series",
+			"anime.png":  "anime",
+		},
+	})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, categoryListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 4)
+
+	// Exact alt match
+	assert.Equal(t, "电影", items[0].Category)
+	assert.Equal(t, "movie", items[0].NormalizedCategory)
+	// The longest matching class keyword wins
+	assert.Equal(t, "tv-series", items[1].NormalizedCategory)
+	// Matched on the icon src
+	assert.Equal(t, "anime", items[2].NormalizedCategory)
+	// Unmapped categories keep the raw value
+	assert.Equal(t, "其他", items[3].NormalizedCategory)
+}
+
+func TestNexusPHPDriver_ParseSearch_NormalizedCategoryWithoutMapping(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, categoryListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 4)
+	assert.Equal(t, "影視劇集", items[1].NormalizedCategory)
+}
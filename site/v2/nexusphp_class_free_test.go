@@ -0,0 +1,158 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const classFreeListingHTML = `<html><body>
+<table class="torrents"><tbody>
+	<tr><td>Type</td><td>Name</td></tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=1">VIP Free Movie 2025</a><img class="pro_vipfree" src="pic/trans.gif" alt="VIP免费" /></td>
+	</tr>
+	<tr>
+		<td><img alt="TV" /></td>
+		<td><a href="details.php?id=2">Everyone Free Show S01</a><img class="pro_free" src="pic/trans.gif" alt="Free" /></td>
+	</tr>
+</tbody></table>
+</body></html>`
+
+func newClassFreeDriver(t *testing.T) *NexusPHPDriver {
+	t.Helper()
+	sel := DefaultNexusPHPSelectors()
+	mergeSelectors(&sel, &SiteSelectors{
+		DiscountIcon:     "img.pro_vipfree, img.pro_free",
+		ClassFreeMapping: map[string][]string{"pro_vipfree": {"VIP", "Uploader"}},
+	})
+	return NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+}
+
+func TestNexusPHPDriver_ParseSearch_ClassFree(t *testing.T) {
+	tests := []struct {
+		name string
+		rank string
+		want DiscountLevel
+	}{
+		{name: "vip rank is free", rank: "VIP", want: DiscountFree},
+		{name: "rank match ignores case", rank: "uploader", want: DiscountFree},
+		{name: "other rank is not free", rank: "Power User", want: DiscountNone},
+		{name: "unknown rank is not free", rank: "", want: DiscountNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newClassFreeDriver(t)
+			d.SetUserRank(tt.rank)
+
+			items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, classFreeListingHTML)})
+			require.NoError(t, err)
+			require.Len(t, items, 2)
+
+			assert.Equal(t, tt.want, items[0].DiscountLevel)
+			assert.Equal(t, DiscountFree, items[1].DiscountLevel, "regular free icon is unaffected by rank")
+		})
+	}
+}
+
+func TestNexusPHPDriver_ParseSearch_ClassFreeWithoutMapping(t *testing.T) {
+	sel := DefaultNexusPHPSelectors()
+	mergeSelectors(&sel, &SiteSelectors{DiscountIcon: "img.pro_vipfree, img.pro_free"})
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, classFreeListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, DiscountFree, items[0].DiscountLevel, "without a mapping the icon keeps the generic keyword match")
+}
+
+func TestNexusPHPDriver_GetUserInfo_SetsRankForClassFree(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "userdetails.php") {
+			_, _ = w.Write([]byte(`<html><body><table>
+				<tr><td class="rowhead">用户名</td><td class="rowfollow">VipUser</td></tr>
+				<tr><td class="rowhead">等级</td><td class="rowfollow">VIP</td></tr>
+			</table></body></html>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<html><body>
+			<div id="info_block"><a class="User_Name" href="userdetails.php?id=7">VipUser</a></div>
+		</body></html>`))
+	}))
+	defer server.Close()
+
+	sel := DefaultNexusPHPSelectors()
+	mergeSelectors(&sel, &SiteSelectors{
+		DiscountIcon:     "img.pro_vipfree, img.pro_free",
+		ClassFreeMapping: map[string][]string{"pro_vipfree": {"VIP"}},
+	})
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", Selectors: &sel})
+
+	info, err := d.GetUserInfo(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "VIP", info.Rank)
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, classFreeListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, DiscountFree, items[0].DiscountLevel, "rank from GetUserInfo resolves the class free icon")
+}
+
+func TestParseClassFreeFromElement_LongestKeywordWins(t *testing.T) {
+	doc := mustDoc(t, `<img class="pro_vipfree" src="pic/trans.gif" />`)
+	mapping := map[string][]string{
+		"vipfree":     {"Uploader"},
+		"pro_vipfree": {"VIP"},
+		"pro_":        {"Nobody"},
+	}
+
+	for range 20 {
+		eligible, ok := parseClassFreeFromElement(doc.Find("img"), mapping, "VIP")
+		require.True(t, ok)
+		assert.True(t, eligible, "the most specific keyword decides")
+	}
+}
+
+func TestNexusPHPDriver_ParseSearch_ClassFreeKeepsOtherIcons(t *testing.T) {
+	const html = `<html><body>
+<table class="torrents"><tbody>
+	<tr><td>Type</td><td>Name</td></tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=1">VIP Free 2up</a><img class="pro_vipfree" src="pic/trans.gif" /><img class="pro_2up" src="pic/trans.gif" alt="2X" /></td>
+	</tr>
+</tbody></table>
+</body></html>`
+
+	tests := []struct {
+		name string
+		rank string
+		want DiscountLevel
+	}{
+		{name: "eligible rank combines with 2up", rank: "VIP", want: Discount2xFree},
+		{name: "ineligible rank keeps 2up", rank: "User", want: Discount2xUp},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sel := DefaultNexusPHPSelectors()
+			mergeSelectors(&sel, &SiteSelectors{
+				DiscountIcon:     "img.pro_vipfree, img.pro_2up",
+				ClassFreeMapping: map[string][]string{"pro_vipfree": {"VIP"}},
+			})
+			d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+			d.SetUserRank(tt.rank)
+
+			items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, html)})
+			require.NoError(t, err)
+			require.Len(t, items, 1)
+			assert.Equal(t, tt.want, items[0].DiscountLevel)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCombineDiscountLevels(t *testing.T) {
+	assert.Equal(t, Discount2xFree, CombineDiscountLevels(DiscountFree, Discount2xUp))
+	assert.Equal(t, Discount2x50, CombineDiscountLevels(DiscountPercent50, Discount2xUp))
+	assert.Equal(t, DiscountFree, CombineDiscountLevels(DiscountFree))
+	assert.Equal(t, DiscountFree, CombineDiscountLevels(DiscountPercent50, DiscountFree))
+	assert.Equal(t, DiscountNone, CombineDiscountLevels())
+	assert.Equal(t, Discount2x30, CombineDiscountLevels(DiscountPercent30, Discount2xUp))
+	assert.Equal(t, Discount2x70, CombineDiscountLevels(Discount2xUp, DiscountPercent70))
+	assert.Equal(t, Discount2x25, CombineDiscountLevels(DiscountPercent25, Discount2xUp))
+	assert.Equal(t, Discount2x75, CombineDiscountLevels(DiscountPercent75, Discount2xUp))
+}
+
+func TestCombineDiscountLevels_KeepsBothDimensions(t *testing.T) {
+	downloads := []DiscountLevel{DiscountNone, DiscountFree, DiscountPercent25, DiscountPercent30, DiscountPercent50, DiscountPercent70, DiscountPercent75}
+	for _, level := range downloads {
+		combined := CombineDiscountLevels(level, Discount2xUp)
+		assert.Equal(t, level.GetDownloadRatio(), combined.GetDownloadRatio(), "%s + 2xup", level)
+		assert.Equal(t, 2.0, combined.GetUploadRatio(), "%s + 2xup", level)
+	}
+}
+
+func TestTorrentItem_IsFreeAndDoubleUp(t *testing.T) {
+	assert.True(t, (&TorrentItem{DiscountLevel: Discount2xFree}).IsFreeAndDoubleUp())
+	assert.False(t, (&TorrentItem{DiscountLevel: DiscountFree}).IsFreeAndDoubleUp())
+	assert.False(t, (&TorrentItem{DiscountLevel: Discount2xUp}).IsFreeAndDoubleUp())
+	assert.False(t, (&TorrentItem{DiscountLevel: Discount2x50}).IsFreeAndDoubleUp())
+}
+
+func TestNexusPHPDriver_ParseSearch_MultipleDiscountIcons(t *testing.T) {
+	html := `<html><body><table class="torrents"><tbody>
+		<tr><td>Type</td><td>Name</td></tr>
+		<tr><td></td><td><a href="details.php?id=1">Free 2up</a><img class="pro_free" src="pic/trans.gif" /><img class="pro_2up" src="pic/trans.gif" /></td></tr>
+		<tr><td></td><td><a href="details.php?id=2">Half 2up</a><img class="pro_50pctdown" src="pic/trans.gif" /><img class="pro_2up" src="pic/trans.gif" /></td></tr>
+		<tr><td></td><td><a href="details.php?id=3">Free only</a><img class="pro_free" src="pic/trans.gif" /></td></tr>
+	</tbody></table></body></html>`
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, html)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	assert.Equal(t, Discount2xFree, items[0].DiscountLevel)
+	assert.True(t, items[0].IsFreeAndDoubleUp())
+	assert.Equal(t, Discount2x50, items[1].DiscountLevel)
+	assert.Equal(t, 0.5, items[1].DiscountLevel.GetDownloadRatio())
+	assert.Equal(t, 2.0, items[1].DiscountLevel.GetUploadRatio())
+	assert.Equal(t, DiscountFree, items[2].DiscountLevel)
+	assert.False(t, items[2].IsFreeAndDoubleUp())
+}
+
+func TestNexusPHPDriver_ParseSearch_HighestDiscountWins(t *testing.T) {
+	html := `<html><body><table class="torrents"><tbody>
+		<tr><td>Type</td><td>Name</td></tr>
+		<tr><td></td><td><a href="details.php?id=1">Half then free</a><img class="pro_50pctdown" src="pic/trans.gif" /><img class="pro_free" src="pic/trans.gif" /></td></tr>
+		<tr><td></td><td><a href="details.php?id=2">Seventy then half</a><img class="pro_70pctdown" src="pic/trans.gif" /><img class="pro_50pctdown" src="pic/trans.gif" /></td></tr>
+	</tbody></table></body></html>`
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, html)})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	assert.Equal(t, DiscountFree, items[0].DiscountLevel)
+	assert.Equal(t, DiscountPercent50, items[1].DiscountLevel)
+}
+
+func TestParseDiscountFromElement_CustomMappingPicksHighest(t *testing.T) {
+	custom := map[string]DiscountLevel{"half": DiscountPercent50, "promo": DiscountFree, "up": Discount2xUp}
+	// 同一图标命中多个自定义关键字时结果与 map 遍历顺序无关
+	for range 20 {
+		assert.Equal(t, DiscountFree, parseDiscountFromElement(discountElem(t, "half promo", "", ""), custom))
+		assert.Equal(t, Discount2x50, parseDiscountFromElement(discountElem(t, "half up", "", ""), custom))
+	}
+}
+
+func TestNexusPHPParser_ParseDiscount_HighestDiscountWins(t *testing.T) {
+	parser := NewNexusPHPParser()
+
+	discount, _ := parser.ParseDiscount(parseHTML(t, `<html><h1><font class="halfdown">50%</font><font class="free">Free</font></h1></html>`))
+	assert.Equal(t, DiscountFree, discount)
+
+	discount, _ = parser.ParseDiscount(parseHTML(t, `<html><h1><font class="free">Free</font><font class="twoup">2x</font></h1></html>`))
+	assert.Equal(t, Discount2xFree, discount)
+}
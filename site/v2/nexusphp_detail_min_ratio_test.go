@@ -0,0 +1,63 @@
+package v2
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNexusPHPDriver_ParseDetail_MinRatio(t *testing.T) {
+	raw, err := os.ReadFile("testdata/nexusphp_details_min_ratio.html")
+	require.NoError(t, err)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	detail, err := d.ParseDetail(NexusPHPResponse{Document: mustDoc(t, string(raw))})
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, detail.MinRatioRequired)
+}
+
+func TestNexusPHPDriver_ParseDetail_MinRatioCustomLabel(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	sel := d.Selectors
+	sel.DetailMinRatio = "td.rowhead:contains('下载限制') + td"
+	d.Selectors = sel
+
+	html := `<table><tr><td class="rowhead">下载限制</td><td>ratio &gt;= 0.8</td></tr></table>`
+	detail, err := d.ParseDetail(NexusPHPResponse{Document: mustDoc(t, html)})
+	require.NoError(t, err)
+	assert.Equal(t, 0.8, detail.MinRatioRequired)
+}
+
+func TestNexusPHPDriver_ParseDetail_NoMinRatio(t *testing.T) {
+	raw, err := os.ReadFile("testdata/nexusphp_details_peers.html")
+	require.NoError(t, err)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	detail, err := d.ParseDetail(NexusPHPResponse{Document: mustDoc(t, string(raw))})
+	require.NoError(t, err)
+	assert.Zero(t, detail.MinRatioRequired)
+}
+
+func TestUserInfo_MeetsMinRatio(t *testing.T) {
+	tests := []struct {
+		name     string
+		ratio    float64
+		required float64
+		want     bool
+	}{
+		{name: "no requirement", ratio: 0.1, required: 0, want: true},
+		{name: "above requirement", ratio: 2.0, required: 1.5, want: true},
+		{name: "equal to requirement", ratio: 1.5, required: 1.5, want: true},
+		{name: "below requirement", ratio: 0.9, required: 1.5, want: false},
+		{name: "infinite ratio", ratio: -1, required: 1.5, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := UserInfo{Ratio: tt.ratio}
+			assert.Equal(t, tt.want, info.MeetsMinRatio(TorrentDetail{MinRatioRequired: tt.required}))
+		})
+	}
+}
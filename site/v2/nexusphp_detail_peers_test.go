@@ -0,0 +1,76 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePeerCounts(t *testing.T) {
+	intPtr := func(n int) *int { return &n }
+
+	tests := []struct {
+		name         string
+		text         string
+		wantSeeders  *int
+		wantLeechers *int
+	}{
+		{name: "chinese count before label", text: "37个做种者 | 4个下载者", wantSeeders: intPtr(37), wantLeechers: intPtr(4)},
+		{name: "traditional chinese", text: "12個做種者 | 0個下載者", wantSeeders: intPtr(12), wantLeechers: intPtr(0)},
+		{name: "label before count", text: "做种者: 5 下载者：2", wantSeeders: intPtr(5), wantLeechers: intPtr(2)},
+		{name: "english", text: "21 seeder(s) | 3 leecher(s)", wantSeeders: intPtr(21), wantLeechers: intPtr(3)},
+		{name: "seeders only", text: "8 Seeders", wantSeeders: intPtr(8)},
+		{name: "no counts", text: "暂无同伴信息"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seeders, leechers := parsePeerCounts(tt.text)
+			assert.Equal(t, tt.wantSeeders, seeders)
+			assert.Equal(t, tt.wantLeechers, leechers)
+		})
+	}
+}
+
+func TestNexusPHPDriver_ParseDetail_LivePeers(t *testing.T) {
+	raw, err := os.ReadFile("testdata/nexusphp_details_peers.html")
+	require.NoError(t, err)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	detail, err := d.ParseDetail(NexusPHPResponse{Document: mustDoc(t, string(raw))})
+	require.NoError(t, err)
+
+	require.NotNil(t, detail.CurrentSeeders)
+	require.NotNil(t, detail.CurrentLeechers)
+	assert.Equal(t, 37, *detail.CurrentSeeders)
+	assert.Equal(t, 4, *detail.CurrentLeechers)
+}
+
+func TestNexusPHPDriver_ParseDetail_NoPeersRow(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	detail, err := d.ParseDetail(NexusPHPResponse{Document: mustDoc(t, `<table><tr><td class="rowhead">副标题</td><td>x</td></tr></table>`)})
+	require.NoError(t, err)
+	assert.Nil(t, detail.CurrentSeeders)
+	assert.Nil(t, detail.CurrentLeechers)
+}
+
+func TestNexusPHPDriver_GetTorrentDetail_PrefersLivePeers(t *testing.T) {
+	raw, err := os.ReadFile("testdata/nexusphp_details_peers.html")
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(raw)
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	item, err := d.GetTorrentDetail(context.Background(), "77", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, 37, item.Seeders)
+	assert.Equal(t, 4, item.Leechers)
+}
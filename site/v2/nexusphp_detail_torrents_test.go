@@ -0,0 +1,46 @@
+package v2
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNexusPHPDriver_ParseDetailTorrents(t *testing.T) {
+	raw, err := os.ReadFile("testdata/nexusphp_details_season_pack.html")
+	require.NoError(t, err)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	torrents, err := d.ParseDetailTorrents(NexusPHPResponse{Document: mustDoc(t, string(raw))})
+	require.NoError(t, err)
+
+	assert.Equal(t, []TorrentDetail{
+		{DownloadURL: "download.php?id=500", Label: "Some.Show.S01.2160p.WEB-DL.torrent"},
+		{DownloadURL: "download.php?id=501", Label: "S01E01 1080p"},
+		{DownloadURL: "download.php?id=502", Label: "S01E02"},
+		{DownloadURL: "download.php?id=503", Label: "S01E03"},
+	}, torrents)
+}
+
+func TestNexusPHPDriver_ParseDetailTorrents_CustomSelector(t *testing.T) {
+	raw, err := os.ReadFile("testdata/nexusphp_details_season_pack.html")
+	require.NoError(t, err)
+
+	selectors := DefaultNexusPHPSelectors()
+	selectors.DetailTorrentLinks = "ul.episodes a"
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &selectors})
+	torrents, err := d.ParseDetailTorrents(NexusPHPResponse{Document: mustDoc(t, string(raw))})
+	require.NoError(t, err)
+
+	require.Len(t, torrents, 3)
+	assert.Equal(t, "download.php?id=501", torrents[0].DownloadURL)
+	assert.Equal(t, "S01E03", torrents[2].Label)
+}
+
+func TestNexusPHPDriver_ParseDetailTorrents_NilDocument(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	_, err := d.ParseDetailTorrents(NexusPHPResponse{})
+	assert.ErrorIs(t, err, ErrParseError)
+}
@@ -0,0 +1,102 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newDownloadConfirmServer serves the torrent only once /confirm.php has set
+// the dl_ok cookie; otherwise download.php answers with the JS confirm page
+func newDownloadConfirmServer(t *testing.T, torrent []byte) (*httptest.Server, *[]string) {
+	t.Helper()
+	var confirmed []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/details.php":
+			_, _ = w.Write([]byte(`<html><body><a href="download.php?id=9&amp;passkey=abc">Torrent</a></body></html>`))
+		case "/confirm.php":
+			confirmed = append(confirmed, r.URL.Query().Get("id"))
+			http.SetCookie(w, &http.Cookie{Name: "dl_ok", Value: "1"})
+			_, _ = w.Write([]byte("<html><body>ok</body></html>"))
+		case "/download.php":
+			if c, err := r.Cookie("dl_ok"); err != nil || c.Value != "1" {
+				_, _ = w.Write([]byte(`<html><script>if(confirm('下载?')){document.cookie='dl_ok=1'}</script></html>`))
+				return
+			}
+			if c, err := r.Cookie("c"); err != nil || c.Value != "1" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			_, _ = w.Write(torrent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &confirmed
+}
+
+func TestNexusPHPDriver_ParseDownload_VisitsConfirmPath(t *testing.T) {
+	torrent := createTestTorrent("confirm")
+	server, confirmed := newDownloadConfirmServer(t, torrent)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{
+		BaseURL:             server.URL,
+		Cookie:              "c=1",
+		Passkey:             "abc",
+		DownloadConfirmPath: "/confirm.php?id={id}",
+	})
+
+	data, _, err := d.DownloadAndHash(context.Background(), "9")
+	require.NoError(t, err)
+	assert.Equal(t, torrent, data)
+	assert.Equal(t, []string{"9"}, *confirmed)
+}
+
+func TestNexusPHPDriver_ParseDownload_WithoutConfirmGetsConfirmPage(t *testing.T) {
+	server, confirmed := newDownloadConfirmServer(t, createTestTorrent("confirm"))
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	page := `<html><body><a href="download.php?id=9&amp;passkey=abc">Torrent</a></body></html>`
+
+	data, err := d.ParseDownload(NexusPHPResponse{Document: mustDoc(t, page)})
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "confirm(", "the site serves its JS confirm page instead")
+	assert.Empty(t, *confirmed)
+}
+
+func TestNexusPHPDriver_ConfirmDownload_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, DownloadConfirmPath: "confirm.php"})
+	page := `<html><body><a href="download.php?id=9&amp;passkey=abc">Torrent</a></body></html>`
+
+	_, err := d.ParseDownload(NexusPHPResponse{Document: mustDoc(t, page)})
+	assert.ErrorContains(t, err, "download confirm page")
+}
+
+func TestMergeCookies(t *testing.T) {
+	cookies := []*http.Cookie{{Name: "dl_ok", Value: "1"}, {Name: "c", Value: "2"}}
+	assert.Equal(t, "uid=5; dl_ok=1; c=2", mergeCookies("uid=5; c=1", cookies))
+	assert.Equal(t, "a=1", mergeCookies("a=1", nil))
+	assert.Equal(t, "dl_ok=1", mergeCookies("", cookies[:1]))
+}
+
+func TestCreateNexusPHPSite_DownloadConfirmPath(t *testing.T) {
+	opts, err := json.Marshal(NexusPHPOptions{Cookie: "c=1", DownloadConfirmPath: " /confirm.php "})
+	require.NoError(t, err)
+	site, err := createNexusPHPSite(SiteConfig{ID: "confirmsite", BaseURL: "https://example.com", Options: opts}, zap.NewNop())
+	require.NoError(t, err)
+	driver := site.(*BaseSite[NexusPHPRequest, NexusPHPResponse]).driver.(*NexusPHPDriver)
+	assert.Equal(t, "/confirm.php", driver.downloadConfirmPath)
+}
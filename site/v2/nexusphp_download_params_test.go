@@ -0,0 +1,69 @@
+package v2
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNexusPHPDriver_ParseDownload_AppendsDownloadURLParams(t *testing.T) {
+	torrent := createTestTorrent("params")
+	var gotQuery url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		_, _ = w.Write(torrent)
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{
+		BaseURL:           server.URL,
+		Cookie:            "c=1",
+		DownloadURLParams: url.Values{"type": {"torrent"}},
+	})
+	page := `<html><body>
+		<a href="download.php?id=9&amp;type=zip">[ZIP]</a>
+		<a href="download.php?id=9&amp;passkey=abc">Torrent</a>
+	</body></html>`
+
+	data, err := d.ParseDownload(NexusPHPResponse{Document: mustDoc(t, page)})
+	require.NoError(t, err)
+	assert.Equal(t, torrent, data)
+	assert.Equal(t, "9", gotQuery.Get("id"))
+	assert.Equal(t, "abc", gotQuery.Get("passkey"))
+	assert.Equal(t, []string{"torrent"}, gotQuery["type"])
+}
+
+func TestNexusPHPDriver_ApplyDownloadParams(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	got, err := d.applyDownloadParams("https://example.com/download.php?id=1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/download.php?id=1", got, "no params configured leaves URL untouched")
+
+	d = NewNexusPHPDriver(NexusPHPDriverConfig{
+		BaseURL:           "https://example.com",
+		DownloadURLParams: url.Values{"type": {"torrent"}},
+	})
+	got, err = d.applyDownloadParams("https://example.com/download.php?id=1&type=file")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/download.php?id=1&type=torrent", got)
+}
+
+func TestCreateNexusPHPSite_DownloadURLParams(t *testing.T) {
+	opts, err := json.Marshal(NexusPHPOptions{Cookie: "c=1", DownloadURLParams: "type=torrent"})
+	require.NoError(t, err)
+	site, err := createNexusPHPSite(SiteConfig{ID: "paramsite", BaseURL: "https://example.com", Options: opts}, zap.NewNop())
+	require.NoError(t, err)
+	driver := site.(*BaseSite[NexusPHPRequest, NexusPHPResponse]).driver.(*NexusPHPDriver)
+	assert.Equal(t, url.Values{"type": {"torrent"}}, driver.downloadParams)
+
+	opts, err = json.Marshal(NexusPHPOptions{Cookie: "c=1", DownloadURLParams: "type=%zz"})
+	require.NoError(t, err)
+	_, err = createNexusPHPSite(SiteConfig{ID: "paramsite", BaseURL: "https://example.com", Options: opts}, zap.NewNop())
+	assert.ErrorContains(t, err, "downloadUrlParams")
+}
@@ -0,0 +1,85 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPostDownloadServer serves the torrent only for a form POST to
+// download.php carrying id, passkey and type=torrent; a GET is rejected
+func newPostDownloadServer(t *testing.T, torrent []byte) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/details.php":
+			_, _ = w.Write([]byte(`<html><body><a href="download.php?id=5&amp;passkey=pk">Torrent</a></body></html>`))
+		case "/download.php":
+			if r.Method != http.MethodPost {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			if err := r.ParseForm(); err != nil || r.URL.RawQuery != "" ||
+				r.PostForm.Get("id") != "5" || r.PostForm.Get("passkey") != "pk" || r.PostForm.Get("type") != "torrent" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write(torrent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNexusPHPDriver_PostDownload_Direct(t *testing.T) {
+	torrent := createTestTorrent("post-direct")
+	server := newPostDownloadServer(t, torrent)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{
+		BaseURL:            server.URL,
+		Cookie:             "c=1",
+		Passkey:            "pk",
+		DownloadMethod:     "post",
+		DownloadFormFields: map[string]string{"type": "torrent"},
+	})
+
+	req, err := d.PrepareDownload("5")
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPost, req.Method)
+
+	data, _, err := d.DownloadAndHash(context.Background(), "5")
+	require.NoError(t, err)
+	assert.Equal(t, torrent, data)
+}
+
+func TestNexusPHPDriver_PostDownload_ViaDetailPage(t *testing.T) {
+	torrent := createTestTorrent("post-detail")
+	server := newPostDownloadServer(t, torrent)
+
+	// Without a passkey the link is scraped from the detail page and its
+	// query is posted as the form
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{
+		BaseURL:            server.URL,
+		Cookie:             "c=1",
+		DownloadMethod:     http.MethodPost,
+		DownloadFormFields: map[string]string{"type": "torrent"},
+	})
+
+	data, _, err := d.DownloadAndHash(context.Background(), "5")
+	require.NoError(t, err)
+	assert.Equal(t, torrent, data)
+}
+
+func TestNexusPHPDriver_GetDownloadRejectedByPostOnlySite(t *testing.T) {
+	server := newPostDownloadServer(t, createTestTorrent("get"))
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", Passkey: "pk"})
+	_, _, err := d.DownloadAndHash(context.Background(), "5")
+	require.Error(t, err)
+}
@@ -1,13 +1,19 @@
 package v2
 
 import (
+	"bytes"
 	"context"
+	"encoding/base32"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"math"
 	"net/http"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -16,6 +22,7 @@ import (
 	"github.com/PuerkitoBio/goquery"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
 )
 
 // NexusPHPRequest represents a request to a NexusPHP site
@@ -26,6 +33,59 @@ type NexusPHPRequest struct {
 	Params url.Values
 	// Method is the HTTP method (default: GET)
 	Method string
+	// Body, when set on a POST, is sent as-is and Params go to the query
+	// string instead of a form body
+	Body []byte
+	// ContentType is the Content-Type of Body (default: application/json)
+	ContentType string
+	// IframeSelector, when set and the page has no torrent rows, selects an
+	// iframe whose src is fetched and returned in place of the outer page
+	IframeSelector string
+	// Raw skips HTML parsing; the body is returned as RawBody only (torrent files)
+	Raw bool
+}
+
+// CardSelectors parses listings rendered as cards (e.g. <div class="torrent-card">).
+// Field selectors are relative to each card; empty ones fall back to the
+// SiteSelectors field of the same name.
+type CardSelectors struct {
+	// Item selects each torrent card
+	Item            string `json:"item"`
+	Title           string `json:"title,omitempty"`
+	Subtitle        string `json:"subtitle,omitempty"`
+	Size            string `json:"size,omitempty"`
+	Seeders         string `json:"seeders,omitempty"`
+	Leechers        string `json:"leechers,omitempty"`
+	Snatched        string `json:"snatched,omitempty"`
+	DiscountIcon    string `json:"discountIcon,omitempty"`
+	DiscountEndTime string `json:"discountEndTime,omitempty"`
+	DownloadLink    string `json:"downloadLink,omitempty"`
+	Category        string `json:"category,omitempty"`
+	UploadTime      string `json:"uploadTime,omitempty"`
+	HRIcon          string `json:"hrIcon,omitempty"`
+}
+
+// overlay returns base with the card field selectors that are set
+func (c *CardSelectors) overlay(base SiteSelectors) SiteSelectors {
+	for dst, src := range map[*string]string{
+		&base.Title:           c.Title,
+		&base.Subtitle:        c.Subtitle,
+		&base.Size:            c.Size,
+		&base.Seeders:         c.Seeders,
+		&base.Leechers:        c.Leechers,
+		&base.Snatched:        c.Snatched,
+		&base.DiscountIcon:    c.DiscountIcon,
+		&base.DiscountEndTime: c.DiscountEndTime,
+		&base.DownloadLink:    c.DownloadLink,
+		&base.Category:        c.Category,
+		&base.UploadTime:      c.UploadTime,
+		&base.HRIcon:          c.HRIcon,
+	} {
+		if src != "" {
+			*dst = src
+		}
+	}
+	return base
 }
 
 // NexusPHPResponse wraps a goquery document for parsing
@@ -42,12 +102,20 @@ type NexusPHPResponse struct {
 type SiteSelectors struct {
 	// TableRows selects torrent rows in the search results
 	TableRows string `json:"tableRows"`
+	// AlternateTableRows are tried in order when TableRows matches no rows
+	AlternateTableRows []string `json:"alternateTableRows,omitempty"`
 	// Title selects the torrent title
 	Title string `json:"title"`
 	// TitleLink selects the link containing torrent ID
 	TitleLink string `json:"titleLink"`
 	// Size selects the torrent size
 	Size string `json:"size"`
+	// SizeBytesAttr names an attribute on the size cell holding the exact byte
+	// count. When empty, "data-bytes" and "data-size" are tried before the text
+	SizeBytesAttr string `json:"sizeBytesAttr,omitempty"`
+	// SizeBase is the multiplier of decimal units like "GB" in the size text:
+	// 1000 for sites reporting SI sizes, 1024 (the default) otherwise
+	SizeBase int `json:"sizeBase,omitempty"`
 	// Seeders selects the seeder count
 	Seeders string `json:"seeders"`
 	// Leechers selects the leecher count
@@ -59,6 +127,10 @@ type SiteSelectors struct {
 	// DiscountMapping maps keywords to discount levels (optional, uses default if nil)
 	// Keys are matched against class, src, alt attributes (case-insensitive)
 	DiscountMapping map[string]DiscountLevel `json:"discountMapping,omitempty"`
+	// ClassFreeMapping maps class-conditional free icon keywords (e.g. "pro_vipfree")
+	// to the user ranks the torrent is free for. Matched like DiscountMapping;
+	// the row is free only when the driver's user rank is listed, otherwise none
+	ClassFreeMapping map[string][]string `json:"classFreeMapping,omitempty"`
 	// DiscountEndTime selects the discount end time
 	DiscountEndTime string `json:"discountEndTime"`
 	// DownloadLink selects the download link
@@ -69,8 +141,36 @@ type SiteSelectors struct {
 	UploadTime string `json:"uploadTime"`
 	// HRIcon selects the H&R icon
 	HRIcon string `json:"hrIcon"`
+	// OfficialBadge selects candidate official/original badges in a row
+	OfficialBadge string `json:"officialBadge,omitempty"`
+	// OfficialKeywords must appear in a badge's text, alt or title for it to count;
+	// empty means any matched badge counts
+	OfficialKeywords []string `json:"officialKeywords,omitempty"`
+	// ExclusiveBadge selects candidate internal/exclusive (内部/禁转) badges in a row
+	ExclusiveBadge string `json:"exclusiveBadge,omitempty"`
+	// ExclusiveKeywords must appear in a badge's text, alt or title for it to count;
+	// empty means any matched badge counts
+	ExclusiveKeywords []string `json:"exclusiveKeywords,omitempty"`
+	// FirstReleaseBadge selects candidate site-first-release (首发) badges in a row
+	FirstReleaseBadge string `json:"firstReleaseBadge,omitempty"`
+	// FirstReleaseKeywords must appear in a badge's text, alt or title for it to count;
+	// empty means any matched badge counts
+	FirstReleaseKeywords []string `json:"firstReleaseKeywords,omitempty"`
+	// PendingBadge selects the moderation status marker on a torrent row
+	PendingBadge string `json:"pendingBadge,omitempty"`
+	// PendingKeywords match badge text/alt/title marking a torrent awaiting approval (待审核)
+	PendingKeywords []string `json:"pendingKeywords,omitempty"`
 	// Subtitle selects the subtitle in search results
 	Subtitle string `json:"subtitle"`
+	// GroupLink selects the link to a torrent's group/collection (合集) page;
+	// the group ID is taken from its href. Empty disables group parsing
+	GroupLink string `json:"groupLink,omitempty"`
+	// Cards parses search listings laid out as <div> cards instead of table
+	// rows; when its item selector matches, it takes precedence over TableRows
+	Cards *CardSelectors `json:"cards,omitempty"`
+	// UploaderClass selects the uploader's class-styled element, usually the
+	// uploader link (e.g. <a class="Uploader_Name">). Empty disables it
+	UploaderClass string `json:"uploaderClass,omitempty"`
 	// UserInfo selectors for user page
 	UserInfoUsername   string `json:"userInfoUsername"`
 	UserInfoUploaded   string `json:"userInfoUploaded"`
@@ -78,39 +178,114 @@ type SiteSelectors struct {
 	UserInfoRatio      string `json:"userInfoRatio"`
 	UserInfoBonus      string `json:"userInfoBonus"`
 	UserInfoRank       string `json:"userInfoRank"`
+	// UserInfoMobileBlock selects the compact userinfo block of mobile and
+	// responsive skins. ParseUserInfo reads it only when the desktop info
+	// block yields no traffic, ratio or bonus
+	UserInfoMobileBlock string `json:"userInfoMobileBlock,omitempty"`
+	// UserDetailsSeeding selects the peer section of userdetails.php holding
+	// the seeding list, used when the AJAX seeding endpoint returns nothing
+	UserDetailsSeeding string `json:"userDetailsSeeding,omitempty"`
+	// SeedingHRStatus selects the cells or badges of a seeding-list row holding
+	// the per-torrent H&R status. The default skips the title cell so titles
+	// such as "Unsatisfied.2024" are not read as a status
+	SeedingHRStatus string `json:"seedingHRStatus,omitempty"`
 	// Detail page selectors
 	// DetailDownloadLink selects the download link from details page
 	DetailDownloadLink string `json:"detailDownloadLink"`
 	// DetailSubtitle selects the subtitle from details page
 	DetailSubtitle string `json:"detailSubtitle"`
+	// DetailPoster selects the poster/cover image from details page
+	DetailPoster string `json:"detailPoster"`
+	// DetailPeers selects the live peers summary (e.g. "5个做种者 | 2个下载者") from details page
+	DetailPeers string `json:"detailPeers,omitempty"`
+	// DetailTorrentLinks selects every download link on a details page, for
+	// pages listing per-episode torrents of a season pack
+	DetailTorrentLinks string `json:"detailTorrentLinks,omitempty"`
+	// PeerListTable selects the seeder and leecher tables on the peer list page
+	PeerListTable string `json:"peerListTable,omitempty"`
+	// DetailMinRatio selects the minimum-ratio-to-download requirement
+	// (e.g. "最低分享率要求: 0.5") from details page
+	DetailMinRatio string `json:"detailMinRatio,omitempty"`
+	// CooldownPattern is a regular expression matching the site's anti-spam
+	// cooldown notice (default DefaultCooldownPattern)
+	CooldownPattern string `json:"cooldownPattern,omitempty"`
+	// CooldownNotice selects the message box a cooldown notice is shown in
+	// (default DefaultCooldownNotice); only its text is matched against
+	// CooldownPattern, so torrent descriptions quoting the notice are ignored
+	CooldownNotice string `json:"cooldownNotice,omitempty"`
+	// SearchIframe selects an iframe holding the torrent listing, for skins
+	// that render search results inside a frame
+	SearchIframe string `json:"searchIframe,omitempty"`
+	// StatsOnlineUsers selects the online user count on the homepage
+	StatsOnlineUsers string `json:"statsOnlineUsers,omitempty"`
+	// StatsTorrentsToday selects the count of torrents uploaded today on the homepage
+	StatsTorrentsToday string `json:"statsTorrentsToday,omitempty"`
+	// StatsTotalTorrents selects the total torrent count on the homepage
+	StatsTotalTorrents string `json:"statsTotalTorrents,omitempty"`
 }
 
 // DefaultNexusPHPSelectors returns default selectors for standard NexusPHP sites
 func DefaultNexusPHPSelectors() SiteSelectors {
 	return SiteSelectors{
-		TableRows:          "table.torrents > tbody > tr:not(:first-child)",
-		Title:              "td:nth-child(2) a[href*='details.php']",
-		TitleLink:          "td:nth-child(2) a[href*='details.php']",
-		Size:               "td:nth-child(5)",
-		Seeders:            "td:nth-child(6)",
-		Leechers:           "td:nth-child(7)",
-		Snatched:           "td:nth-child(8)",
-		DiscountIcon:       "img.pro_free, img.pro_free2up, img.pro_50pctdown, img.pro_30pctdown, img.pro_2up",
-		DiscountEndTime:    "span.free_end_time, span[title*='结束']",
-		DownloadLink:       "a[href*='download.php']",
-		Category:           "td:nth-child(1) img",
-		UploadTime:         "td:nth-child(4) span",
-		HRIcon:             "img.hitandrun, img[alt*='H&R'], img[title*='H&R']",
-		Subtitle:           "td:nth-child(2) br + *",
-		UserInfoUsername:   "#info_block a.User_Name, a[href*='userdetails.php']",
-		UserInfoUploaded:   "td:contains('上传量') + td, td:contains('Uploaded') + td",
-		UserInfoDownloaded: "td:contains('下载量') + td, td:contains('Downloaded') + td",
-		UserInfoRatio:      "td:contains('分享率') + td, td:contains('Ratio') + td",
-		UserInfoBonus:      "td:contains('魔力值') + td, td:contains('Bonus') + td",
-		UserInfoRank:       "td:contains('等级') + td, td:contains('Class') + td",
+		TableRows:            "table.torrents > tbody > tr:not(:first-child)",
+		AlternateTableRows:   DefaultAlternateTableRows(),
+		Title:                "td:nth-child(2) a[href*='details.php']",
+		TitleLink:            "td:nth-child(2) a[href*='details.php']",
+		Size:                 "td:nth-child(5)",
+		Seeders:              "td:nth-child(6)",
+		Leechers:             "td:nth-child(7)",
+		Snatched:             "td:nth-child(8)",
+		DiscountIcon:         "img.pro_free, img.pro_free2up, img.pro_50pctdown, img.pro_30pctdown, img.pro_75pctdown, img.pro_25pctdown, img.pro_2up, img.pro_custom, img.pro_neutral",
+		DiscountEndTime:      "span.free_end_time, span[title*='结束']",
+		DownloadLink:         "a[href*='download.php']",
+		Category:             "td:nth-child(1) img",
+		UploadTime:           "td:nth-child(4) span",
+		HRIcon:               "img.hitandrun, img[alt*='H&R'], img[title*='H&R']",
+		OfficialBadge:        "span.tgf, span.tags, img[alt*='官方'], img[title*='官方'], img[alt*='原创'], img[title*='原创']",
+		OfficialKeywords:     []string{"官方", "原创", "原創", "Official"},
+		ExclusiveBadge:       "span.tjz, span.tags, img[alt*='禁转'], img[title*='禁转'], img[alt*='内部'], img[title*='内部']",
+		ExclusiveKeywords:    []string{"禁转", "禁轉", "内部", "內部", "Exclusive", "Internal"},
+		FirstReleaseBadge:    "span.tags, span[class*='first'], img[alt*='首发'], img[title*='首发'], img[alt*='首發'], img[title*='首發']",
+		FirstReleaseKeywords: []string{"首发", "首發", "First Release"},
+		PendingBadge:         "span.tags, span[class*='approval'], img[alt*='待审'], img[title*='待审'], img[alt*='Pending'], img[title*='Pending']",
+		PendingKeywords:      []string{"待审核", "待審核", "待审", "待審", "审核中", "審核中", "Pending", "Unapproved"},
+		Subtitle:             "td:nth-child(2) br + *",
+		UserInfoUsername:     "#info_block a.User_Name, a[href*='userdetails.php']",
+		UserInfoUploaded:     "td:contains('上传量') + td, td:contains('Uploaded') + td",
+		UserInfoDownloaded:   "td:contains('下载量') + td, td:contains('Downloaded') + td",
+		UserInfoRatio:        "td:contains('分享率') + td, td:contains('Ratio') + td",
+		UserInfoBonus:        "td:contains('魔力值') + td, td:contains('Bonus') + td",
+		UserInfoRank:         "td:contains('等级') + td, td:contains('Class') + td",
+		UserInfoMobileBlock:  "#m_userinfo, #mobile_info_block, .m-userinfo, .mobile-userinfo, .user-info-mobile",
+		UserDetailsSeeding:   "#ka1, #seeding",
+		SeedingHRStatus:      "td:not(:has(a[href*='details.php'])), .hitandrun",
 		// Detail page selectors - default for standard NexusPHP sites
 		DetailDownloadLink: "td.rowhead:contains('下载链接') + td a[href*='download.php'], form[action*='download.php']",
 		DetailSubtitle:     "td.rowhead:contains('副标题') + td, td.rowhead:contains('小标题') + td",
+		DetailPoster:       "img#poster, .poster img, #kdescr img",
+		DetailPeers:        "td.rowhead:contains('同伴') + td, td.rowhead:contains('Peers') + td",
+		PeerListTable:      "table",
+		DetailTorrentLinks: "a[href*='download.php']",
+		DetailMinRatio:     "td.rowhead:contains('最低分享率') + td, td.rowhead:contains('Min Ratio') + td, td.rowhead:contains('Minimum Ratio') + td",
+		// Homepage stats selectors
+		StatsOnlineUsers:   "td.rowhead:contains('在线用户') + td, td.rowhead:contains('在线人数') + td, td.rowhead:contains('当前在线') + td, td.rowhead:contains('Online') + td",
+		StatsTorrentsToday: "td.rowhead:contains('今日新种') + td, td.rowhead:contains('今日种子') + td, td.rowhead:contains('Torrents today') + td",
+		StatsTotalTorrents: "td.rowhead:contains('种子总数') + td, td.rowhead:contains('种子数') + td, td.rowhead:contains('Total torrents') + td",
+	}
+}
+
+// DefaultAlternateTableRows returns row selectors for newer NexusPHP layouts.
+// NexusPHP v1.8+ (the rewritten frontend) renders the listing as table.torrentsNexus,
+// sometimes wrapped in div.torrentsNexus, with the header row moved into <thead>.
+// The tbody-less variants cover documents built from nodes or fragments, where
+// no <tbody> is synthesized the way the HTML parser does for full pages.
+// Header rows without a title link are skipped by ParseSearch.
+func DefaultAlternateTableRows() []string {
+	return []string{
+		"table.torrentsNexus > tbody > tr",
+		"div.torrentsNexus table > tbody > tr",
+		"table.torrents > tr:not(:first-child)",
+		"table.torrentsNexus > tr",
 	}
 }
 
@@ -130,6 +305,7 @@ func truncateStr(s string, maxLen int) string {
 type NexusPHPDriver struct {
 	BaseURL        string
 	Cookie         string
+	Passkey        string
 	Selectors      SiteSelectors
 	httpClient     *SiteHTTPClient
 	failoverClient *FailoverHTTPClient
@@ -137,6 +313,44 @@ type NexusPHPDriver struct {
 	useFailover    bool
 	siteName       SiteName
 	siteDefinition *SiteDefinition
+	// downloadParams are merged into the download URL before fetching
+	downloadParams url.Values
+	// promotionPath is the page listing current discounted torrents
+	promotionPath string
+	// peerListPath is the page listing a torrent's peers
+	peerListPath string
+	// cooldownRegex is Selectors.CooldownPattern compiled once; nil when the
+	// pattern is invalid, which disables cooldown detection
+	cooldownRegex *regexp.Regexp
+	// rankMu guards userRank, which GetUserInfo updates while searches read it
+	rankMu sync.RWMutex
+	// userRank is the user's class, checked against Selectors.ClassFreeMapping
+	userRank string
+	// now is the reference time for relative upload times
+	now func() time.Time
+	// charset overrides the page charset detected from headers and <meta>
+	charset string
+	// downloadConfirmPath is visited before fetching a torrent so the site
+	// can set the cookie its JS download confirmation would have set
+	downloadConfirmPath string
+	// thanksPath is posted to when a detail page hides its download link
+	// until the user says thanks
+	thanksPath string
+	// downloadForm, when non-nil, makes downloads a POST to download.php with
+	// id, passkey and these extra fields as the form body
+	downloadForm url.Values
+	// limiter spaces every HTTP request the driver sends, including the
+	// concurrent user-info processes; nil means unthrottled
+	limiter *rate.Limiter
+	// retryMaxAttempts and retryBaseDelay bound the backoff retries of
+	// transient failures in executeDirectly
+	retryMaxAttempts int
+	retryBaseDelay   time.Duration
+	// excludeKeywords drop search rows whose title contains any of them
+	excludeKeywords []string
+	// authMu guards lastAuthErr, which Execute updates concurrently
+	authMu      sync.Mutex
+	lastAuthErr error
 }
 
 // NexusPHPDriverConfig holds configuration for creating a NexusPHP driver
@@ -148,6 +362,81 @@ type NexusPHPDriverConfig struct {
 	UserAgent   string
 	UseFailover bool     // Enable multi-URL failover
 	SiteName    SiteName // Site name for failover URL lookup
+	// Concurrency is the expected number of concurrent requests (e.g. userinfo phases).
+	// Above 1, the default client keeps connections alive with a pool sized to it.
+	Concurrency int
+	// KeepAlive overrides the keep-alive choice derived from Concurrency
+	KeepAlive *bool
+	// MaxIdleConns overrides the idle connection pool size
+	MaxIdleConns int
+	// DownloadURLParams are set on the download URL before fetching, for sites
+	// whose download.php needs a discriminator such as type=torrent
+	DownloadURLParams url.Values
+	// PromotionPath is the page listing all current discounted torrents
+	// (default "/promotion.php"), used by GetPromotions
+	PromotionPath string
+	// PeerListPath is the page listing a torrent's peers (default
+	// "/viewpeerlist.php"), used by GetPeerList
+	PeerListPath string
+	// Passkey, when set, lets downloads go straight to download.php
+	// instead of scraping the link from the details page
+	Passkey string
+	// Charset forces the page encoding (e.g. "gbk") for sites that declare
+	// it wrongly; empty detects it from Content-Type and <meta>
+	Charset string
+	// DownloadConfirmPath is requested before each torrent download, for sites
+	// whose download.php only works after a JS confirm sets a cookie.
+	// "{id}" is replaced with the torrent ID.
+	DownloadConfirmPath string
+	// ThanksPath is the endpoint (e.g. "/thanks.php") the torrent ID is posted
+	// to when a detail page only shows the download link after "说谢谢";
+	// the detail page is then fetched again. Empty disables the step
+	ThanksPath string
+	// DownloadMethod "POST" fetches torrents by posting id and passkey as a
+	// form to download.php, for sites whose GET download returns an error
+	DownloadMethod string
+	// DownloadFormFields are extra fields sent with a POST download
+	DownloadFormFields map[string]string
+	// RateLimit caps the requests per second sent by the driver itself, shared
+	// by all concurrent requests; 0 leaves the driver unthrottled
+	RateLimit float64
+	// RateBurst is the limiter's burst size (default 3)
+	RateBurst int
+	// GlobalExcludeKeywords drop search rows whose title contains any of them
+	// (case-insensitive) before filter rules run, e.g. a personal ban list
+	GlobalExcludeKeywords []string
+	// RetryMaxAttempts is the number of attempts for a request failing with a
+	// network error or 502/503/504 (default 3; 1 disables retries)
+	RetryMaxAttempts int
+	// RetryBaseDelay is the backoff before the first retry, doubled for each
+	// later one (default 500ms)
+	RetryBaseDelay time.Duration
+}
+
+// httpClientConfig builds the default SiteHTTPClient configuration.
+// Keep-alives stay disabled for sequential use; when concurrency is expected
+// they are enabled so parallel requests reuse pooled connections.
+func (c NexusPHPDriverConfig) httpClientConfig(userAgent string) SiteHTTPClientConfig {
+	keepAlive := c.Concurrency > 1
+	if c.KeepAlive != nil {
+		keepAlive = *c.KeepAlive
+	}
+
+	maxIdle := 10
+	if keepAlive && c.Concurrency > maxIdle {
+		maxIdle = c.Concurrency
+	}
+	if c.MaxIdleConns > 0 {
+		maxIdle = c.MaxIdleConns
+	}
+
+	return SiteHTTPClientConfig{
+		Timeout:           30 * time.Second,
+		MaxIdleConns:      maxIdle,
+		IdleConnTimeout:   30 * time.Second,
+		DisableKeepAlives: !keepAlive,
+		UserAgent:         userAgent,
+	}
 }
 
 // NewNexusPHPDriver creates a new NexusPHP driver
@@ -164,23 +453,68 @@ func NewNexusPHPDriver(config NexusPHPDriverConfig) *NexusPHPDriver {
 
 	httpClient := config.HTTPClient
 	if httpClient == nil {
-		httpClient = NewSiteHTTPClient(SiteHTTPClientConfig{
-			Timeout:           30 * time.Second,
-			MaxIdleConns:      10,
-			IdleConnTimeout:   30 * time.Second,
-			DisableKeepAlives: true,
-			UserAgent:         userAgent,
-		})
+		httpClient = NewSiteHTTPClient(config.httpClientConfig(userAgent))
 	}
 
 	driver := &NexusPHPDriver{
-		BaseURL:     strings.TrimSuffix(config.BaseURL, "/"),
-		Cookie:      config.Cookie,
-		Selectors:   selectors,
-		httpClient:  httpClient,
-		userAgent:   userAgent,
-		useFailover: config.UseFailover,
-		siteName:    config.SiteName,
+		BaseURL:             strings.TrimSuffix(config.BaseURL, "/"),
+		Cookie:              config.Cookie,
+		Passkey:             strings.TrimSpace(config.Passkey),
+		Selectors:           selectors,
+		charset:             config.Charset,
+		downloadConfirmPath: strings.TrimSpace(config.DownloadConfirmPath),
+		thanksPath:          strings.TrimSpace(config.ThanksPath),
+		httpClient:          httpClient,
+		userAgent:           userAgent,
+		useFailover:         config.UseFailover,
+		siteName:            config.SiteName,
+	}
+	driver.cooldownRegex = compileCooldownPattern(selectors.CooldownPattern)
+	driver.promotionPath = config.PromotionPath
+	if driver.promotionPath == "" {
+		driver.promotionPath = defaultPromotionPath
+	}
+	if !strings.HasPrefix(driver.promotionPath, "/") {
+		driver.promotionPath = "/" + driver.promotionPath
+	}
+	if config.RateLimit > 0 {
+		burst := config.RateBurst
+		if burst <= 0 {
+			burst = 3
+		}
+		driver.limiter = rate.NewLimiter(rate.Limit(config.RateLimit), burst)
+	}
+	driver.retryMaxAttempts = config.RetryMaxAttempts
+	if driver.retryMaxAttempts <= 0 {
+		driver.retryMaxAttempts = defaultRetryMaxAttempts
+	}
+	driver.retryBaseDelay = config.RetryBaseDelay
+	if driver.retryBaseDelay <= 0 {
+		driver.retryBaseDelay = defaultRetryBaseDelay
+	}
+	driver.peerListPath = config.PeerListPath
+	if driver.peerListPath == "" {
+		driver.peerListPath = defaultPeerListPath
+	}
+	if !strings.HasPrefix(driver.peerListPath, "/") {
+		driver.peerListPath = "/" + driver.peerListPath
+	}
+	for _, kw := range config.GlobalExcludeKeywords {
+		if kw = strings.TrimSpace(kw); kw != "" {
+			driver.excludeKeywords = append(driver.excludeKeywords, kw)
+		}
+	}
+	if strings.EqualFold(config.DownloadMethod, http.MethodPost) {
+		driver.downloadForm = make(url.Values, len(config.DownloadFormFields))
+		for k, v := range config.DownloadFormFields {
+			driver.downloadForm.Set(k, v)
+		}
+	}
+	if len(config.DownloadURLParams) > 0 {
+		driver.downloadParams = make(url.Values, len(config.DownloadURLParams))
+		for k, v := range config.DownloadURLParams {
+			driver.downloadParams[k] = append([]string(nil), v...)
+		}
 	}
 
 	// Initialize failover client if enabled and site name is provided
@@ -219,6 +553,22 @@ func (d *NexusPHPDriver) SetSiteDefinition(def *SiteDefinition) {
 	d.siteDefinition = def
 }
 
+// SetUserRank sets the user's class (UserInfo.Rank) used to resolve
+// class-conditional free icons via Selectors.ClassFreeMapping. GetUserInfo
+// calls it with the parsed rank.
+func (d *NexusPHPDriver) SetUserRank(rank string) {
+	d.rankMu.Lock()
+	defer d.rankMu.Unlock()
+	d.userRank = strings.TrimSpace(rank)
+}
+
+// getUserRank returns the rank set by SetUserRank
+func (d *NexusPHPDriver) getUserRank() string {
+	d.rankMu.RLock()
+	defer d.rankMu.RUnlock()
+	return d.userRank
+}
+
 // GetSiteDefinition returns the site definition
 func (d *NexusPHPDriver) GetSiteDefinition() *SiteDefinition {
 	return d.siteDefinition
@@ -238,23 +588,136 @@ func (d *NexusPHPDriver) PrepareSearch(query SearchQuery) (NexusPHPRequest, erro
 		params.Set("spstate", "2") // Free torrents in NexusPHP
 	}
 	if query.Page > 0 {
-		params.Set("page", strconv.Itoa(query.Page-1)) // NexusPHP uses 0-indexed pages
+		page := query.Page
+		if d.siteDefinition.PagesZeroIndexed() {
+			page-- // NexusPHP uses 0-indexed pages
+		}
+		params.Set("page", strconv.Itoa(page))
+	}
+	if imdbID := query.NormalizedIMDbID(); imdbID != "" {
+		d.setIMDbSearchParams(params, imdbID)
 	}
 
 	return NexusPHPRequest{
-		Path:   "/torrents.php",
-		Params: params,
-		Method: "GET",
+		Path:           "/torrents.php",
+		Params:         params,
+		Method:         "GET",
+		IframeSelector: d.Selectors.SearchIframe,
 	}, nil
 }
 
+// Default NexusPHP IMDb search: search=tt1234567&search_area=4
+const (
+	defaultIMDbParam      = "search"
+	defaultIMDbSearchArea = "4"
+)
+
+// setIMDbSearchParams adds an IMDb search to params using the site
+// definition's SearchConfig, falling back to the NexusPHP IMDb search area
+func (d *NexusPHPDriver) setIMDbSearchParams(params url.Values, imdbID string) {
+	var cfg SearchConfig
+	if d.siteDefinition != nil && d.siteDefinition.Search != nil {
+		cfg = *d.siteDefinition.Search
+	}
+
+	param := cfg.IMDbParam
+	if param == "" {
+		param = defaultIMDbParam
+	}
+	area := cfg.IMDbSearchArea
+	if area == "" && param == defaultIMDbParam {
+		area = defaultIMDbSearchArea
+	}
+	if cfg.IMDbNumericOnly {
+		imdbID = strings.TrimPrefix(imdbID, "tt")
+	}
+
+	params.Set(param, imdbID)
+	if area != "" {
+		params.Set("search_area", area)
+	}
+}
+
 // Execute performs the HTTP request
 func (d *NexusPHPDriver) Execute(ctx context.Context, req NexusPHPRequest) (NexusPHPResponse, error) {
 	// Use failover client if available
+	var (
+		res NexusPHPResponse
+		err error
+	)
 	if d.useFailover && d.failoverClient != nil {
-		return d.executeWithFailover(ctx, req)
+		res, err = d.executeWithFailover(ctx, req)
+	} else {
+		res, err = d.executeDirectly(ctx, req, d.BaseURL)
+	}
+	if err == nil && req.IframeSelector != "" {
+		res, err = d.followIframe(ctx, req.IframeSelector, res)
+	}
+	d.recordAuthResult(err)
+	return res, err
+}
+
+// isAuthError reports whether err means the cookie no longer authenticates
+func isAuthError(err error) bool {
+	return errors.Is(err, ErrInvalidCredentials) || errors.Is(err, ErrSessionExpired) || errors.Is(err, Err2FARequired)
+}
+
+// recordAuthResult updates LastAuthError: auth failures are recorded and a
+// success clears them, while other errors (network, parse) say nothing about
+// the cookie and leave it unchanged
+func (d *NexusPHPDriver) recordAuthResult(err error) {
+	if err != nil && !isAuthError(err) {
+		return
+	}
+	d.authMu.Lock()
+	d.lastAuthErr = err
+	d.authMu.Unlock()
+}
+
+// LastAuthError returns the auth error (invalid credentials, expired session
+// or 2FA page) seen by the most recent conclusive Execute, or nil when that
+// request authenticated fine
+func (d *NexusPHPDriver) LastAuthError() error {
+	d.authMu.Lock()
+	defer d.authMu.Unlock()
+	return d.lastAuthErr
+}
+
+// IsSessionValid fetches /index.php and reports false when it lands on the
+// login or 2FA page or is refused with 401/403, so schedulers can skip sites
+// with dead cookies. Other failures such as network errors don't prove the
+// cookie dead and report true.
+func (d *NexusPHPDriver) IsSessionValid(ctx context.Context) bool {
+	_, err := d.Execute(ctx, NexusPHPRequest{Path: "/index.php", Method: http.MethodGet})
+	return !isAuthError(err)
+}
+
+// followIframe fetches the iframe matched by selector when the page itself
+// has no torrent rows. Only same-host frames are followed so the cookie is
+// never sent elsewhere; otherwise the original response is returned.
+func (d *NexusPHPDriver) followIframe(ctx context.Context, selector string, res NexusPHPResponse) (NexusPHPResponse, error) {
+	if res.Document == nil || d.findSearchRows(res.Document).Length() > 0 {
+		return res, nil
+	}
+	src, ok := res.Document.Find(selector).First().Attr("src")
+	src = strings.TrimSpace(src)
+	if !ok || src == "" {
+		return res, nil
+	}
+
+	frameURL, err := url.Parse(d.resolveURL(src))
+	if err != nil {
+		return res, nil
 	}
-	return d.executeDirectly(ctx, req, d.BaseURL)
+	base, err := url.Parse(d.BaseURL)
+	if err != nil || !strings.EqualFold(frameURL.Host, base.Host) {
+		return res, nil
+	}
+
+	return d.executeDirectly(ctx, NexusPHPRequest{
+		Path:   frameURL.RequestURI(),
+		Method: "GET",
+	}, frameURL.Scheme+"://"+frameURL.Host)
 }
 
 // executeWithFailover executes request with automatic URL failover
@@ -271,31 +734,118 @@ func (d *NexusPHPDriver) executeWithFailover(ctx context.Context, req NexusPHPRe
 	return result, err
 }
 
-// executeDirectly performs the HTTP request to a specific base URL
+// waitRateLimit blocks until the driver's rate limiter admits a request
+func (d *NexusPHPDriver) waitRateLimit(ctx context.Context) error {
+	if d.limiter == nil {
+		return nil
+	}
+	if err := d.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("rate limit: %w", err)
+	}
+	return nil
+}
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryBaseDelay   = 500 * time.Millisecond
+	maxRetryDelay           = 10 * time.Second
+)
+
+// executeDirectly performs the HTTP request to a specific base URL, retrying
+// network errors and 502/503/504 with exponential backoff. Each attempt waits
+// for the rate limiter, and no retry is started past the context deadline.
 func (d *NexusPHPDriver) executeDirectly(ctx context.Context, req NexusPHPRequest, baseURL string) (NexusPHPResponse, error) {
+	delay := d.retryBaseDelay
+	for attempt := 1; ; attempt++ {
+		result, err := d.executeOnce(ctx, req, baseURL)
+		if err == nil || attempt >= d.retryMaxAttempts || ctx.Err() != nil || !isRetryableResult(result, err) {
+			return result, err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return result, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, err
+		case <-timer.C:
+		}
+		delay = min(delay*2, maxRetryDelay)
+	}
+}
+
+// isRetryableResult reports whether a failed attempt is transient: a network
+// error (no status received) or a gateway/unavailable status. Auth failures,
+// other statuses and parse errors are returned as is.
+func isRetryableResult(result NexusPHPResponse, err error) bool {
+	switch result.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	case 0:
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	default:
+		return false
+	}
+}
+
+// executeOnce performs a single attempt of the HTTP request
+func (d *NexusPHPDriver) executeOnce(ctx context.Context, req NexusPHPRequest, baseURL string) (NexusPHPResponse, error) {
+	if err := d.waitRateLimit(ctx); err != nil {
+		return NexusPHPResponse{}, err
+	}
+
 	method := req.Method
 	if method == "" {
 		method = "GET"
 	}
 
-	fullURL := baseURL + req.Path
-	if len(req.Params) > 0 {
-		fullURL += "?" + req.Params.Encode()
-	}
-
 	headers := map[string]string{
 		"Cookie":          d.Cookie,
 		"User-Agent":      d.userAgent,
 		"Accept":          "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
 		"Accept-Language": "zh-CN,zh;q=0.9,en;q=0.8",
 	}
+	if req.Raw {
+		headers["Accept"] = "application/x-bittorrent,*/*"
+		headers["Referer"] = baseURL + "/"
+	}
+
+	// POST sends the params as a form body unless an explicit body is given,
+	// everything else as a query string
+	fullURL := baseURL + req.Path
+	var body []byte
+	isPost := strings.EqualFold(method, http.MethodPost)
+	switch {
+	case isPost && req.Body != nil:
+		method = http.MethodPost
+		body = req.Body
+		headers["Content-Type"] = req.ContentType
+		if req.ContentType == "" {
+			headers["Content-Type"] = "application/json"
+		}
+	case isPost:
+		method = http.MethodPost
+		body = []byte(req.Params.Encode())
+		headers["Content-Type"] = "application/x-www-form-urlencoded"
+	}
+	if len(req.Params) > 0 && (!isPost || req.Body != nil) {
+		fullURL += "?" + req.Params.Encode()
+	}
 
 	// Print curl command for debugging
 	if DebugUserInfo {
 		fmt.Printf("\n[CURL] %s\n", buildCurlCommand(method, fullURL, headers))
 	}
 
-	resp, err := d.httpClient.Get(ctx, fullURL, headers)
+	var resp *HTTPResponse
+	var err error
+	if method == http.MethodPost {
+		resp, err = d.httpClient.Post(ctx, fullURL, body, headers)
+	} else {
+		resp, err = d.httpClient.Get(ctx, fullURL, headers)
+	}
 	if err != nil {
 		return NexusPHPResponse{}, fmt.Errorf("execute request: %w", err)
 	}
@@ -314,15 +864,22 @@ func (d *NexusPHPDriver) executeDirectly(ctx context.Context, req NexusPHPReques
 		return result, fmt.Errorf("HTTP %d: %s", resp.StatusCode, http.StatusText(resp.StatusCode))
 	}
 
+	if req.Raw {
+		return result, d.checkRawDownload(resp.Body)
+	}
+
+	// Transcode legacy charsets (e.g. GBK) so labels match UTF-8 selectors
+	result.RawBody = decodeHTML(resp.Body, resp.Headers.Get("Content-Type"), d.charset)
+
 	// Parse HTML document
-	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(resp.Body)))
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(result.RawBody))
 	if err != nil {
 		return result, fmt.Errorf("parse HTML: %w", err)
 	}
 	result.Document = doc
 
 	// Check if we're on a login page (cookie expired or invalid)
-	if isLoginPage(doc) {
+	if d.detectLoginPage(doc) {
 		return result, ErrSessionExpired
 	}
 
@@ -331,9 +888,36 @@ func (d *NexusPHPDriver) executeDirectly(ctx context.Context, req NexusPHPReques
 		return result, Err2FARequired
 	}
 
+	// Check for an anti-spam cooldown notice served with 200
+	if err := d.cooldownError(doc); err != nil {
+		return result, err
+	}
+
 	return result, nil
 }
 
+// detectLoginPage reports whether doc is a login page, consulting the site
+// definition's login selectors and keywords before the built-in heuristics
+func (d *NexusPHPDriver) detectLoginPage(doc *goquery.Document) bool {
+	if def := d.siteDefinition; def != nil {
+		for _, sel := range def.LoginPageSelectors {
+			if sel != "" && doc.Find(sel).Length() > 0 {
+				return true
+			}
+		}
+		if len(def.LoginPageKeywords) > 0 {
+			// Title and inline scripts cover both login templates and JS redirects
+			text := strings.ToLower(doc.Find("title").Text() + "\n" + doc.Find("script").Text())
+			for _, kw := range def.LoginPageKeywords {
+				if kw != "" && strings.Contains(text, strings.ToLower(kw)) {
+					return true
+				}
+			}
+		}
+	}
+	return isLoginPage(doc)
+}
+
 // isLoginPage checks if the HTML document is a login page
 // This indicates the session/cookie has expired or is invalid
 func isLoginPage(doc *goquery.Document) bool {
@@ -410,126 +994,241 @@ func (d *NexusPHPDriver) ParseSearch(res NexusPHPResponse) ([]TorrentItem, error
 
 	var items []TorrentItem
 
-	res.Document.Find(d.Selectors.TableRows).Each(func(i int, s *goquery.Selection) {
-		item := TorrentItem{
-			SourceSite:    d.BaseURL,
-			DiscountLevel: DiscountNone,
+	d.findSearchRows(res.Document).Each(func(_ int, s *goquery.Selection) {
+		if item, ok := d.parseSearchRow(s); ok {
+			items = append(items, item)
 		}
+	})
+
+	return items, nil
+}
+
+// parseSearchRow parses one listing row or card; ok is false for rows
+// without a title or whose title has an excluded keyword
+func (d *NexusPHPDriver) parseSearchRow(s *goquery.Selection) (TorrentItem, bool) {
+	item := TorrentItem{
+		SourceSite:    d.BaseURL,
+		DiscountLevel: DiscountNone,
+	}
 
-		// Parse title and ID
-		titleElem := s.Find(d.Selectors.Title)
-		item.Title = strings.TrimSpace(titleElem.Text())
-		if href, exists := titleElem.Attr("href"); exists {
-			item.ID = extractTorrentID(href)
-			item.URL = d.BaseURL + "/" + href
+	// Cards take their field selectors from the card layout
+	sel := &d.Selectors
+	if cards := d.Selectors.Cards; cards != nil && cards.Item != "" && s.Is(cards.Item) {
+		overlaid := cards.overlay(d.Selectors)
+		sel = &overlaid
+	}
+
+	// Parse title and ID; a title split across several anchors to the same
+	// details page is joined, and the secondary parts kept as a subtitle
+	titleElem := titleAnchors(s.Find(sel.Title))
+	item.Title = joinedText(titleElem)
+	if href, exists := titleElem.Attr("href"); exists {
+		item.ID = extractTorrentID(href)
+		item.URL = d.BaseURL + "/" + href
+	}
+
+	// Skip if no title or the title is excluded
+	if item.Title == "" || (len(d.excludeKeywords) > 0 && containsAny(item.Title, d.excludeKeywords...)) {
+		return TorrentItem{}, false
+	}
+
+	// Parse subtitle (副标题) - usually in the same cell as title
+	if sel.Subtitle != "" {
+		subtitleElem := s.Find(sel.Subtitle)
+		if subtitleElem.Length() > 0 {
+			item.Subtitle = strings.TrimSpace(subtitleElem.Text())
 		}
+	}
+	if item.Subtitle == "" && titleElem.Length() > 1 {
+		item.Subtitle = joinedText(titleElem.Slice(1, titleElem.Length()))
+	}
 
-		// Skip if no title
-		if item.Title == "" {
-			return
+	// Parse group ID (合集) linking editions of the same title
+	if sel.GroupLink != "" {
+		if href, exists := s.Find(sel.GroupLink).First().Attr("href"); exists {
+			item.GroupID = extractGroupID(href)
 		}
+	}
 
-		// Parse subtitle (副标题) - usually in the same cell as title
-		if d.Selectors.Subtitle != "" {
-			subtitleElem := s.Find(d.Selectors.Subtitle)
-			if subtitleElem.Length() > 0 {
-				item.Subtitle = strings.TrimSpace(subtitleElem.Text())
-			}
+	// Parse the uploader's class (发布员, staff...) as a trust hint
+	if sel.UploaderClass != "" {
+		item.UploaderClass = parseUploaderClass(s.Find(sel.UploaderClass).First())
+	}
+
+	// Parse size, preferring a raw byte count attribute on the size cell
+	sizeElem := s.Find(sel.Size)
+	item.SizeBytes = -1
+	for _, attr := range sizeBytesAttrs(sel.SizeBytesAttr) {
+		if size, ok := parseSizeAttr(sizeElem, attr); ok {
+			item.SizeBytes = size
+			break
 		}
+	}
+	if item.SizeBytes < 0 {
+		item.SizeBytes = parseSizeWithBase(strings.TrimSpace(sizeElem.Text()), sel.SizeBase)
+	}
 
-		// Parse size
-		sizeText := strings.TrimSpace(s.Find(d.Selectors.Size).Text())
-		item.SizeBytes = parseSize(sizeText)
+	// Parse seeders
+	seedersText := strings.TrimSpace(s.Find(sel.Seeders).Text())
+	item.Seeders, _ = strconv.Atoi(seedersText)
 
-		// Parse seeders
-		seedersText := strings.TrimSpace(s.Find(d.Selectors.Seeders).Text())
-		item.Seeders, _ = strconv.Atoi(seedersText)
+	// Parse leechers
+	leechersText := strings.TrimSpace(s.Find(sel.Leechers).Text())
+	item.Leechers, _ = strconv.Atoi(leechersText)
 
-		// Parse leechers
-		leechersText := strings.TrimSpace(s.Find(d.Selectors.Leechers).Text())
-		item.Leechers, _ = strconv.Atoi(leechersText)
+	// Parse snatched
+	snatchedText := strings.TrimSpace(s.Find(sel.Snatched).Text())
+	item.Snatched, _ = strconv.Atoi(snatchedText)
 
-		// Parse snatched
-		snatchedText := strings.TrimSpace(s.Find(d.Selectors.Snatched).Text())
-		item.Snatched, _ = strconv.Atoi(snatchedText)
+	// Parse discount level
+	discountElem := s.Find(sel.DiscountIcon)
+	if discountElem.Length() > 0 {
+		item.DiscountLevel = parseDiscountWithClassFree(discountElem, sel.ClassFreeMapping, d.getUserRank(), sel.DiscountMapping)
+	}
 
-		// Parse discount level
-		discountElem := s.Find(d.Selectors.DiscountIcon)
-		if discountElem.Length() > 0 {
-			item.DiscountLevel = parseDiscountFromElement(discountElem, d.Selectors.DiscountMapping)
+	// Parse discount end time
+	endTimeElem := s.Find(sel.DiscountEndTime)
+	if endTimeElem.Length() > 0 {
+		if title, exists := endTimeElem.Attr("title"); exists {
+			item.DiscountEndTime = parseTime(title)
+		} else {
+			item.DiscountEndTime = parseTime(endTimeElem.Text())
 		}
+	}
 
-		// Parse discount end time
-		endTimeElem := s.Find(d.Selectors.DiscountEndTime)
-		if endTimeElem.Length() > 0 {
-			if title, exists := endTimeElem.Attr("title"); exists {
-				item.DiscountEndTime = parseTime(title)
-			} else {
-				item.DiscountEndTime = parseTime(endTimeElem.Text())
-			}
+	// Fallback: parse discount end time from onmouseover attribute of discount icon
+	// Some sites (like HDSky) embed the end time in the tooltip, not as a separate element
+	// Format: domTT_activate(..., '<span title="2026-01-18 22:37:47">1时19分</span>', ...)
+	if item.DiscountEndTime.IsZero() && discountElem.Length() > 0 {
+		if onmouseover, exists := discountElem.Attr("onmouseover"); exists && onmouseover != "" {
+			item.DiscountEndTime = parseDiscountEndTimeFromOnmouseover(onmouseover)
 		}
+	}
 
-		// Fallback: parse discount end time from onmouseover attribute of discount icon
-		// Some sites (like HDSky) embed the end time in the tooltip, not as a separate element
-		// Format: domTT_activate(..., '<span title="2026-01-18 22:37:47">1时19分</span>', ...)
-		if item.DiscountEndTime.IsZero() && discountElem.Length() > 0 {
-			if onmouseover, exists := discountElem.Attr("onmouseover"); exists && onmouseover != "" {
-				item.DiscountEndTime = parseDiscountEndTimeFromOnmouseover(onmouseover)
-			}
+	// Parse download link - use proxy URL instead of direct link for authentication handling
+	// The backend proxy will handle cookie/passkey authentication
+	if item.ID != "" {
+		// Use proxy download URL that handles authentication
+		siteID := string(d.siteName)
+		if siteID == "" {
+			// Fallback to extracting site ID from BaseURL
+			siteID = extractSiteIDFromURL(d.BaseURL)
 		}
-
-		// Parse download link - use proxy URL instead of direct link for authentication handling
-		// The backend proxy will handle cookie/passkey authentication
-		if item.ID != "" {
-			// Use proxy download URL that handles authentication
-			siteID := string(d.siteName)
-			if siteID == "" {
-				// Fallback to extracting site ID from BaseURL
-				siteID = extractSiteIDFromURL(d.BaseURL)
-			}
-			item.DownloadURL = fmt.Sprintf("/api/site/%s/torrent/%s/download", siteID, item.ID)
-		} else {
-			// If no ID, try to get direct link (may not work without passkey)
-			downloadElem := s.Find(d.Selectors.DownloadLink)
-			if href, exists := downloadElem.Attr("href"); exists {
-				item.DownloadURL = d.BaseURL + "/" + href
-			}
+		item.DownloadURL = fmt.Sprintf("/api/site/%s/torrent/%s/download", siteID, item.ID)
+	} else {
+		// If no ID, try to get direct link (may not work without passkey)
+		downloadElem := s.Find(sel.DownloadLink)
+		if href, exists := downloadElem.Attr("href"); exists {
+			item.DownloadURL = d.BaseURL + "/" + href
 		}
+	}
 
-		// Parse category
-		categoryElem := s.Find(d.Selectors.Category)
-		if alt, exists := categoryElem.Attr("alt"); exists {
-			item.Category = alt
+	// Parse category
+	categoryElem := s.Find(sel.Category)
+	if alt, exists := categoryElem.Attr("alt"); exists {
+		item.Category = alt
+	}
+	item.NormalizedCategory = item.Category
+	if def := d.siteDefinition; def != nil && categoryElem.Length() > 0 {
+		if category, ok := normalizeCategory(categoryElem.First(), def.CategoryMapping); ok {
+			item.NormalizedCategory = category
 		}
+	}
 
-		// Parse upload time
-		if d.Selectors.UploadTime != "" {
-			uploadTimeElem := s.Find(d.Selectors.UploadTime)
-			if uploadTimeElem.Length() > 0 {
-				// Try to get time from title attribute first (more precise)
-				if title, exists := uploadTimeElem.Attr("title"); exists && title != "" {
-					if t := parseTime(title); !t.IsZero() {
-						item.UploadedAt = t.Unix()
-					}
+	// Parse upload time
+	if sel.UploadTime != "" {
+		uploadTimeElem := s.Find(sel.UploadTime)
+		if uploadTimeElem.Length() > 0 {
+			// Try to get time from title attribute first (more precise)
+			if title, exists := uploadTimeElem.Attr("title"); exists && title != "" {
+				if t := parseTime(title); !t.IsZero() {
+					item.UploadedAt = t.Unix()
 				}
-				// Fallback to text content
-				if item.UploadedAt == 0 {
-					timeText := strings.TrimSpace(uploadTimeElem.Text())
-					if t := parseTime(timeText); !t.IsZero() {
-						item.UploadedAt = t.Unix()
-					}
+			}
+			// Fallback to text content, which may be relative ("3天前")
+			if item.UploadedAt == 0 {
+				timeText := strings.TrimSpace(uploadTimeElem.Text())
+				if t := parseTime(timeText); !t.IsZero() {
+					item.UploadedAt = t.Unix()
+				} else if t := parseRelativeTime(timeText, d.referenceTime()); !t.IsZero() {
+					item.UploadedAt = t.Unix()
 				}
 			}
 		}
+	}
 
-		// Check for H&R
-		hrElem := s.Find(d.Selectors.HRIcon)
-		item.HasHR = hrElem.Length() > 0
+	// Check for H&R, as an icon or a text badge
+	item.HasHR = s.Find(sel.HRIcon).Length() > 0 || d.hasHRKeyword(s)
 
-		items = append(items, item)
+	item.IsOfficial = hasBadge(s, sel.OfficialBadge, sel.OfficialKeywords)
+	item.IsExclusive = hasBadge(s, sel.ExclusiveBadge, sel.ExclusiveKeywords)
+	item.IsFirstRelease = hasBadge(s, sel.FirstReleaseBadge, sel.FirstReleaseKeywords)
+	item.Pending = hasBadge(s, sel.PendingBadge, sel.PendingKeywords)
+
+	return item, true
+}
+
+// ParseSearchStream parses the listing like ParseSearch but emits items on the
+// returned channel as rows are parsed, closing it after the last row. The
+// channel is unbuffered, so callers must drain it to let the parser finish.
+// A response without a document yields a closed, empty channel.
+func (d *NexusPHPDriver) ParseSearchStream(res NexusPHPResponse) <-chan TorrentItem {
+	out := make(chan TorrentItem)
+	go func() {
+		defer close(out)
+		if res.Document == nil {
+			return
+		}
+		d.findSearchRows(res.Document).Each(func(_ int, s *goquery.Selection) {
+			if item, ok := d.parseSearchRow(s); ok {
+				out <- item
+			}
+		})
+	}()
+	return out
+}
+
+// hasBadge reports whether the row has an element matching selector whose text,
+// alt or title contains one of the keywords (any match counts without keywords)
+func hasBadge(row *goquery.Selection, selector string, keywords []string) bool {
+	if selector == "" {
+		return false
+	}
+	found := false
+	row.Find(selector).EachWithBreak(func(_ int, badge *goquery.Selection) bool {
+		if len(keywords) == 0 {
+			found = true
+			return false
+		}
+		alt, _ := badge.Attr("alt")
+		title, _ := badge.Attr("title")
+		found = containsAny(badge.Text()+" "+alt+" "+title, keywords...)
+		return !found
 	})
+	return found
+}
 
-	return items, nil
+// findSearchRows selects the torrent rows using the primary selector,
+// falling back to the alternate layouts when the primary yields no rows
+func (d *NexusPHPDriver) findSearchRows(doc *goquery.Document) *goquery.Selection {
+	if cards := d.Selectors.Cards; cards != nil && cards.Item != "" {
+		if items := doc.Find(cards.Item); items.Length() > 0 {
+			return items
+		}
+	}
+	rows := doc.Find(d.Selectors.TableRows)
+	if rows.Length() > 0 {
+		return rows
+	}
+	for _, sel := range d.Selectors.AlternateTableRows {
+		if sel == "" {
+			continue
+		}
+		if alt := doc.Find(sel); alt.Length() > 0 {
+			return alt
+		}
+	}
+	return rows
 }
 
 // TorrentDetail contains detailed information from a torrent detail page
@@ -540,6 +1239,20 @@ type TorrentDetail struct {
 	Subtitle string `json:"subtitle"`
 	// InfoHash is the torrent info hash
 	InfoHash string `json:"infoHash,omitempty"`
+	// PosterURL is the absolute URL of the poster/cover image
+	PosterURL string `json:"posterUrl,omitempty"`
+	// CurrentSeeders is the live seeder count from the peers summary; nil when absent
+	CurrentSeeders *int `json:"currentSeeders,omitempty"`
+	// CurrentLeechers is the live leecher count from the peers summary; nil when absent
+	CurrentLeechers *int `json:"currentLeechers,omitempty"`
+	// Label is the link text for entries returned by ParseDetailTorrents
+	Label string `json:"label,omitempty"`
+	// HasHR reports an H&R icon or keyword badge on the page, for sites that
+	// flag H&R only on the detail page
+	HasHR bool `json:"hasHR,omitempty"`
+	// MinRatioRequired is the ratio a user needs before the site allows the
+	// download; 0 when the page states no requirement
+	MinRatioRequired float64 `json:"minRatioRequired,omitempty"`
 }
 
 // PrepareDetail prepares a request for torrent detail page
@@ -679,33 +1392,204 @@ func (d *NexusPHPDriver) ParseDetail(res NexusPHPResponse) (TorrentDetail, error
 	}
 
 	// Parse info hash
-	hashSelectors := []string{
-		"td:contains('Hash码') + td",
-		"td:contains('Hash码:') ~ td",
-		"td.no_border_wide:contains('Hash码')",
-	}
-	for _, sel := range hashSelectors {
-		elem := doc.Find(sel).First()
-		if elem.Length() > 0 {
-			text := strings.TrimSpace(elem.Text())
-			// Extract hash from text like "Hash码: 303a850dedc19e60bd7cc814f60e0e28d7f2c202"
-			if strings.Contains(text, "Hash码") {
-				parts := strings.Split(text, ":")
-				if len(parts) >= 2 {
-					text = strings.TrimSpace(parts[len(parts)-1])
-				}
+	detail.InfoHash = parseDetailInfoHash(doc)
+
+	// Parse poster image
+	if d.Selectors.DetailPoster != "" {
+		doc.Find(d.Selectors.DetailPoster).EachWithBreak(func(_ int, s *goquery.Selection) bool {
+			src := strings.TrimSpace(s.AttrOr("data-src", ""))
+			if src == "" {
+				src = strings.TrimSpace(s.AttrOr("src", ""))
 			}
-			// Validate it looks like a hash (40 hex chars for SHA1)
-			if len(text) == 40 && isHexString(text) {
-				detail.InfoHash = text
-				break
+			if src == "" || strings.HasPrefix(src, "data:") {
+				return true
 			}
+			detail.PosterURL = d.resolveURL(src)
+			return false
+		})
+	}
+
+	// Parse live peer counts
+	if d.Selectors.DetailPeers != "" {
+		if peers := doc.Find(d.Selectors.DetailPeers).First(); peers.Length() > 0 {
+			detail.CurrentSeeders, detail.CurrentLeechers = parsePeerCounts(peers.Text())
+		}
+	}
+
+	// Parse minimum ratio requirement
+	if d.Selectors.DetailMinRatio != "" {
+		if elem := doc.Find(d.Selectors.DetailMinRatio).First(); elem.Length() > 0 {
+			detail.MinRatioRequired = parseFloat(extractNumber(elem.Text()))
 		}
 	}
 
+	// Check for H&R. Keywords are only looked for in the title heading, since
+	// site menus commonly link to an "H&R" page from every page
+	detail.HasHR = (d.Selectors.HRIcon != "" && doc.Find(d.Selectors.HRIcon).Length() > 0) ||
+		d.hasHRKeyword(doc.Find("h1"))
+
 	return detail, nil
 }
 
+// hasHRKeyword reports whether sel's text or HTML contains one of the site's
+// HRKeywords (case-insensitive)
+func (d *NexusPHPDriver) hasHRKeyword(sel *goquery.Selection) bool {
+	if d.siteDefinition == nil || len(d.siteDefinition.HRKeywords) == 0 || sel.Length() == 0 {
+		return false
+	}
+	var html strings.Builder
+	sel.Each(func(_ int, s *goquery.Selection) {
+		h, _ := goquery.OuterHtml(s)
+		html.WriteString(h)
+	})
+	haystack := sel.Text() + " " + html.String()
+	for _, kw := range d.siteDefinition.HRKeywords {
+		if kw != "" && containsAny(haystack, kw) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseDetailTorrents returns every download link on a details page with its
+// label, in page order. Zip bundles and duplicate URLs are skipped.
+func (d *NexusPHPDriver) ParseDetailTorrents(res NexusPHPResponse) ([]TorrentDetail, error) {
+	if res.Document == nil {
+		return nil, ErrParseError
+	}
+	if d.Selectors.DetailTorrentLinks == "" {
+		return nil, nil
+	}
+
+	var torrents []TorrentDetail
+	seen := make(map[string]struct{})
+	res.Document.Find(d.Selectors.DetailTorrentLinks).Each(func(_ int, s *goquery.Selection) {
+		href := strings.TrimSpace(s.AttrOr("href", ""))
+		if href == "" || strings.Contains(href, "type=zip") {
+			return
+		}
+		if _, ok := seen[href]; ok {
+			return
+		}
+		seen[href] = struct{}{}
+
+		label := strings.Join(strings.Fields(s.Text()), " ")
+		if label == "" {
+			label = strings.TrimSpace(s.AttrOr("title", ""))
+		}
+		torrents = append(torrents, TorrentDetail{DownloadURL: href, Label: label})
+	})
+	return torrents, nil
+}
+
+// Peer summary patterns. "label: N" is tried first so that a count following
+// one label is not mistaken for a prefix of the next ("做种者: 5 下载者: 2").
+var (
+	seedersLabelFirstRegex  = regexp.MustCompile(`(?i)(?:做种者|做種者|做种人数|做種人數|seeders?)\s*[:：]\s*(\d+)`)
+	seedersCountFirstRegex  = regexp.MustCompile(`(?i)(\d+)\s*[个個]?\s*(?:做种者|做種者|seeders?)`)
+	leechersLabelFirstRegex = regexp.MustCompile(`(?i)(?:下载者|下載者|下载人数|下載人數|leechers?)\s*[:：]\s*(\d+)`)
+	leechersCountFirstRegex = regexp.MustCompile(`(?i)(\d+)\s*[个個]?\s*(?:下载者|下載者|leechers?)`)
+)
+
+// parsePeerCounts extracts seeder and leecher counts from a peers summary.
+// A count is nil when its label is not found.
+func parsePeerCounts(text string) (seeders, leechers *int) {
+	seeders = matchPeerCount(text, seedersLabelFirstRegex, seedersCountFirstRegex)
+	leechers = matchPeerCount(text, leechersLabelFirstRegex, leechersCountFirstRegex)
+	return seeders, leechers
+}
+
+func matchPeerCount(text string, patterns ...*regexp.Regexp) *int {
+	for _, re := range patterns {
+		m := re.FindStringSubmatch(text)
+		if m == nil {
+			continue
+		}
+		if n, err := strconv.Atoi(m[1]); err == nil {
+			return &n
+		}
+	}
+	return nil
+}
+
+// resolveURL resolves a possibly relative URL against the site base URL
+func (d *NexusPHPDriver) resolveURL(href string) string {
+	if strings.HasPrefix(href, "http://") || strings.HasPrefix(href, "https://") {
+		return href
+	}
+	base, err := url.Parse(d.BaseURL + "/")
+	if err != nil {
+		return href
+	}
+	ref, err := url.Parse(href)
+	if err != nil {
+		return href
+	}
+	return base.ResolveReference(ref).String()
+}
+
+// infoHashLabels are the labels detail pages put in front of the info hash
+var infoHashLabels = []string{"Hash码", "Info Hash", "Infohash", "InfoHash", "种子特征码"}
+
+// parseDetailInfoHash finds the info hash on a detail page, first in cells
+// labelled with one of infoHashLabels and then in code/kbd elements holding
+// nothing but the hash
+func parseDetailInfoHash(doc *goquery.Document) string {
+	var hash string
+	for _, label := range infoHashLabels {
+		// Value in the cell after the label
+		doc.Find("td:contains('" + label + "') + td").EachWithBreak(func(_ int, s *goquery.Selection) bool {
+			hash = normalizeInfoHash(firstField(s.Text()))
+			return hash == ""
+		})
+		if hash != "" {
+			return hash
+		}
+		// Label and value in the same cell, e.g. "Hash码: 303a85..."
+		doc.Find("td:contains('" + label + "')").EachWithBreak(func(_ int, s *goquery.Selection) bool {
+			text := s.Text()
+			idx := strings.LastIndex(text, label)
+			rest := strings.TrimLeft(text[idx+len(label):], " \t\r\n:：")
+			hash = normalizeInfoHash(firstField(rest))
+			return hash == ""
+		})
+		if hash != "" {
+			return hash
+		}
+	}
+	doc.Find("code, kbd").EachWithBreak(func(_ int, s *goquery.Selection) bool {
+		hash = normalizeInfoHash(strings.TrimSpace(s.Text()))
+		return hash == ""
+	})
+	return hash
+}
+
+// firstField returns the first whitespace-separated field of s
+func firstField(s string) string {
+	if fields := strings.Fields(s); len(fields) > 0 {
+		return fields[0]
+	}
+	return ""
+}
+
+// normalizeInfoHash validates a candidate info hash. 40-char hex SHA1 hashes
+// are returned unchanged; 32-char base32 hashes are converted to lowercase
+// hex. Anything else yields "".
+func normalizeInfoHash(s string) string {
+	switch len(s) {
+	case 40:
+		if isHexString(s) {
+			return s
+		}
+	case 32:
+		raw, err := base32.StdEncoding.DecodeString(strings.ToUpper(s))
+		if err == nil && len(raw) == 20 {
+			return hex.EncodeToString(raw)
+		}
+	}
+	return ""
+}
+
 // isHexString checks if a string contains only hexadecimal characters
 func isHexString(s string) bool {
 	for _, c := range s {
@@ -770,20 +1654,26 @@ func (d *NexusPHPDriver) ParseUserInfo(res NexusPHPResponse) (UserInfo, error) {
 	}
 
 	// Parse uploaded - look in info block
-	uploadedText := findTextByLabel(doc, "上传量", "上傳量", "Uploaded", "上传")
+	uploadedText := findSizeByLabel(doc, "上传量", "上傳量", "Uploaded", "上传")
 	if uploadedText == "" {
 		// Try to find in info_block format: 上传量: xxx
-		uploadedText = findInfoBlockValue(doc, "上传量", "上傳量", "Uploaded")
+		uploadedText = extractSizeText(findInfoBlockValue(doc, "上传量", "上傳量", "Uploaded"))
 	}
 	info.Uploaded = parseSize(uploadedText)
 
 	// Parse downloaded
-	downloadedText := findTextByLabel(doc, "下载量", "下載量", "Downloaded", "下载")
+	downloadedText := findSizeByLabel(doc, "下载量", "下載量", "Downloaded", "下载")
 	if downloadedText == "" {
-		downloadedText = findInfoBlockValue(doc, "下载量", "下載量", "Downloaded")
+		downloadedText = extractSizeText(findInfoBlockValue(doc, "下载量", "下載量", "Downloaded"))
 	}
 	info.Downloaded = parseSize(downloadedText)
 
+	// Compact skins show traffic as "↑1.5TB ↓500GB" without labels
+	if uploadedText == "" && downloadedText == "" {
+		up, down := extractArrowTraffic(doc.Find("#info_block, #userbar, .info_block").Text())
+		info.Uploaded, info.Downloaded = up, down
+	}
+
 	// Parse ratio
 	ratioText := findTextByLabel(doc, "分享率", "分享率", "Ratio")
 	if ratioText == "" {
@@ -805,9 +1695,74 @@ func (d *NexusPHPDriver) ParseUserInfo(res NexusPHPResponse) (UserInfo, error) {
 	}
 	info.Rank = strings.TrimSpace(rankText)
 
+	// Mobile skins drop #info_block for a compact summary the lookups above miss
+	if info.Uploaded == 0 && info.Downloaded == 0 && info.Ratio == 0 && info.Bonus == 0 &&
+		d.Selectors.UserInfoMobileBlock != "" {
+		if block := doc.Find(d.Selectors.UserInfoMobileBlock).First(); block.Length() > 0 {
+			parseMobileUserInfo(block, &info)
+		}
+	}
+
 	return info, nil
 }
 
+// parseMobileUserInfo fills info from a mobile skin's compact userinfo block,
+// where each stat is a label such as "上传" or "邀请" followed by its value in
+// the same text node or the next one.
+func parseMobileUserInfo(block *goquery.Selection, info *UserInfo) {
+	if info.Username == "" {
+		if link := block.Find("a[href*='userdetails.php']").First(); link.Length() > 0 {
+			info.Username = strings.TrimSpace(link.Text())
+			info.UserID = extractUserID(link.AttrOr("href", ""))
+		}
+	}
+
+	tokens := textTokens(block)
+	value := func(labels ...string) string {
+		for i, tok := range tokens {
+			for _, label := range labels {
+				if !strings.HasPrefix(tok, label) {
+					continue
+				}
+				rest := strings.TrimLeft(strings.TrimPrefix(tok, label), " :：")
+				if rest == "" && i+1 < len(tokens) {
+					rest = tokens[i+1]
+				}
+				if rest != "" {
+					return rest
+				}
+			}
+		}
+		return ""
+	}
+
+	info.Uploaded = parseSize(extractSizeText(value("上传量", "上傳量", "上传", "上傳", "Uploaded")))
+	info.Downloaded = parseSize(extractSizeText(value("下载量", "下載量", "下载", "下載", "Downloaded")))
+	info.Ratio = parseRatio(value("分享率", "Ratio"))
+	info.Bonus = parseFloat(extractNumber(value("魔力值", "魔力", "积分", "積分", "Bonus")))
+	info.Seeding, _ = strconv.Atoi(extractNumber(value("做种数", "做種數", "做种中", "做種中", "Seeding")))
+	info.Invites, _ = strconv.Atoi(extractNumber(value("邀请", "邀請", "Invites")))
+}
+
+// textTokens returns the trimmed, non-empty text nodes under sel in document order
+func textTokens(sel *goquery.Selection) []string {
+	var tokens []string
+	var walk func(*goquery.Selection)
+	walk = func(sel *goquery.Selection) {
+		sel.Each(func(_ int, n *goquery.Selection) {
+			if goquery.NodeName(n) == "#text" {
+				if t := strings.TrimSpace(n.Text()); t != "" {
+					tokens = append(tokens, t)
+				}
+				return
+			}
+			walk(n.Contents())
+		})
+	}
+	walk(sel.Contents())
+	return tokens
+}
+
 // ParseUserDetails extracts detailed user info from userdetails.php page
 func (d *NexusPHPDriver) ParseUserDetails(res NexusPHPResponse) (UserInfo, error) {
 	if res.Document == nil {
@@ -839,18 +1794,29 @@ func (d *NexusPHPDriver) ParseUserDetails(res NexusPHPResponse) (UserInfo, error
 			if info.Username == "" {
 				info.Username = value
 			}
+		// Time rows come before 下载量/Seeding so "下载时间" and "Seeding time"
+		// are not taken for size or count rows; time ratio rows are skipped
+		case containsAny(header, "比率", "ratio") && containsAny(header, "时间", "time"):
+		case containsAny(header, "做种时间", "做種時間", "Seeding time"):
+			info.SeedingTimeSeconds = parseDuration(value)
+		case containsAny(header, "下载时间", "下載時間", "Leeching time"):
+			info.LeechingTimeSeconds = parseDuration(value)
 		case containsAny(header, "传输", "传送", "Transfers", "流量"):
 			// Format: "上传量: 1.5 TB 下载量: 500 GB 分享率: 3.0"
 			info.Uploaded = extractSizeFromTransfer(value, "上传量", "上傳量", "Uploaded", "上传")
 			info.Downloaded = extractSizeFromTransfer(value, "下载量", "下載量", "Downloaded", "下载")
+			if info.Uploaded == 0 && info.Downloaded == 0 {
+				// Format: "↑1.5TB ↓500GB"
+				info.Uploaded, info.Downloaded = extractArrowTraffic(value)
+			}
 			ratioStr := extractValueFromTransfer(value, "分享率", "Ratio")
 			if ratioStr != "" {
 				info.Ratio = parseRatio(ratioStr)
 			}
 		case containsAny(header, "上传量", "Uploaded"):
-			info.Uploaded = parseSize(value)
+			info.Uploaded = parseSize(extractSizeText(value))
 		case containsAny(header, "下载量", "Downloaded"):
-			info.Downloaded = parseSize(value)
+			info.Downloaded = parseSize(extractSizeText(value))
 		case containsAny(header, "分享率", "Ratio"):
 			info.Ratio = parseRatio(value)
 		case containsAny(header, "魔力值", "魔力", "Bonus"):
@@ -860,8 +1826,10 @@ func (d *NexusPHPDriver) ParseUserDetails(res NexusPHPResponse) (UserInfo, error
 			info.Rank = value
 		case containsAny(header, "做种积分", "Seeding"):
 			info.Seeding, _ = strconv.Atoi(extractNumber(value))
-		case containsAny(header, "加入日期", "Join"):
-			// Parse join date if needed
+		case containsAny(header, "加入日期", "注册时间", "註冊時間", "Join"):
+			if t := parseTimeInLocation(joinDateRegex.FindString(value), d.siteLocation()); !t.IsZero() {
+				info.JoinDate = t.Unix()
+			}
 		case containsAny(header, "上次访问", "上次訪問", "Last access", "Last seen"):
 			if t, err := ParseTimeInCST("2006-01-02 15:04:05", value); err == nil {
 				info.LastAccess = t.Unix()
@@ -881,13 +1849,21 @@ func (d *NexusPHPDriver) ParseUserDetails(res NexusPHPResponse) (UserInfo, error
 // 1. Fetch /index.php to get user ID and basic info from info_block
 // 2. Fetch /userdetails.php?id=xxx to get detailed info
 func (d *NexusPHPDriver) GetUserInfo(ctx context.Context) (UserInfo, error) {
+	var info UserInfo
+	var err error
 	// If we have a site definition with UserInfo config, use the definition-based parsing
 	if d.siteDefinition != nil && d.siteDefinition.UserInfo != nil {
-		return d.getUserInfoWithDefinition(ctx)
+		info, err = d.getUserInfoWithDefinition(ctx)
+	} else {
+		// Fall back to legacy parsing
+		info, err = d.getUserInfoLegacy(ctx)
 	}
 
-	// Fall back to legacy parsing
-	return d.getUserInfoLegacy(ctx)
+	// Remember the rank so class-conditional free icons resolve in later searches
+	if err == nil && info.Rank != "" {
+		d.SetUserRank(info.Rank)
+	}
+	return info, err
 }
 
 // getUserInfoWithDefinition fetches user info using site definition selectors
@@ -966,22 +1942,31 @@ func (d *NexusPHPDriver) getUserInfoWithDefinition(ctx context.Context) (UserInf
 		time.Sleep(time.Duration(uiConfig.RequestDelay) * time.Millisecond)
 	}
 
-	// Phase 2: Execute dependent processes AND FetchSeedingStatus in parallel
+	// Phase 2: Execute dependent processes AND the seeding/leeching status fetches in parallel
 	phase2Start := time.Now()
 	needSeedingStatus := false
+	needLeechingStatus := false
 	if uiConfig.Selectors != nil {
 		_, hasSeedingSizeSelector := uiConfig.Selectors["seedingSize"]
 		needSeedingStatus = !hasSeedingSizeSelector
+		_, hasLeecherSizeSelector := uiConfig.Selectors["leecherSize"]
+		needLeechingStatus = !hasLeecherSizeSelector
 	} else {
 		needSeedingStatus = true
+		needLeechingStatus = true
 	}
+	needSeedingStatus = needSeedingStatus && info.UserID != ""
+	needLeechingStatus = needLeechingStatus && info.UserID != ""
 
-	if len(dependentProcesses) > 0 || (needSeedingStatus && info.UserID != "") {
+	if len(dependentProcesses) > 0 || needSeedingStatus || needLeechingStatus {
 		if DebugUserInfo {
 			fmt.Printf("[DEBUG] Phase 2: Executing %d dependent processes", len(dependentProcesses))
-			if needSeedingStatus && info.UserID != "" {
+			if needSeedingStatus {
 				fmt.Printf(" + seeding status fetch")
 			}
+			if needLeechingStatus {
+				fmt.Printf(" + leeching status fetch")
+			}
 			fmt.Printf(" in parallel\n")
 		}
 
@@ -1006,17 +1991,11 @@ func (d *NexusPHPDriver) getUserInfoWithDefinition(ctx context.Context) (UserInf
 		}
 
 		// Launch seeding status fetch if needed (non-blocking error)
-		if needSeedingStatus && info.UserID != "" {
+		if needSeedingStatus {
 			userID := info.UserID // capture
 			g.Go(func() error {
-				seeding, seedingSize, err := d.FetchSeedingStatus(gctx, userID)
-				if err != nil {
-					if DebugUserInfo {
-						fmt.Printf("[DEBUG] FetchSeedingStatus error: %v\n", err)
-					}
-					// Don't fail the whole operation for seeding status
-					return nil
-				}
+				// Errors are swallowed: seeding status must not fail the whole operation
+				seeding, seedingSize := d.seedingStatusWithFallback(gctx, userID, nil)
 				if seedingSize > 0 {
 					mu.Lock()
 					info.SeederSize = seedingSize
@@ -1033,6 +2012,36 @@ func (d *NexusPHPDriver) getUserInfoWithDefinition(ctx context.Context) (UserInf
 			})
 		}
 
+		// Launch leeching status fetch if needed (non-blocking error)
+		if needLeechingStatus {
+			userID := info.UserID // capture
+			g.Go(func() error {
+				// Errors are swallowed like the seeding fetch; an empty list leaves zeros
+				leeching, leechingSize, err := d.FetchLeechingStatus(gctx, userID)
+				if err != nil {
+					if DebugUserInfo {
+						fmt.Printf("[DEBUG] FetchLeechingStatus error: %v\n", err)
+					}
+					return nil
+				}
+				if leeching > 0 || leechingSize > 0 {
+					mu.Lock()
+					if leechingSize > 0 {
+						info.LeecherSize = leechingSize
+					}
+					if leeching > 0 && info.Leeching == 0 {
+						info.Leeching = leeching
+						info.LeecherCount = leeching
+					}
+					mu.Unlock()
+					if DebugUserInfo {
+						fmt.Printf("[DEBUG] Updated leeching status: count=%d, size=%d\n", leeching, leechingSize)
+					}
+				}
+				return nil
+			})
+		}
+
 		if err := g.Wait(); err != nil {
 			return UserInfo{}, fmt.Errorf("phase 2 parallel execution failed: %w", err)
 		}
@@ -1059,6 +2068,7 @@ func (d *NexusPHPDriver) executeProcess(ctx context.Context, uiConfig *UserInfoC
 
 	// Build request URL
 	reqURL := process.RequestConfig.URL
+	replacements := make(map[string]string)
 
 	// Replace params in URL (e.g., params.id)
 	if process.Assertion != nil {
@@ -1066,6 +2076,7 @@ func (d *NexusPHPDriver) executeProcess(ctx context.Context, uiConfig *UserInfoC
 			if strings.HasPrefix(valueRef, "params.") {
 				paramKey := strings.TrimPrefix(valueRef, "params.")
 				if val, ok := parsedValues[paramKey]; ok {
+					replacements[key] = toString(val)
 					reqURL = strings.ReplaceAll(reqURL, "{"+key+"}", toString(val))
 					// Also add as query param if needed
 					if !strings.Contains(reqURL, "?") {
@@ -1083,6 +2094,14 @@ func (d *NexusPHPDriver) executeProcess(ctx context.Context, uiConfig *UserInfoC
 		Path:   reqURL,
 		Method: "GET",
 	}
+	if strings.EqualFold(process.RequestConfig.Method, http.MethodPost) {
+		req.Method = http.MethodPost
+		body, err := processRequestBody(process.RequestConfig, replacements)
+		if err != nil {
+			return result, err
+		}
+		req.Body = body
+	}
 
 	res, err := d.Execute(ctx, req)
 	if err != nil {
@@ -1117,6 +2136,35 @@ func (d *NexusPHPDriver) executeProcess(ctx context.Context, uiConfig *UserInfoC
 	return result, nil
 }
 
+// processRequestBody builds the JSON body of a POST userinfo process from its
+// Body template, or from Data when no template is given. "{key}" in the body
+// is replaced with the value of the process's assertion param "key".
+func processRequestBody(cfg RequestConfig, replacements map[string]string) ([]byte, error) {
+	if cfg.Body != "" {
+		body := cfg.Body
+		for key, val := range replacements {
+			body = strings.ReplaceAll(body, "{"+key+"}", val)
+		}
+		return []byte(body), nil
+	}
+
+	data := make(map[string]any, len(cfg.Data))
+	for k, v := range cfg.Data {
+		if s, ok := v.(string); ok {
+			for key, val := range replacements {
+				s = strings.ReplaceAll(s, "{"+key+"}", val)
+			}
+			v = s
+		}
+		data[k] = v
+	}
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("encode userinfo request body: %w", err)
+	}
+	return body, nil
+}
+
 // extractFieldValue extracts a field value from the document using the selector config
 func (d *NexusPHPDriver) extractFieldValue(doc *goquery.Document, selector FieldSelector) string {
 	var value string
@@ -1205,7 +2253,9 @@ func (d *NexusPHPDriver) setUserInfoField(info *UserInfo, fieldName, value strin
 	case "downloaded":
 		info.Downloaded = parseSize(value)
 	case "ratio":
-		info.Ratio = parseRatio(value)
+		if ratio, ok := parseRatioOK(value); ok {
+			info.Ratio = ratio
+		}
 	case "bonus":
 		info.Bonus = parseFloat(value)
 	case "levelName", "rank", "class":
@@ -1217,6 +2267,8 @@ func (d *NexusPHPDriver) setUserInfoField(info *UserInfo, fieldName, value strin
 		info.BonusPerHour = parseFloat(value)
 	case "seedingBonusPerHour":
 		info.SeedingBonusPerHour = parseFloat(value)
+	case "bonusExchangeRate":
+		info.BonusExchangeRate = parseFloat(value)
 	case "joinTime", "joinDate":
 		// Value should already be Unix timestamp after parseTime filter
 		if ts, err := strconv.ParseInt(value, 10, 64); err == nil {
@@ -1260,6 +2312,10 @@ func (d *NexusPHPDriver) setUserInfoField(info *UserInfo, fieldName, value strin
 		info.TrueUploaded = parseSize(value)
 	case "trueDownloaded":
 		info.TrueDownloaded = parseSize(value)
+	case "uploadedToday":
+		info.UploadedToday = parseSize(value)
+	case "downloadedToday":
+		info.DownloadedToday = parseSize(value)
 	case "seederSize":
 		info.SeederSize = parseSize(value)
 	case "leecherSize":
@@ -1332,7 +2388,8 @@ func (d *NexusPHPDriver) getUserInfoLegacy(ctx context.Context) (UserInfo, error
 		if detailInfo.Downloaded > 0 {
 			info.Downloaded = detailInfo.Downloaded
 		}
-		if detailInfo.Ratio > 0 {
+		// A detail page ratio of -1 (infinite) is a real value, not a miss
+		if detailInfo.Ratio != 0 {
 			info.Ratio = detailInfo.Ratio
 		}
 		if detailInfo.Bonus > 0 {
@@ -1344,6 +2401,14 @@ func (d *NexusPHPDriver) getUserInfoLegacy(ctx context.Context) (UserInfo, error
 		if detailInfo.Seeding > 0 {
 			info.Seeding = detailInfo.Seeding
 		}
+
+		if seeding, seedingSize := d.seedingStatusWithFallback(ctx, info.UserID, &detailRes); seedingSize > 0 {
+			info.SeederSize = seedingSize
+			if seeding > 0 && info.Seeding == 0 {
+				info.Seeding = seeding
+				info.SeederCount = seeding
+			}
+		}
 	} else {
 	}
 
@@ -1351,8 +2416,15 @@ func (d *NexusPHPDriver) getUserInfoLegacy(ctx context.Context) (UserInfo, error
 }
 
 // PrepareDownload prepares a request for downloading a torrent
-// For NexusPHP sites, we first need to visit the detail page to get the download URL with passkey
+// With a passkey the torrent is fetched from download.php directly; otherwise
+// we first need to visit the detail page to get the download URL with passkey.
+// A download confirm path also forces the detail page route, since the confirm
+// step runs in ParseDownload.
 func (d *NexusPHPDriver) PrepareDownload(torrentID string) (NexusPHPRequest, error) {
+	if d.Passkey != "" && d.downloadConfirmPath == "" {
+		return d.PrepareDownloadDirect(torrentID)
+	}
+
 	params := url.Values{}
 	params.Set("id", torrentID)
 	params.Set("hit", "1")
@@ -1365,6 +2437,58 @@ func (d *NexusPHPDriver) PrepareDownload(torrentID string) (NexusPHPRequest, err
 	}, nil
 }
 
+// PrepareDownloadDirect prepares a download.php?id=...&passkey=... request,
+// skipping the detail page, or the equivalent form POST when the site
+// downloads by POST. It fails when no passkey is configured.
+func (d *NexusPHPDriver) PrepareDownloadDirect(torrentID string) (NexusPHPRequest, error) {
+	passkey := strings.TrimSpace(d.Passkey)
+	if passkey == "" {
+		return NexusPHPRequest{}, ErrNoPasskey
+	}
+	torrentID = strings.TrimSpace(torrentID)
+	if torrentID == "" {
+		return NexusPHPRequest{}, fmt.Errorf("torrent ID is required")
+	}
+
+	params := url.Values{}
+	for key, values := range d.downloadParams {
+		params[key] = append([]string(nil), values...)
+	}
+	params.Set("id", torrentID)
+	params.Set("passkey", passkey)
+
+	method := http.MethodGet
+	if d.downloadForm != nil {
+		method = http.MethodPost
+		for key, values := range d.downloadForm {
+			params[key] = append([]string(nil), values...)
+		}
+	}
+
+	return NexusPHPRequest{
+		Path:   "/download.php",
+		Params: params,
+		Method: method,
+		Raw:    true,
+	}, nil
+}
+
+// checkRawDownload rejects an HTML page served in place of a torrent file
+func (d *NexusPHPDriver) checkRawDownload(body []byte) error {
+	if len(body) == 0 {
+		return fmt.Errorf("empty torrent file response")
+	}
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || trimmed[0] != '<' {
+		return nil
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(trimmed))
+	if err == nil && d.detectLoginPage(doc) {
+		return ErrSessionExpired
+	}
+	return fmt.Errorf("%w: download returned an HTML page", ErrParseError)
+}
+
 // ParseDownload extracts torrent file data from the response
 // For NexusPHP, the response is a detail page - we need to extract the download URL and fetch the torrent
 func (d *NexusPHPDriver) ParseDownload(res NexusPHPResponse) ([]byte, error) {
@@ -1382,6 +2506,12 @@ func (d *NexusPHPDriver) ParseDownload(res NexusPHPResponse) ([]byte, error) {
 		return nil, fmt.Errorf("parse detail page: %w", err)
 	}
 
+	if detail.DownloadURL == "" && d.thanksPath != "" {
+		if detail, err = d.detailAfterThanks(res.Document); err != nil {
+			return nil, err
+		}
+	}
+
 	if detail.DownloadURL == "" {
 		return nil, fmt.Errorf("no download URL found in detail page")
 	}
@@ -1396,6 +2526,10 @@ func (d *NexusPHPDriver) ParseDownload(res NexusPHPResponse) ([]byte, error) {
 			downloadURL = d.BaseURL + "/" + downloadURL
 		}
 	}
+	downloadURL, err = d.applyDownloadParams(downloadURL)
+	if err != nil {
+		return nil, err
+	}
 
 	// Fetch the actual torrent file
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -1409,7 +2543,18 @@ func (d *NexusPHPDriver) ParseDownload(res NexusPHPResponse) ([]byte, error) {
 		"Referer":         d.BaseURL + "/",
 	}
 
-	resp, err := d.httpClient.Get(ctx, downloadURL, headers)
+	if d.downloadConfirmPath != "" {
+		cookie, confirmErr := d.confirmDownload(ctx, extractTorrentID(downloadURL), headers)
+		if confirmErr != nil {
+			return nil, confirmErr
+		}
+		headers["Cookie"] = cookie
+	}
+
+	if err := d.waitRateLimit(ctx); err != nil {
+		return nil, err
+	}
+	resp, err := d.fetchTorrent(ctx, downloadURL, headers)
 	if err != nil {
 		return nil, fmt.Errorf("fetch torrent file: %w", err)
 	}
@@ -1425,6 +2570,210 @@ func (d *NexusPHPDriver) ParseDownload(res NexusPHPResponse) ([]byte, error) {
 	return resp.Body, nil
 }
 
+// fetchTorrent requests the torrent file, posting the download URL's query
+// (with the passkey and extra form fields) as a form when the site downloads
+// by POST
+func (d *NexusPHPDriver) fetchTorrent(ctx context.Context, downloadURL string, headers map[string]string) (*HTTPResponse, error) {
+	if d.downloadForm == nil {
+		return d.httpClient.Get(ctx, downloadURL, headers)
+	}
+
+	u, err := url.Parse(downloadURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse download URL: %w", err)
+	}
+	form := u.Query()
+	if form.Get("passkey") == "" && d.Passkey != "" {
+		form.Set("passkey", d.Passkey)
+	}
+	for key, values := range d.downloadForm {
+		form[key] = append([]string(nil), values...)
+	}
+	u.RawQuery = ""
+
+	postHeaders := make(map[string]string, len(headers)+1)
+	for key, value := range headers {
+		postHeaders[key] = value
+	}
+	postHeaders["Content-Type"] = "application/x-www-form-urlencoded"
+	return d.httpClient.Post(ctx, u.String(), []byte(form.Encode()), postHeaders)
+}
+
+// confirmDownload visits the configured confirm endpoint and returns the
+// Cookie header with any cookies it set merged in
+func (d *NexusPHPDriver) confirmDownload(ctx context.Context, torrentID string, headers map[string]string) (string, error) {
+	confirmURL := strings.ReplaceAll(d.downloadConfirmPath, "{id}", url.QueryEscape(torrentID))
+	if !strings.HasPrefix(confirmURL, "http") {
+		confirmURL = d.BaseURL + "/" + strings.TrimPrefix(confirmURL, "/")
+	}
+
+	confirmHeaders := make(map[string]string, len(headers))
+	for key, value := range headers {
+		confirmHeaders[key] = value
+	}
+	confirmHeaders["Accept"] = "text/html,application/xhtml+xml,*/*"
+
+	if err := d.waitRateLimit(ctx); err != nil {
+		return "", err
+	}
+	resp, err := d.httpClient.Get(ctx, confirmURL, confirmHeaders)
+	if err != nil {
+		return "", fmt.Errorf("visit download confirm page: %w", err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return "", fmt.Errorf("HTTP %d visiting download confirm page %s", resp.StatusCode, confirmURL)
+	}
+
+	cookies := (&http.Response{Header: resp.Headers}).Cookies()
+	return mergeCookies(headers["Cookie"], cookies), nil
+}
+
+// sayThanksIDRegex extracts the torrent ID from the thanks button, e.g. onclick="saythanks(123)"
+var sayThanksIDRegex = regexp.MustCompile(`(?i)saythanks\(\s*['"]?(\d+)`)
+
+// detailTorrentID finds the torrent ID on a detail page from its thanks
+// button or hidden ID inputs
+func detailTorrentID(doc *goquery.Document) string {
+	if html, err := doc.Html(); err == nil {
+		if m := sayThanksIDRegex.FindStringSubmatch(html); m != nil {
+			return m[1]
+		}
+	}
+	for _, sel := range []string{"input[name='detail_torrent_id']", "form[action*='thanks'] input[name='id']"} {
+		if id := strings.TrimSpace(doc.Find(sel).First().AttrOr("value", "")); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// detailAfterThanks posts the torrent ID to the thanks endpoint and parses
+// the detail page again, for sites that reveal the download link only after
+// the user says thanks
+func (d *NexusPHPDriver) detailAfterThanks(doc *goquery.Document) (TorrentDetail, error) {
+	torrentID := detailTorrentID(doc)
+	if torrentID == "" {
+		return TorrentDetail{}, fmt.Errorf("no download URL found in detail page and no torrent ID to thank")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	thanksURL := d.thanksPath
+	if !strings.HasPrefix(thanksURL, "http") {
+		thanksURL = d.BaseURL + "/" + strings.TrimPrefix(thanksURL, "/")
+	}
+	form := url.Values{}
+	form.Set("id", torrentID)
+	headers := map[string]string{
+		"Cookie":           d.Cookie,
+		"User-Agent":       d.userAgent,
+		"Content-Type":     "application/x-www-form-urlencoded",
+		"X-Requested-With": "XMLHttpRequest",
+		"Referer":          d.BaseURL + "/details.php?id=" + url.QueryEscape(torrentID),
+	}
+
+	if err := d.waitRateLimit(ctx); err != nil {
+		return TorrentDetail{}, err
+	}
+	resp, err := d.httpClient.Post(ctx, thanksURL, []byte(form.Encode()), headers)
+	if err != nil {
+		return TorrentDetail{}, fmt.Errorf("say thanks: %w", err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return TorrentDetail{}, fmt.Errorf("HTTP %d saying thanks at %s", resp.StatusCode, thanksURL)
+	}
+
+	req, err := d.PrepareDetail(torrentID)
+	if err != nil {
+		return TorrentDetail{}, err
+	}
+	res, err := d.Execute(ctx, req)
+	if err != nil {
+		return TorrentDetail{}, fmt.Errorf("fetch detail page after thanks: %w", err)
+	}
+	detail, err := d.ParseDetail(res)
+	if err != nil {
+		return TorrentDetail{}, fmt.Errorf("parse detail page after thanks: %w", err)
+	}
+	return detail, nil
+}
+
+// mergeCookies adds cookies to a Cookie header, replacing same-name entries
+func mergeCookies(header string, cookies []*http.Cookie) string {
+	if len(cookies) == 0 {
+		return header
+	}
+	set := make(map[string]string, len(cookies))
+	for _, c := range cookies {
+		set[c.Name] = c.Value
+	}
+
+	parts := make([]string, 0, len(cookies))
+	for _, part := range strings.Split(header, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, _, _ := strings.Cut(part, "=")
+		if _, replaced := set[strings.TrimSpace(name)]; replaced {
+			continue
+		}
+		parts = append(parts, part)
+	}
+	for _, c := range cookies {
+		if _, pending := set[c.Name]; pending {
+			parts = append(parts, c.Name+"="+set[c.Name])
+			delete(set, c.Name)
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// applyDownloadParams sets the configured download URL params, replacing any
+// existing values for the same keys
+func (d *NexusPHPDriver) applyDownloadParams(downloadURL string) (string, error) {
+	if len(d.downloadParams) == 0 {
+		return downloadURL, nil
+	}
+	u, err := url.Parse(downloadURL)
+	if err != nil {
+		return "", fmt.Errorf("parse download URL: %w", err)
+	}
+	query := u.Query()
+	for key, values := range d.downloadParams {
+		query[key] = values
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// DownloadAndHash fetches the torrent file and computes its SHA1 info-hash in one call,
+// so callers can check whether the torrent already exists before adding it
+func (d *NexusPHPDriver) DownloadAndHash(ctx context.Context, torrentID string) ([]byte, string, error) {
+	req, err := d.PrepareDownload(torrentID)
+	if err != nil {
+		return nil, "", fmt.Errorf("prepare download request: %w", err)
+	}
+
+	res, err := d.Execute(ctx, req)
+	if err != nil {
+		return nil, "", fmt.Errorf("execute download request: %w", err)
+	}
+
+	data, err := d.ParseDownload(res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	hash, err := ComputeTorrentHash(data)
+	if err != nil {
+		return nil, "", fmt.Errorf("compute torrent hash: %w", err)
+	}
+
+	return data, hash, nil
+}
+
 // Helper functions
 
 // extractTorrentID extracts the torrent ID from a URL
@@ -1438,16 +2787,72 @@ func extractTorrentID(href string) string {
 	return ""
 }
 
+// parseUploaderClass returns the user class styling an uploader element.
+// NexusPHP renders it as a "<Class>_Name" CSS class (e.g. "Uploader_Name",
+// "Staff_Leader_Name"); skins without that fall back to the title attribute.
+func parseUploaderClass(s *goquery.Selection) string {
+	if s.Length() == 0 {
+		return ""
+	}
+	var class string
+	s.AddSelection(s.Find("[class]")).EachWithBreak(func(_ int, elem *goquery.Selection) bool {
+		for _, c := range strings.Fields(elem.AttrOr("class", "")) {
+			if name, ok := strings.CutSuffix(c, "_Name"); ok && name != "" {
+				class = name
+				return false
+			}
+		}
+		return true
+	})
+	if class != "" {
+		return class
+	}
+	return strings.TrimSpace(s.AttrOr("title", ""))
+}
+
+// extractGroupID extracts the group ID from a group/collection link, either
+// from an id-style query parameter ("groupid=12", "id=12") or the trailing
+// numeric path segment ("/collection/12")
+func extractGroupID(href string) string {
+	if id := extractTorrentID(href); id != "" {
+		return id
+	}
+	re := regexp.MustCompile(`/(\d+)/?(?:[?#].*)?$`)
+	if matches := re.FindStringSubmatch(href); len(matches) > 1 {
+		return matches[1]
+	}
+	return ""
+}
+
+// sizeUnitPattern extracts the number, an optional Chinese magnitude (万/亿/万亿) and
+// the unit. Chinese unit characters are tried first since the Latin unit may
+// match empty; a trailing "字节" (e.g. "吉字节") is ignored
+var sizeUnitPattern = regexp.MustCompile(`([\d.]+)\s*(万亿|亿|万)?([千兆吉太拍]|[KMGTP]?I?B?)`)
+
+// sizeColumnPattern finds a table cell containing a size, used to detect the
+// size column of seeding lists
+var sizeColumnPattern = regexp.MustCompile(`(?i)[\d.]+\s*[KMGTP]?i?B`)
+
+// sizeCellPattern matches a table cell holding nothing but a size
+var sizeCellPattern = regexp.MustCompile(`(?i)^[\d.,]+\s*[KMGTP]?i?B$`)
+
 // parseSize parses a size string like "1.5 GB" to bytes
 func parseSize(sizeStr string) int64 {
+	return parseSizeWithBase(sizeStr, 1024)
+}
+
+// parseSizeWithBase parses a human readable size using base (1000 or 1024) for
+// decimal units like "GB". IEC units such as "GiB" are always binary.
+func parseSizeWithBase(sizeStr string, base int) int64 {
+	if base != 1000 {
+		base = 1024
+	}
 	sizeStr = strings.TrimSpace(sizeStr)
 	sizeStr = strings.ReplaceAll(sizeStr, ",", "")
 	sizeStr = strings.ReplaceAll(sizeStr, " ", "")
 
-	// Extract number and unit
-	re := regexp.MustCompile(`([\d.]+)\s*([KMGTP]?i?B?)`)
-	matches := re.FindStringSubmatch(strings.ToUpper(sizeStr))
-	if len(matches) < 2 {
+	matches := sizeUnitPattern.FindStringSubmatch(strings.ToUpper(sizeStr))
+	if len(matches) < 4 {
 		return 0
 	}
 
@@ -1456,76 +2861,289 @@ func parseSize(sizeStr string) int64 {
 		return 0
 	}
 
-	unit := ""
-	if len(matches) > 2 {
-		unit = matches[2]
+	// Chinese magnitudes scale the count itself, e.g. "1.5 万亿字节"
+	switch matches[2] {
+	case "万亿":
+		value *= 1e12
+	case "亿":
+		value *= 1e8
+	case "万":
+		value *= 1e4
+	}
+
+	unit := matches[3]
+
+	// Map Chinese unit characters used by some localized skins, e.g. "1.5吉" or "500兆"
+	switch unit {
+	case "千":
+		unit = "K"
+	case "兆":
+		unit = "M"
+	case "吉":
+		unit = "G"
+	case "太":
+		unit = "T"
+	case "拍":
+		unit = "P"
+	}
+
+	step := float64(base)
+	if strings.Contains(unit, "I") {
+		step = 1024
 	}
 
-	multiplier := float64(1)
+	exp := 0
 	switch {
 	case strings.HasPrefix(unit, "K"):
-		multiplier = 1024
+		exp = 1
 	case strings.HasPrefix(unit, "M"):
-		multiplier = 1024 * 1024
+		exp = 2
 	case strings.HasPrefix(unit, "G"):
-		multiplier = 1024 * 1024 * 1024
+		exp = 3
 	case strings.HasPrefix(unit, "T"):
-		multiplier = 1024 * 1024 * 1024 * 1024
+		exp = 4
 	case strings.HasPrefix(unit, "P"):
-		multiplier = 1024 * 1024 * 1024 * 1024 * 1024
+		exp = 5
 	}
 
-	return int64(value * multiplier)
+	return int64(value * math.Pow(step, float64(exp)))
 }
 
-// parseDiscountFromElement parses discount level from an HTML element
-func parseDiscountFromElement(elem *goquery.Selection, customMapping map[string]DiscountLevel) DiscountLevel {
-	class, _ := elem.Attr("class")
-	class = strings.ToLower(class)
+// sizeBytesAttrs returns the attributes to check for a raw byte count
+func sizeBytesAttrs(attr string) []string {
+	if attr != "" {
+		return []string{attr}
+	}
+	return []string{"data-bytes", "data-size"}
+}
 
-	src, _ := elem.Attr("src")
-	src = strings.ToLower(src)
+// parseSizeAttr reads a raw byte count from attr on elem. ok is false when the
+// attribute is missing, empty or not a non-negative integer.
+func parseSizeAttr(elem *goquery.Selection, attr string) (int64, bool) {
+	if attr == "" || elem.Length() == 0 {
+		return 0, false
+	}
+	raw, exists := elem.Attr(attr)
+	raw = strings.TrimSpace(raw)
+	if !exists || raw == "" {
+		return 0, false
+	}
+	size, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || size < 0 {
+		return 0, false
+	}
+	return size, true
+}
 
+// discountAttrs returns the lower-cased class, src and alt of a discount icon
+func discountAttrs(elem *goquery.Selection) string {
+	class, _ := elem.Attr("class")
+	src, _ := elem.Attr("src")
 	alt, _ := elem.Attr("alt")
-	alt = strings.ToLower(alt)
+	return strings.ToLower(class + " " + src + " " + alt)
+}
 
-	combined := class + " " + src + " " + alt
-	for keyword, level := range customMapping {
-		if strings.Contains(combined, strings.ToLower(keyword)) {
-			return level
+// normalizeCategory maps a category icon to a canonical category, matching
+// mapping keys against its class, src and alt like discount icons. An exact
+// alt match wins; otherwise the longest matching key does, so results don't
+// depend on map iteration order.
+func normalizeCategory(elem *goquery.Selection, mapping map[string]string) (string, bool) {
+	if len(mapping) == 0 {
+		return "", false
+	}
+	alt := strings.TrimSpace(elem.AttrOr("alt", ""))
+	combined := discountAttrs(elem)
+	best := ""
+	for keyword := range mapping {
+		switch {
+		case keyword == "":
+			continue
+		case strings.EqualFold(keyword, alt):
+			return mapping[keyword], true
+		case strings.Contains(combined, strings.ToLower(keyword)):
+			if len(keyword) > len(best) || (len(keyword) == len(best) && keyword < best) {
+				best = keyword
+			}
 		}
 	}
-
-	switch {
-	case strings.Contains(combined, "2xfree") || strings.Contains(combined, "free2up"):
-		return Discount2xFree
-	case strings.Contains(combined, "free"):
-		return DiscountFree
-	case strings.Contains(combined, "50pct") || strings.Contains(combined, "50%"):
-		return DiscountPercent50
-	case strings.Contains(combined, "30pct") || strings.Contains(combined, "30%"):
-		return DiscountPercent30
-	case strings.Contains(combined, "70pct") || strings.Contains(combined, "70%"):
-		return DiscountPercent70
-	case strings.Contains(combined, "2xup") || strings.Contains(combined, "2up"):
-		return Discount2xUp
-	default:
-		return DiscountNone
+	if best == "" {
+		return "", false
 	}
+	return mapping[best], true
 }
 
-var discountEndTimeInOnmouseoverRegex = regexp.MustCompile(`title=(?:&quot;|")(\d{4}-\d{2}-\d{2}\s+\d{2}:\d{2}:\d{2})(?:&quot;|")`)
-
-func parseDiscountEndTimeFromOnmouseover(onmouseover string) time.Time {
-	matches := discountEndTimeInOnmouseoverRegex.FindStringSubmatch(onmouseover)
-	if len(matches) >= 2 {
-		return parseTime(matches[1])
+// parseDiscountWithClassFree parses discount icons like parseDiscountFromElement,
+// except that an icon matching a ClassFreeMapping keyword counts as free only
+// when rank is one of its classes and is skipped otherwise, so other icons in
+// the row (e.g. 2up) still apply.
+func parseDiscountWithClassFree(elem *goquery.Selection, classMapping map[string][]string, rank string, customMapping map[string]DiscountLevel) DiscountLevel {
+	if len(classMapping) == 0 {
+		return parseDiscountFromElement(elem, customMapping)
+	}
+	levels := make([]DiscountLevel, 0, elem.Length())
+	elem.Each(func(_ int, icon *goquery.Selection) {
+		if eligible, ok := parseClassFreeFromElement(icon, classMapping, rank); ok {
+			if eligible {
+				levels = append(levels, DiscountFree)
+			}
+			return
+		}
+		levels = append(levels, parseDiscountFromElement(icon, customMapping))
+	})
+	return CombineDiscountLevels(levels...)
+}
+
+// parseClassFreeFromElement resolves a class-conditional free icon. ok is false
+// when the icon matches no keyword in mapping; otherwise eligible reports
+// whether rank is one of the classes listed for the matched keyword. The
+// longest keyword wins so "pro_vipfree" takes precedence over "pro_".
+func parseClassFreeFromElement(elem *goquery.Selection, mapping map[string][]string, rank string) (eligible, ok bool) {
+	if len(mapping) == 0 {
+		return false, false
+	}
+	keywords := slices.SortedFunc(maps.Keys(mapping), func(a, b string) int {
+		if len(a) != len(b) {
+			return len(b) - len(a)
+		}
+		return strings.Compare(a, b)
+	})
+	combined := discountAttrs(elem)
+	for _, keyword := range keywords {
+		if keyword == "" || !strings.Contains(combined, strings.ToLower(keyword)) {
+			continue
+		}
+		for _, r := range mapping[keyword] {
+			if rank != "" && strings.EqualFold(strings.TrimSpace(r), rank) {
+				return true, true
+			}
+		}
+		return false, true
 	}
-	return time.Time{}
+	return false, false
+}
+
+// parseDiscountFromElement parses discount level from an HTML element. When
+// elem holds several icons (e.g. free and 2up) their levels are combined.
+func parseDiscountFromElement(elem *goquery.Selection, customMapping map[string]DiscountLevel) DiscountLevel {
+	if elem.Length() > 1 {
+		levels := make([]DiscountLevel, 0, elem.Length())
+		elem.Each(func(_ int, icon *goquery.Selection) {
+			levels = append(levels, parseDiscountFromElement(icon, customMapping))
+		})
+		return CombineDiscountLevels(levels...)
+	}
+
+	// 多个自定义关键字同时命中时取价值最高的组合，不依赖 map 的遍历顺序
+	combined := discountAttrs(elem)
+	var levels []DiscountLevel
+	for keyword, level := range customMapping {
+		if strings.Contains(combined, strings.ToLower(keyword)) {
+			levels = append(levels, level)
+		}
+	}
+	if len(levels) > 0 {
+		return CombineDiscountLevels(levels...)
+	}
+
+	if isNeutralDiscount(elem, combined) {
+		return DiscountNeutral
+	}
+
+	switch {
+	case strings.Contains(combined, "2xfree") || strings.Contains(combined, "free2up"):
+		return Discount2xFree
+	case strings.Contains(combined, "free"):
+		return DiscountFree
+	case strings.Contains(combined, "50pct") || strings.Contains(combined, "50%"):
+		return DiscountPercent50
+	case strings.Contains(combined, "30pct") || strings.Contains(combined, "30%"):
+		return DiscountPercent30
+	case strings.Contains(combined, "70pct") || strings.Contains(combined, "70%"):
+		return DiscountPercent70
+	case strings.Contains(combined, "75pct") || strings.Contains(combined, "75%"):
+		return DiscountPercent75
+	case strings.Contains(combined, "25pct") || strings.Contains(combined, "25%"):
+		return DiscountPercent25
+	case strings.Contains(combined, "2xup") || strings.Contains(combined, "2up"):
+		return Discount2xUp
+	default:
+		return DiscountNone
+	}
+}
+
+// neutralNoUploadMarkers indicate a promotion that doesn't count upload
+var neutralNoUploadMarkers = []string{"上传不计", "不计上传", "上傳不計", "不計上傳", "noupload", "no upload", "0xup"}
+
+// isNeutralDiscount reports a neutral (中性) promotion icon. "neutral" and
+// "中性" always qualify; the generic pro_custom class and "免" only qualify
+// when the icon also says upload is not counted, since both are used for
+// other promotions too.
+func isNeutralDiscount(elem *goquery.Selection, combined string) bool {
+	if containsAny(combined, "neutral", "中性") {
+		return true
+	}
+	hint := combined + " " + strings.ToLower(elem.AttrOr("title", ""))
+	if !containsAny(hint, "pro_custom", "免") {
+		return false
+	}
+	return containsAny(hint, neutralNoUploadMarkers...)
+}
+
+// joinDateRegex extracts the date from a join date cell like "2020-01-02 03:04:05 (5年前)"
+var joinDateRegex = regexp.MustCompile(`\d{4}[-/]\d{2}[-/]\d{2}(?: \d{2}:\d{2}(?::\d{2})?)?`)
+
+var discountEndTimeInOnmouseoverRegex = regexp.MustCompile(`title=(?:&quot;|")(\d{4}-\d{2}-\d{2}\s+\d{2}:\d{2}:\d{2})(?:&quot;|")`)
+
+// bareTimestampRegex matches an absolute timestamp written as plain text,
+// e.g. '剩余时间：<b>2026-01-18 22:37:47</b>' in a domTT payload
+var bareTimestampRegex = regexp.MustCompile(`\d{4}-\d{2}-\d{2}\s+\d{2}:\d{2}:\d{2}`)
+
+// parseDiscountEndTimeFromOnmouseover extracts the discount end time from a
+// tooltip, preferring <span title="..."> timestamps over bare ones. Some
+// tooltips also show the current time, so the latest timestamp found wins.
+func parseDiscountEndTimeFromOnmouseover(onmouseover string) time.Time {
+	var candidates []string
+	for _, m := range discountEndTimeInOnmouseoverRegex.FindAllStringSubmatch(onmouseover, -1) {
+		candidates = append(candidates, m[1])
+	}
+	if len(candidates) == 0 {
+		candidates = bareTimestampRegex.FindAllString(onmouseover, -1)
+	}
+
+	var latest time.Time
+	for _, c := range candidates {
+		if t := parseTime(c); t.After(latest) {
+			latest = t
+		}
+	}
+	return latest
+}
+
+// siteLocation returns the timezone of the site definition, defaulting to CST
+func (d *NexusPHPDriver) siteLocation() *time.Location {
+	if d.siteDefinition == nil || d.siteDefinition.TimezoneOffset == "" {
+		return CSTLocation
+	}
+	offset := d.siteDefinition.TimezoneOffset
+	if !timezonePattern.MatchString(offset) {
+		return CSTLocation
+	}
+	hours, _ := strconv.Atoi(offset[1:3])
+	minutes, _ := strconv.Atoi(offset[3:5])
+	seconds := hours*3600 + minutes*60
+	if offset[0] == '-' {
+		seconds = -seconds
+	}
+	return time.FixedZone(offset, seconds)
 }
 
 // parseTime parses various time formats
 func parseTime(timeStr string) time.Time {
+	return parseTimeInLocation(timeStr, time.UTC)
+}
+
+// parseTimeInLocation parses various time formats in the given location
+func parseTimeInLocation(timeStr string, loc *time.Location) time.Time {
 	timeStr = strings.TrimSpace(timeStr)
 	if timeStr == "" {
 		return time.Time{}
@@ -1537,12 +3155,14 @@ func parseTime(timeStr string) time.Time {
 		"2006-01-02 15:04",
 		"2006/01/02 15:04:05",
 		"2006/01/02 15:04",
+		"2006-01-02",
+		"2006/01/02",
 		"01-02 15:04",
 		time.RFC3339,
 	}
 
 	for _, format := range formats {
-		if t, err := time.Parse(format, timeStr); err == nil {
+		if t, err := time.ParseInLocation(format, timeStr, loc); err == nil {
 			return t
 		}
 	}
@@ -1550,18 +3170,170 @@ func parseTime(timeStr string) time.Time {
 	return time.Time{}
 }
 
-// parseRatio parses a ratio string
+// referenceTime returns the time relative upload times are measured from
+func (d *NexusPHPDriver) referenceTime() time.Time {
+	if d.now != nil {
+		return d.now()
+	}
+	return time.Now()
+}
+
+// durationUnitRegex matches one "<n><unit>" part of an accumulated duration
+// such as "1年30天" or "3d 4h 5m"; longer units are listed first
+var durationUnitRegex = regexp.MustCompile(`(?i)(\d+(?:\.\d+)?)\s*(个月|小时|小時|分钟|分鐘|星期|年|月|周|週|天|日|时|時|分|秒|years?|yrs?|months?|weeks?|days?|hours?|hrs?|minutes?|mins?|seconds?|secs?|y|w|d|h|m|s)`)
+
+// durationClockRegex matches a trailing clock part like "10:20:30" in "127天10:20:30"
+var durationClockRegex = regexp.MustCompile(`(\d+):(\d{2})(?::(\d{2}))?`)
+
+// parseDuration parses an accumulated duration such as "1年30天", "2天3时",
+// "127天10:20:30" or "3d 4h 5m" into seconds. A year is 365 days and a
+// month 30 days; unparseable input yields 0.
+func parseDuration(s string) int64 {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+
+	var total float64
+	if m := durationClockRegex.FindStringSubmatchIndex(s); m != nil {
+		hours, _ := strconv.Atoi(s[m[2]:m[3]])
+		minutes, _ := strconv.Atoi(s[m[4]:m[5]])
+		seconds := 0
+		if m[6] >= 0 {
+			seconds, _ = strconv.Atoi(s[m[6]:m[7]])
+		}
+		total += float64(hours*3600 + minutes*60 + seconds)
+		s = s[:m[0]] + " " + s[m[1]:]
+	}
+
+	for _, part := range durationUnitRegex.FindAllStringSubmatch(s, -1) {
+		n, err := strconv.ParseFloat(part[1], 64)
+		if err != nil {
+			continue
+		}
+		unit := strings.ToLower(part[2])
+		switch {
+		case unit == "年" || strings.HasPrefix(unit, "y"):
+			total += n * 365 * 86400
+		case unit == "个月" || unit == "月" || strings.HasPrefix(unit, "mo"):
+			total += n * 30 * 86400
+		case unit == "周" || unit == "週" || unit == "星期" || strings.HasPrefix(unit, "w"):
+			total += n * 7 * 86400
+		case unit == "天" || unit == "日" || strings.HasPrefix(unit, "d"):
+			total += n * 86400
+		case unit == "小时" || unit == "小時" || unit == "时" || unit == "時" || strings.HasPrefix(unit, "h"):
+			total += n * 3600
+		case unit == "分钟" || unit == "分鐘" || unit == "分" || strings.HasPrefix(unit, "m"):
+			total += n * 60
+		default:
+			total += n
+		}
+	}
+	return int64(total)
+}
+
+// relativeTimeUnitRegex matches one "<n><unit>" part of a relative time such
+// as "3天前", "1小时20分钟前" or "2 hours ago"
+var relativeTimeUnitRegex = regexp.MustCompile(`(?i)(\d+)\s*(个月|分钟|小时|星期|秒|分|时|天|日|周|月|年|seconds?|secs?|minutes?|mins?|hours?|hrs?|days?|weeks?|months?|years?)`)
+
+// relativeDayRegex matches a day-relative time such as "昨天 14:30"
+var relativeDayRegex = regexp.MustCompile(`(?i)^(今天|昨天|前天|today|yesterday)\s*(\d{1,2}):(\d{2})(?::(\d{2}))?$`)
+
+// parseRelativeTime converts a relative time ("刚刚", "昨天 14:30", "3天前",
+// "2 hours ago") to an absolute time based on now. It returns the zero time
+// when the string is not relative.
+func parseRelativeTime(timeStr string, now time.Time) time.Time {
+	timeStr = strings.TrimSpace(timeStr)
+	lower := strings.ToLower(timeStr)
+	switch lower {
+	case "":
+		return time.Time{}
+	case "刚刚", "just now", "now":
+		return now
+	}
+
+	if m := relativeDayRegex.FindStringSubmatch(timeStr); m != nil {
+		days := 0
+		switch strings.ToLower(m[1]) {
+		case "昨天", "yesterday":
+			days = 1
+		case "前天":
+			days = 2
+		}
+		hour, _ := strconv.Atoi(m[2])
+		minute, _ := strconv.Atoi(m[3])
+		second, _ := strconv.Atoi(m[4])
+		if hour > 23 || minute > 59 || second > 59 {
+			return time.Time{}
+		}
+		day := now.AddDate(0, 0, -days)
+		return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, now.Location())
+	}
+
+	if !strings.HasSuffix(lower, "前") && !strings.HasSuffix(lower, "ago") {
+		return time.Time{}
+	}
+	parts := relativeTimeUnitRegex.FindAllStringSubmatch(lower, -1)
+	if len(parts) == 0 {
+		return time.Time{}
+	}
+
+	t := now
+	for _, part := range parts {
+		n, err := strconv.Atoi(part[1])
+		if err != nil {
+			return time.Time{}
+		}
+		switch unit := part[2]; {
+		case unit == "秒" || strings.HasPrefix(unit, "sec"):
+			t = t.Add(-time.Duration(n) * time.Second)
+		case unit == "分钟" || unit == "分" || strings.HasPrefix(unit, "min"):
+			t = t.Add(-time.Duration(n) * time.Minute)
+		case unit == "小时" || unit == "时" || strings.HasPrefix(unit, "h"):
+			t = t.Add(-time.Duration(n) * time.Hour)
+		case unit == "天" || unit == "日" || strings.HasPrefix(unit, "day"):
+			t = t.AddDate(0, 0, -n)
+		case unit == "周" || unit == "星期" || strings.HasPrefix(unit, "week"):
+			t = t.AddDate(0, 0, -7*n)
+		case unit == "个月" || unit == "月" || strings.HasPrefix(unit, "month"):
+			t = t.AddDate(0, -n, 0)
+		case unit == "年" || strings.HasPrefix(unit, "year"):
+			t = t.AddDate(-n, 0, 0)
+		}
+	}
+	return t
+}
+
+// parseRatio parses a ratio string, returning -1 for an infinite ratio and 0
+// when no number is present
 func parseRatio(ratioStr string) float64 {
+	value, _ := parseRatioOK(ratioStr)
+	return value
+}
+
+// parseRatioOK parses a ratio string and reports whether a ratio was present.
+// Sites show "∞", "Inf.", "无限", "---" or "N/A" for accounts with nothing
+// downloaded; those return the infinite sentinel -1.
+func parseRatioOK(ratioStr string) (float64, bool) {
 	ratioStr = strings.TrimSpace(ratioStr)
 	ratioStr = strings.ReplaceAll(ratioStr, ",", "")
+	if ratioStr == "" {
+		return 0, false
+	}
 
 	// Handle special cases
-	if strings.Contains(strings.ToLower(ratioStr), "inf") || strings.Contains(ratioStr, "∞") {
-		return -1 // Infinite ratio
+	lower := strings.ToLower(ratioStr)
+	if strings.Contains(lower, "inf") || strings.Contains(ratioStr, "∞") ||
+		strings.Contains(ratioStr, "无限") || strings.Contains(ratioStr, "無限") ||
+		lower == "n/a" || strings.Trim(ratioStr, "-") == "" {
+		return -1, true // Infinite ratio
 	}
 
-	value, _ := strconv.ParseFloat(ratioStr, 64)
-	return value
+	value, err := strconv.ParseFloat(ratioStr, 64)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
 }
 
 // parseFloat parses a float string
@@ -1589,6 +3361,85 @@ func findTextByLabel(doc *goquery.Document, labels ...string) string {
 	return ""
 }
 
+// titleAnchors narrows the title selector matches to the first one and those
+// linking to the same href, so unrelated details links in the row (comments,
+// thumbnails) never leak into the title.
+func titleAnchors(sel *goquery.Selection) *goquery.Selection {
+	href, exists := sel.First().Attr("href")
+	if !exists {
+		return sel.First()
+	}
+	return sel.FilterFunction(func(i int, s *goquery.Selection) bool {
+		h, _ := s.Attr("href")
+		return i == 0 || h == href
+	})
+}
+
+// inlineTextTags are formatting tags whose text belongs to the surrounding
+// word, e.g. a search-term highlight inside a release name
+var inlineTextTags = map[string]bool{"b": true, "i": true, "u": true, "em": true, "strong": true, "font": true}
+
+// joinedText returns the text under sel with the parts of separate anchors,
+// spans and other elements joined by single spaces, so titles split over
+// nested nodes don't run together.
+func joinedText(sel *goquery.Selection) string {
+	var b strings.Builder
+	var walk func(*goquery.Selection)
+	walk = func(sel *goquery.Selection) {
+		sel.Each(func(_ int, n *goquery.Selection) {
+			name := goquery.NodeName(n)
+			if name == "#text" {
+				b.WriteString(n.Text())
+				return
+			}
+			sep := !inlineTextTags[name]
+			if sep {
+				b.WriteByte(' ')
+			}
+			walk(n.Contents())
+			if sep {
+				b.WriteByte(' ')
+			}
+		})
+	}
+	walk(sel)
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// sizeValueRegex matches a size with an explicit unit, e.g. "1.5 TB", "800 GiB" or "1.5吉"
+var sizeValueRegex = regexp.MustCompile(`(?i)\d[\d,]*(?:\.\d+)?\s*(?:[KMGTPE]i?B|B|[千兆吉太拍])`)
+
+// extractSizeText returns the first size with a unit found in s, dropping
+// surrounding noise such as colored <font> counters. When s holds no such
+// size it is returned trimmed, so unit-less values still reach parseSize.
+func extractSizeText(s string) string {
+	if m := sizeValueRegex.FindString(s); m != "" {
+		return m
+	}
+	return strings.TrimSpace(s)
+}
+
+// findSizeByLabel is findTextByLabel for size values. Each matching label
+// cell is tried on its own, and only the size found in its sibling cell is
+// returned, so wrapper cells and extra <font> spans are ignored. Falls back
+// to findTextByLabel when no cell holds a size with a unit.
+func findSizeByLabel(doc *goquery.Document, labels ...string) string {
+	for _, label := range labels {
+		var value string
+		doc.Find(fmt.Sprintf("td:contains('%s')", label)).EachWithBreak(func(_ int, s *goquery.Selection) bool {
+			if m := sizeValueRegex.FindString(s.Next().Text()); m != "" {
+				value = m
+				return false
+			}
+			return true
+		})
+		if value != "" {
+			return value
+		}
+	}
+	return findTextByLabel(doc, labels...)
+}
+
 // extractUserID extracts user ID from a URL like "userdetails.php?id=12345"
 func extractUserID(href string) string {
 	re := regexp.MustCompile(`id=(\d+)`)
@@ -1687,6 +3538,24 @@ func extractSizeFromTransfer(text string, labels ...string) int64 {
 	return 0
 }
 
+var (
+	arrowUploadRegex   = regexp.MustCompile(`(?i)[↑▲]\s*(\d[\d,]*(?:\.\d+)?\s*(?:[KMGTPE]i?B|B))`)
+	arrowDownloadRegex = regexp.MustCompile(`(?i)[↓▼]\s*(\d[\d,]*(?:\.\d+)?\s*(?:[KMGTPE]i?B|B))`)
+)
+
+// extractArrowTraffic extracts uploaded and downloaded sizes from a combined
+// traffic string marked with arrow glyphs
+// Format: "↑1.5TB ↓500GB" or "▲ 1.5 TB ▼ 500 GB"
+func extractArrowTraffic(text string) (uploaded, downloaded int64) {
+	if m := arrowUploadRegex.FindStringSubmatch(text); m != nil {
+		uploaded = parseSize(m[1])
+	}
+	if m := arrowDownloadRegex.FindStringSubmatch(text); m != nil {
+		downloaded = parseSize(m[1])
+	}
+	return uploaded, downloaded
+}
+
 // extractValueFromTransfer extracts a value from transfer string
 func extractValueFromTransfer(text string, labels ...string) string {
 	for _, label := range labels {
@@ -1726,14 +3595,29 @@ func (d *NexusPHPDriver) PrepareUserSeedingPage(userID, listType string) (NexusP
 	return NexusPHPRequest{
 		Path:   "/getusertorrentlistajax.php",
 		Params: params,
-		Method: "GET",
+		Method: d.seedingRequestMethod(),
 	}, nil
 }
 
+// seedingRequestMethod returns the site's method for the seeding AJAX request
+func (d *NexusPHPDriver) seedingRequestMethod() string {
+	if d.siteDefinition != nil && d.siteDefinition.UserInfo != nil &&
+		strings.EqualFold(d.siteDefinition.UserInfo.SeedingRequestMethod, http.MethodPost) {
+		return http.MethodPost
+	}
+	return http.MethodGet
+}
+
+// seedingCountOnlyRegex matches a seeding summary that gives only a count,
+// e.g. "共 94 条" or "94 条记录"
+var seedingCountOnlyRegex = regexp.MustCompile(`共\s*([\d,]+)\s*[条條]|([\d,]+)\s*[条條][记記]录`)
+
 // ParseSeedingStatus parses the seeding status from the AJAX response
 // Implements two parsing strategies based on NexusPHP.ts:
 // 1. Direct parsing: Look for summary text like "10 | 100 GB" or "<b>94</b>条记录，共计<b>2.756 TB</b>"
 // 2. Table accumulation: Sum up sizes from individual torrent rows
+// A count-only summary ("共 94 条") supplies the count while the size is
+// still accumulated from the rows.
 func (d *NexusPHPDriver) ParseSeedingStatus(res NexusPHPResponse) (seeding int, seedingSize int64, err error) {
 	if res.Document == nil {
 		return 0, 0, ErrParseError
@@ -1772,6 +3656,20 @@ func (d *NexusPHPDriver) ParseSeedingStatus(res NexusPHPResponse) (seeding int,
 		}
 	}
 
+	// Method 1c: Count-only summary (e.g., "共 94 条") - the size column is
+	// left to the table accumulation below
+	summaryCount := -1
+	if m := seedingCountOnlyRegex.FindStringSubmatch(doc.Text()); m != nil {
+		countText := m[1]
+		if countText == "" {
+			countText = m[2]
+		}
+		summaryCount = int(parseFloat(countText))
+		if DebugUserInfo {
+			fmt.Printf("[DEBUG] ParseSeedingStatus Method1c (count-only format): count=%d from %q\n", summaryCount, m[0])
+		}
+	}
+
 	// Method 2: Fallback - parse table rows and accumulate sizes
 	// Find all rows except the header row
 	rows := doc.Find("table:last tr:not(:first-child)")
@@ -1784,19 +3682,22 @@ func (d *NexusPHPDriver) ParseSeedingStatus(res NexusPHPResponse) (seeding int,
 		if DebugUserInfo {
 			fmt.Printf("[DEBUG] ParseSeedingStatus: no table rows found\n")
 		}
-		return 0, 0, nil
+		return max(summaryCount, 0), 0, nil
 	}
 
 	seeding = rows.Length()
+	if summaryCount >= 0 {
+		// The summary counts every torrent, including rows on later pages
+		seeding = summaryCount
+	}
 
 	// Auto-detect size column index by finding the first column that matches size pattern
 	sizeIndex := -1
-	sizePattern := regexp.MustCompile(`(?i)[\d.]+\s*[KMGTP]?i?B`)
 
 	// Check first row to determine size column
 	firstRow := rows.First()
 	firstRow.Find("td").Each(func(i int, td *goquery.Selection) {
-		if sizeIndex < 0 && sizePattern.MatchString(td.Text()) {
+		if sizeIndex < 0 && sizeColumnPattern.MatchString(td.Text()) {
 			sizeIndex = i
 		}
 	})
@@ -1830,22 +3731,349 @@ func (d *NexusPHPDriver) ParseSeedingStatus(res NexusPHPResponse) (seeding int,
 	return seeding, seedingSize, nil
 }
 
+// hrUnsatisfiedKeywords and hrSatisfiedKeywords mark the per-torrent H&R status in seeding lists.
+// Negative keywords are checked first since "satisfied" is a substring of "unsatisfied".
+var (
+	hrUnsatisfiedKeywords = []string{"未满足", "未達成", "未达标", "not satisfied", "unsatisfied"}
+	hrSatisfiedKeywords   = []string{"已满足", "已達成", "已达标", "satisfied"}
+)
+
+// parseHRSatisfied returns the H&R satisfaction status found in text, or nil when unknown
+func parseHRSatisfied(text string) *bool {
+	lower := strings.ToLower(text)
+	for _, kw := range hrUnsatisfiedKeywords {
+		if strings.Contains(lower, kw) {
+			satisfied := false
+			return &satisfied
+		}
+	}
+	for _, kw := range hrSatisfiedKeywords {
+		if strings.Contains(lower, kw) {
+			satisfied := true
+			return &satisfied
+		}
+	}
+	return nil
+}
+
+// parseHRStatus reads the H&R status from the elements of row matched by
+// selector, including their title and alt attributes
+func parseHRStatus(row *goquery.Selection, selector string) *bool {
+	if selector == "" {
+		return nil
+	}
+	var text strings.Builder
+	row.Find(selector).Each(func(_ int, elem *goquery.Selection) {
+		text.WriteString(elem.Text())
+		text.WriteString(" " + elem.AttrOr("title", "") + " " + elem.AttrOr("alt", "") + " ")
+	})
+	return parseHRSatisfied(text.String())
+}
+
+// ParseSeedingList parses the individual torrents from the seeding AJAX response,
+// including the per-torrent H&R satisfaction status when the site shows it
+func (d *NexusPHPDriver) ParseSeedingList(res NexusPHPResponse) ([]TorrentItem, error) {
+	if res.Document == nil {
+		return nil, ErrParseError
+	}
+
+	rows := res.Document.Find("table:last tr:not(:first-child)")
+	if rows.Length() == 0 {
+		rows = res.Document.Find("table tr:not(:first-child)")
+	}
+
+	var items []TorrentItem
+	rows.Each(func(_ int, row *goquery.Selection) {
+		link := row.Find("a[href*='details.php']").First()
+		href, exists := link.Attr("href")
+		if !exists {
+			return
+		}
+
+		item := TorrentItem{
+			ID:            extractTorrentID(href),
+			Title:         strings.TrimSpace(link.AttrOr("title", "")),
+			SourceSite:    d.getSiteID(),
+			DiscountLevel: DiscountNone,
+			HRSatisfied:   parseHRStatus(row, d.Selectors.SeedingHRStatus),
+		}
+		if item.Title == "" {
+			item.Title = strings.TrimSpace(link.Text())
+		}
+
+		row.Find("td").EachWithBreak(func(_ int, td *goquery.Selection) bool {
+			text := strings.TrimSpace(td.Text())
+			if sizeCellPattern.MatchString(text) {
+				item.SizeBytes = parseSize(text)
+				return false
+			}
+			return true
+		})
+
+		items = append(items, item)
+	})
+
+	return items, nil
+}
+
+// defaultPromotionPath is the usual NexusPHP page listing discounted torrents
+const defaultPromotionPath = "/promotion.php"
+
+// PreparePromotions prepares a request for one page (0-indexed) of the promotion listing
+func (d *NexusPHPDriver) PreparePromotions(page int) (NexusPHPRequest, error) {
+	params := url.Values{}
+	if page > 0 {
+		params.Set("page", strconv.Itoa(page))
+	}
+	return NexusPHPRequest{
+		Path:   d.promotionPath,
+		Params: params,
+		Method: "GET",
+	}, nil
+}
+
+// GetPromotions fetches up to maxPages pages (at least one) of the promotion
+// listing and parses the rows with the search row parser. Paging stops early
+// at an empty page or when a page repeats torrents already seen, which is how
+// sites answer out-of-range page numbers.
+func (d *NexusPHPDriver) GetPromotions(ctx context.Context, maxPages int) ([]TorrentItem, error) {
+	if maxPages <= 0 {
+		maxPages = 1
+	}
+
+	var items []TorrentItem
+	seen := make(map[string]struct{})
+	for page := 0; page < maxPages; page++ {
+		req, err := d.PreparePromotions(page)
+		if err != nil {
+			return nil, err
+		}
+		res, err := d.Execute(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("fetch promotion page %d: %w", page, err)
+		}
+		pageItems, err := d.ParseSearch(res)
+		if err != nil {
+			return nil, fmt.Errorf("parse promotion page %d: %w", page, err)
+		}
+
+		added := 0
+		for _, item := range pageItems {
+			if _, ok := seen[item.ID]; ok {
+				continue
+			}
+			seen[item.ID] = struct{}{}
+			items = append(items, item)
+			added++
+		}
+		if added == 0 {
+			break
+		}
+	}
+	return items, nil
+}
+
+// GetSiteStats fetches the homepage and parses its site-wide stats
+func (d *NexusPHPDriver) GetSiteStats(ctx context.Context) (SiteStats, error) {
+	res, err := d.Execute(ctx, NexusPHPRequest{Path: "/index.php", Method: "GET"})
+	if err != nil {
+		return SiteStats{}, fmt.Errorf("fetch homepage: %w", err)
+	}
+	return d.ParseSiteStats(res)
+}
+
+// ParseSiteStats parses the online user and torrent counts from the
+// homepage. Counts the page does not show are left at zero.
+func (d *NexusPHPDriver) ParseSiteStats(res NexusPHPResponse) (SiteStats, error) {
+	if res.Document == nil {
+		return SiteStats{}, ErrParseError
+	}
+	count := func(selector string) int {
+		if selector == "" {
+			return 0
+		}
+		n, _ := strconv.Atoi(extractNumber(res.Document.Find(selector).First().Text()))
+		return n
+	}
+	return SiteStats{
+		OnlineUsers:   count(d.Selectors.StatsOnlineUsers),
+		TorrentsToday: count(d.Selectors.StatsTorrentsToday),
+		TotalTorrents: count(d.Selectors.StatsTotalTorrents),
+	}, nil
+}
+
+// defaultPeerListPath is the usual NexusPHP page listing a torrent's peers
+const defaultPeerListPath = "/viewpeerlist.php"
+
+// PreparePeerList prepares a request for a torrent's peer list
+func (d *NexusPHPDriver) PreparePeerList(torrentID string) (NexusPHPRequest, error) {
+	if torrentID == "" {
+		return NexusPHPRequest{}, fmt.Errorf("torrent ID is required")
+	}
+	params := url.Values{}
+	params.Set("id", torrentID)
+	return NexusPHPRequest{
+		Path:   d.peerListPath,
+		Params: params,
+		Method: "GET",
+	}, nil
+}
+
+// GetPeerList fetches and parses the full peer list of a torrent
+func (d *NexusPHPDriver) GetPeerList(ctx context.Context, torrentID string) ([]Peer, error) {
+	req, err := d.PreparePeerList(torrentID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := d.Execute(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch peer list: %w", err)
+	}
+	return d.ParsePeerList(res)
+}
+
+// ParsePeerList parses the seeder and leecher tables of a peer list page.
+// Each table is classified by the heading before it ("做种者"/"Seeders" or
+// "下载者"/"Leechers"); without one, a peer at 100% counts as a seeder.
+// Columns are located by their header text, so extra columns are tolerated.
+func (d *NexusPHPDriver) ParsePeerList(res NexusPHPResponse) ([]Peer, error) {
+	if res.Document == nil {
+		return nil, ErrParseError
+	}
+
+	selector := d.Selectors.PeerListTable
+	if selector == "" {
+		selector = "table"
+	}
+
+	peers := []Peer{}
+	res.Document.Find(selector).Each(func(_ int, table *goquery.Selection) {
+		rows := table.Find("tr")
+		if rows.Length() < 2 {
+			return
+		}
+
+		clientCol, progressCol := -1, -1
+		rows.First().Children().Each(func(i int, cell *goquery.Selection) {
+			header := strings.ToLower(strings.TrimSpace(cell.Text()))
+			switch {
+			case strings.Contains(header, "客户端") || strings.Contains(header, "client"):
+				clientCol = i
+			case strings.Contains(header, "完成") || strings.Contains(header, "complete") || strings.Contains(header, "progress"):
+				progressCol = i
+			}
+		})
+		if clientCol < 0 && progressCol < 0 {
+			return
+		}
+
+		heading := peerTableHeading(table)
+		rows.Slice(1, goquery.ToEnd).Each(func(_ int, row *goquery.Selection) {
+			cells := row.Children()
+			peer := Peer{}
+			if clientCol >= 0 && clientCol < cells.Length() {
+				peer.Client = strings.TrimSpace(cells.Eq(clientCol).Text())
+			}
+			if progressCol >= 0 && progressCol < cells.Length() {
+				peer.Progress = parsePeerProgress(cells.Eq(progressCol).Text())
+			}
+			switch heading {
+			case peerHeadingSeeders:
+				peer.IsSeeder = true
+			case peerHeadingLeechers:
+				peer.IsSeeder = false
+			default:
+				peer.IsSeeder = peer.Progress >= 1
+			}
+			peers = append(peers, peer)
+		})
+	})
+
+	return peers, nil
+}
+
+const (
+	peerHeadingUnknown = iota
+	peerHeadingSeeders
+	peerHeadingLeechers
+)
+
+// peerTableHeading classifies a peer table by the nearest heading before it
+func peerTableHeading(table *goquery.Selection) int {
+	heading := peerHeadingUnknown
+	table.PrevAll().EachWithBreak(func(_ int, prev *goquery.Selection) bool {
+		if goquery.NodeName(prev) == "table" {
+			return false
+		}
+		text := strings.ToLower(prev.Text())
+		switch {
+		case strings.Contains(text, "做种") || strings.Contains(text, "seeder"):
+			heading = peerHeadingSeeders
+		case strings.Contains(text, "下载者") || strings.Contains(text, "leecher"):
+			heading = peerHeadingLeechers
+		default:
+			return true
+		}
+		return false
+	})
+	return heading
+}
+
+// parsePeerProgress parses a completion like "45.5%" into a 0-1 fraction
+func parsePeerProgress(text string) float64 {
+	value, err := strconv.ParseFloat(extractNumber(text), 64)
+	if err != nil {
+		return 0
+	}
+	if strings.Contains(text, "%") || value > 1 {
+		value /= 100
+	}
+	return math.Min(value, 1)
+}
+
+// FetchSeedingList fetches the user's seeding torrents with their H&R status
+func (d *NexusPHPDriver) FetchSeedingList(ctx context.Context, userID string) ([]TorrentItem, error) {
+	req, err := d.PrepareUserSeedingPage(userID, "seeding")
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.Execute(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.ParseSeedingList(res)
+}
+
 // FetchSeedingStatus fetches the seeding status (count and size) for a user
 // This method requests /getusertorrentlistajax.php and parses the response
 func (d *NexusPHPDriver) FetchSeedingStatus(ctx context.Context, userID string) (seeding int, seedingSize int64, err error) {
-	req, err := d.PrepareUserSeedingPage(userID, "seeding")
+	return d.fetchTorrentListStatus(ctx, userID, "seeding")
+}
+
+// FetchLeechingStatus fetches the leeching status (count and size) for a user
+// from the same AJAX endpoint; an empty list yields zero counts, not an error
+func (d *NexusPHPDriver) FetchLeechingStatus(ctx context.Context, userID string) (leeching int, leechingSize int64, err error) {
+	return d.fetchTorrentListStatus(ctx, userID, "leeching")
+}
+
+// fetchTorrentListStatus fetches and parses the count and total size of the
+// user's torrent list of the given type ("seeding" or "leeching")
+func (d *NexusPHPDriver) fetchTorrentListStatus(ctx context.Context, userID, listType string) (count int, size int64, err error) {
+	req, err := d.PrepareUserSeedingPage(userID, listType)
 	if err != nil {
 		return 0, 0, err
 	}
 
 	if DebugUserInfo {
-		fmt.Printf("[DEBUG] FetchSeedingStatus: requesting %s?%s\n", req.Path, req.Params.Encode())
+		fmt.Printf("[DEBUG] fetchTorrentListStatus(%s): %s %s?%s\n", listType, req.Method, req.Path, req.Params.Encode())
 	}
 
 	res, err := d.Execute(ctx, req)
 	if err != nil {
 		if DebugUserInfo {
-			fmt.Printf("[DEBUG] FetchSeedingStatus: request error: %v\n", err)
+			fmt.Printf("[DEBUG] fetchTorrentListStatus(%s): request error: %v\n", listType, err)
 		}
 		return 0, 0, err
 	}
@@ -1853,7 +4081,7 @@ func (d *NexusPHPDriver) FetchSeedingStatus(ctx context.Context, userID string)
 	// Check if response contains table data
 	if res.Document == nil {
 		if DebugUserInfo {
-			fmt.Printf("[DEBUG] FetchSeedingStatus: document is nil\n")
+			fmt.Printf("[DEBUG] fetchTorrentListStatus(%s): document is nil\n", listType)
 		}
 		return 0, 0, nil
 	}
@@ -1866,12 +4094,12 @@ func (d *NexusPHPDriver) FetchSeedingStatus(ctx context.Context, userID string)
 		if len(preview) > 500 {
 			preview = preview[:500] + "..."
 		}
-		fmt.Printf("[DEBUG] FetchSeedingStatus: response preview: %s\n", preview)
+		fmt.Printf("[DEBUG] fetchTorrentListStatus(%s): response preview: %s\n", listType, preview)
 	}
 
 	if !strings.Contains(bodyStr, "<table") {
 		if DebugUserInfo {
-			fmt.Printf("[DEBUG] FetchSeedingStatus: no table in response, skipping\n")
+			fmt.Printf("[DEBUG] fetchTorrentListStatus(%s): no table in response, skipping\n", listType)
 		}
 		return 0, 0, nil
 	}
@@ -1879,6 +4107,71 @@ func (d *NexusPHPDriver) FetchSeedingStatus(ctx context.Context, userID string)
 	return d.ParseSeedingStatus(res)
 }
 
+// seedingStatusWithFallback fetches the seeding status via the AJAX endpoint and,
+// when that yields nothing, parses the peer section of userdetails.php instead.
+// detailRes is reused when the caller already fetched userdetails.php.
+func (d *NexusPHPDriver) seedingStatusWithFallback(ctx context.Context, userID string, detailRes *NexusPHPResponse) (seeding int, seedingSize int64) {
+	seeding, seedingSize, err := d.FetchSeedingStatus(ctx, userID)
+	if err != nil {
+		if DebugUserInfo {
+			fmt.Printf("[DEBUG] FetchSeedingStatus error: %v\n", err)
+		}
+	} else if seeding > 0 || seedingSize > 0 {
+		return seeding, seedingSize
+	}
+
+	if d.Selectors.UserDetailsSeeding == "" {
+		return 0, 0
+	}
+
+	if detailRes == nil {
+		req, err := d.PrepareUserDetails(userID)
+		if err != nil {
+			return 0, 0
+		}
+		res, err := d.Execute(ctx, req)
+		if err != nil {
+			return 0, 0
+		}
+		detailRes = &res
+	}
+
+	seeding, seedingSize, err = d.ParseUserDetailsSeeding(*detailRes)
+	if err != nil {
+		return 0, 0
+	}
+	if DebugUserInfo {
+		fmt.Printf("[DEBUG] Seeding status from userdetails peer section: count=%d, size=%d\n", seeding, seedingSize)
+	}
+	return seeding, seedingSize
+}
+
+// ParseUserDetailsSeeding parses the seeding count and size from the peer
+// section of a userdetails.php page, selected by Selectors.UserDetailsSeeding
+func (d *NexusPHPDriver) ParseUserDetailsSeeding(res NexusPHPResponse) (seeding int, seedingSize int64, err error) {
+	if res.Document == nil {
+		return 0, 0, ErrParseError
+	}
+	if d.Selectors.UserDetailsSeeding == "" {
+		return 0, 0, nil
+	}
+
+	section := res.Document.Find(d.Selectors.UserDetailsSeeding).First()
+	if section.Length() == 0 || section.Find("table").Length() == 0 {
+		return 0, 0, nil
+	}
+
+	html, err := goquery.OuterHtml(section)
+	if err != nil {
+		return 0, 0, err
+	}
+	return d.ParseSeedingStatus(NexusPHPResponse{
+		Document:   goquery.NewDocumentFromNode(section.Get(0)),
+		RawBody:    []byte(html),
+		StatusCode: res.StatusCode,
+	})
+}
+
 // extractSiteIDFromURL extracts site ID from a base URL
 // e.g., "https://hdsky.me" -> "hdsky", "https://springsunday.net" -> "springsunday"
 func extractSiteIDFromURL(baseURL string) string {
@@ -1955,6 +4248,19 @@ func (d *NexusPHPDriver) GetTorrentDetail(ctx context.Context, guid, link, _ str
 		HasHR:           detailInfo.HasHR,
 		SourceSite:      d.getSiteID(),
 	}
+	// The detail page carries the same official/original badges as the listing
+	item.IsOfficial = hasBadge(res.Document.Selection, d.Selectors.OfficialBadge, d.Selectors.OfficialKeywords)
+
+	if detail, err := d.ParseDetail(res); err == nil {
+		item.PosterURL = detail.PosterURL
+		// Live counts from the detail page are fresher than search-row numbers
+		if detail.CurrentSeeders != nil {
+			item.Seeders = *detail.CurrentSeeders
+		}
+		if detail.CurrentLeechers != nil {
+			item.Leechers = *detail.CurrentLeechers
+		}
+	}
 
 	return item, nil
 }
@@ -1987,6 +4293,19 @@ func init() {
 	RegisterDriverForSchema("NexusPHP", createNexusPHPSite)
 }
 
+// userInfoStatusFetches is the number of status requests getUserInfoWithDefinition
+// runs alongside the dependent process steps: seeding and leeching
+const userInfoStatusFetches = 2
+
+// userInfoConcurrency returns the number of requests a userinfo fetch may run
+// in parallel: every process step plus the seeding and leeching status requests
+func userInfoConcurrency(def *SiteDefinition) int {
+	if def == nil || def.UserInfo == nil || len(def.UserInfo.Process) == 0 {
+		return 1
+	}
+	return len(def.UserInfo.Process) + userInfoStatusFetches
+}
+
 func createNexusPHPSite(config SiteConfig, logger *zap.Logger) (Site, error) {
 	var opts NexusPHPOptions
 	if len(config.Options) > 0 {
@@ -2010,10 +4329,38 @@ func createNexusPHPSite(config SiteConfig, logger *zap.Logger) (Site, error) {
 		mergeSelectors(&selectors, siteDef.Selectors)
 	}
 
+	var downloadParams url.Values
+	if opts.DownloadURLParams != "" {
+		params, err := url.ParseQuery(opts.DownloadURLParams)
+		if err != nil {
+			return nil, fmt.Errorf("parse NexusPHP downloadUrlParams: %w", err)
+		}
+		downloadParams = params
+	}
+
+	// The driver needs a limiter for the crawl delay to space its concurrent
+	// requests; an infinite rate leaves it unthrottled until the delay is known
+	driverRateLimit := config.RateLimit
+	if opts.RespectCrawlDelay && driverRateLimit <= 0 {
+		driverRateLimit = float64(rate.Inf)
+	}
+
 	driver := NewNexusPHPDriver(NexusPHPDriverConfig{
-		BaseURL:   config.BaseURL,
-		Cookie:    opts.Cookie,
-		Selectors: &selectors,
+		BaseURL:             config.BaseURL,
+		Cookie:              opts.Cookie,
+		Selectors:           &selectors,
+		Concurrency:         userInfoConcurrency(siteDef),
+		DownloadURLParams:   downloadParams,
+		PromotionPath:       opts.PromotionPath,
+		PeerListPath:        opts.PeerListPath,
+		Passkey:             opts.Passkey,
+		Charset:             opts.Charset,
+		DownloadConfirmPath: opts.DownloadConfirmPath,
+		ThanksPath:          opts.ThanksPath,
+		DownloadMethod:      siteDef.GetDownloadMethod(),
+		DownloadFormFields:  siteDef.GetDownloadFormFields(),
+		RateLimit:           driverRateLimit,
+		RateBurst:           config.RateBurst,
 	})
 
 	if siteDef != nil {
@@ -2021,11 +4368,12 @@ func createNexusPHPSite(config SiteConfig, logger *zap.Logger) (Site, error) {
 	}
 
 	return NewBaseSite(driver, BaseSiteConfig{
-		ID:        config.ID,
-		Name:      config.Name,
-		Kind:      SiteNexusPHP,
-		RateLimit: config.RateLimit,
-		RateBurst: config.RateBurst,
-		Logger:    logger.With(zap.String("site", config.ID)),
+		ID:                config.ID,
+		Name:              config.Name,
+		Kind:              SiteNexusPHP,
+		RateLimit:         config.RateLimit,
+		RateBurst:         config.RateBurst,
+		Logger:            logger.With(zap.String("site", config.ID)),
+		RespectCrawlDelay: opts.RespectCrawlDelay,
 	}), nil
 }
@@ -0,0 +1,72 @@
+package v2
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNexusPHPDriverJoinDate(t *testing.T) {
+	raw, err := os.ReadFile("testdata/nexusphp_userdetails_joindate.html")
+	require.NoError(t, err)
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(raw)))
+	require.NoError(t, err)
+
+	driver := &NexusPHPDriver{}
+	info, err := driver.ParseUserDetails(NexusPHPResponse{Document: doc, RawBody: raw, StatusCode: 200})
+	require.NoError(t, err)
+
+	expected := time.Date(2021, 3, 4, 5, 6, 7, 0, CSTLocation).Unix()
+	assert.Equal(t, expected, info.JoinDate, "JoinDate must match fixture")
+}
+
+func TestNexusPHPDriverJoinDate_SiteTimezone(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		value    string
+		tz       string
+		expected time.Time
+	}{
+		{
+			name:     "registration header with date only",
+			header:   "注册时间",
+			value:    "2020/01/02",
+			expected: time.Date(2020, 1, 2, 0, 0, 0, 0, CSTLocation),
+		},
+		{
+			name:     "site timezone offset",
+			header:   "Join date",
+			value:    "2022-06-01 12:00:00 (4 years ago)",
+			tz:       "-0500",
+			expected: time.Date(2022, 6, 1, 12, 0, 0, 0, time.FixedZone("", -5*3600)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := mustDoc(t, `<table><tr><td class="rowhead">`+tt.header+`</td><td class="rowfollow">`+tt.value+`</td></tr></table>`)
+			driver := &NexusPHPDriver{}
+			if tt.tz != "" {
+				driver.SetSiteDefinition(&SiteDefinition{TimezoneOffset: tt.tz})
+			}
+
+			info, err := driver.ParseUserDetails(NexusPHPResponse{Document: doc})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected.Unix(), info.JoinDate)
+		})
+	}
+}
+
+func TestNexusPHPDriverJoinDate_Unparseable(t *testing.T) {
+	doc := mustDoc(t, `<table><tr><td class="rowhead">加入日期</td><td class="rowfollow">未知</td></tr></table>`)
+	info, err := (&NexusPHPDriver{}).ParseUserDetails(NexusPHPResponse{Document: doc})
+	require.NoError(t, err)
+	assert.Zero(t, info.JoinDate)
+}
@@ -2,6 +2,8 @@ package v2
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -10,6 +12,7 @@ import (
 	"github.com/PuerkitoBio/goquery"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/zeebo/bencode"
 )
 
 func TestNewNexusPHPDriver(t *testing.T) {
@@ -433,6 +436,11 @@ func TestParseSize(t *testing.T) {
 		{"1.5 GB", int64(1.5 * 1024 * 1024 * 1024)},
 		{"1 TB", 1024 * 1024 * 1024 * 1024},
 		{"100 B", 100},
+		{"1.5吉", int64(1.5 * 1024 * 1024 * 1024)},
+		{"500兆", 500 * 1024 * 1024},
+		{"2 千", 2048},
+		{"1太", 1024 * 1024 * 1024 * 1024},
+		{"1,024兆", 1024 * 1024 * 1024},
 		{"invalid", 0},
 		{"", 0},
 	}
@@ -454,6 +462,10 @@ func TestParseRatio(t *testing.T) {
 		{"inf", -1},
 		{"Inf", -1},
 		{"∞", -1},
+		{"Inf.", -1},
+		{"---", -1},
+		{"N/A", -1},
+		{"无限", -1},
 		{"invalid", 0},
 		{"", 0},
 	}
@@ -465,6 +477,43 @@ func TestParseRatio(t *testing.T) {
 	}
 }
 
+func TestParseRatioOK(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected float64
+		ok       bool
+	}{
+		{"0.000", 0, true},
+		{"1,234.5", 1234.5, true},
+		{"---", -1, true},
+		{"n/a", -1, true},
+		{"invalid", 0, false},
+		{"  ", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			value, ok := parseRatioOK(tt.input)
+			assert.Equal(t, tt.expected, value)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestSetUserInfoField_Ratio(t *testing.T) {
+	d := &NexusPHPDriver{}
+
+	info := UserInfo{Ratio: 2.5}
+	d.setUserInfoField(&info, "ratio", "garbled")
+	assert.Equal(t, 2.5, info.Ratio, "an unparsable ratio must not clobber a known value")
+
+	d.setUserInfoField(&info, "ratio", "---")
+	assert.Equal(t, float64(-1), info.Ratio)
+
+	d.setUserInfoField(&info, "ratio", "0.00")
+	assert.Equal(t, float64(0), info.Ratio)
+}
+
 func TestDefaultNexusPHPSelectors(t *testing.T) {
 	selectors := DefaultNexusPHPSelectors()
 
@@ -898,6 +947,36 @@ func TestParseDiscountEndTimeFromOnmouseover(t *testing.T) {
 			wantMinute:  0,
 			wantSecond:  0,
 		},
+		{
+			name:        "bare timestamp without title attribute",
+			onmouseover: `domTT_activate(this, event, 'content', '&lt;b&gt;&lt;font class=&quot;free&quot;&gt;免费&lt;/font&gt;&lt;/b&gt;剩余时间：&lt;b&gt;2026-01-18 22:37:47&lt;/b&gt;', 'trail', false)`,
+			wantYear:    2026,
+			wantMonth:   1,
+			wantDay:     18,
+			wantHour:    22,
+			wantMinute:  37,
+			wantSecond:  47,
+		},
+		{
+			name:        "bare timestamps with current time prefers the latest",
+			onmouseover: `domTT_activate(this, event, 'content', '当前时间：<b>2026-01-10 09:00:00</b> 截止时间：<b>2026-01-12 18:30:00</b>', 'trail', false)`,
+			wantYear:    2026,
+			wantMonth:   1,
+			wantDay:     12,
+			wantHour:    18,
+			wantMinute:  30,
+			wantSecond:  0,
+		},
+		{
+			name:        "title attribute preferred over bare timestamp",
+			onmouseover: `domTT_activate(this, event, 'content', '更新于 2026-03-01 00:00:00 剩余：<span title="2026-02-20 12:00:00">3天</span>', 'trail', false)`,
+			wantYear:    2026,
+			wantMonth:   2,
+			wantDay:     20,
+			wantHour:    12,
+			wantMinute:  0,
+			wantSecond:  0,
+		},
 		{
 			name:        "empty string",
 			onmouseover: "",
@@ -1072,6 +1151,53 @@ func TestNexusPHPDriver_ParseDownload_Empty(t *testing.T) {
 	assert.Contains(t, err.Error(), "empty torrent file")
 }
 
+func TestNexusPHPDriver_DownloadAndHash(t *testing.T) {
+	torrentData := createTestTorrent("hash-fixture")
+
+	var metainfo struct {
+		Info bencode.RawMessage `bencode:"info"`
+	}
+	require.NoError(t, bencode.DecodeBytes(torrentData, &metainfo))
+	sum := sha1.Sum(metainfo.Info)
+	expectedHash := hex.EncodeToString(sum[:])
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/details.php":
+			assert.Equal(t, "42", r.URL.Query().Get("id"))
+			_, _ = w.Write([]byte(`<html><body><a href="download.php?id=42&passkey=abc">dl</a></body></html>`))
+		case "/download.php":
+			w.Header().Set("Content-Type", "application/x-bittorrent")
+			_, _ = w.Write(torrentData)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	data, hash, err := d.DownloadAndHash(context.Background(), "42")
+	require.NoError(t, err)
+	assert.Equal(t, torrentData, data)
+	assert.Equal(t, expectedHash, hash)
+}
+
+func TestNexusPHPDriver_DownloadAndHash_InvalidTorrent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/download.php" {
+			_, _ = w.Write([]byte("not a torrent"))
+			return
+		}
+		_, _ = w.Write([]byte(`<html><body><a href="download.php?id=1&passkey=abc">dl</a></body></html>`))
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	_, _, err := d.DownloadAndHash(context.Background(), "1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "compute torrent hash")
+}
+
 func TestNexusPHPDriver_FetchSeedingStatus_ExecuteError(t *testing.T) {
 	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "http://127.0.0.1:1", Cookie: "c=1"})
 	_, _, err := d.FetchSeedingStatus(context.Background(), "42")
@@ -1223,9 +1349,17 @@ func TestParseDiscountFromElement_Branches(t *testing.T) {
 	assert.Equal(t, DiscountPercent50, parseDiscountFromElement(discountElem(t, "pro_50pctdown", "", ""), nil))
 	assert.Equal(t, DiscountPercent30, parseDiscountFromElement(discountElem(t, "pro_30pctdown", "", ""), nil))
 	assert.Equal(t, DiscountPercent70, parseDiscountFromElement(discountElem(t, "pro_70pctdown", "", ""), nil))
+	assert.Equal(t, DiscountPercent75, parseDiscountFromElement(discountElem(t, "pro_75pctdown", "", ""), nil))
+	assert.Equal(t, DiscountPercent25, parseDiscountFromElement(discountElem(t, "pro_25pctdown", "", ""), nil))
+	assert.Equal(t, DiscountPercent75, parseDiscountFromElement(discountElem(t, "", "", "75%"), nil))
+	assert.Equal(t, DiscountPercent25, parseDiscountFromElement(discountElem(t, "", "", "25%"), nil))
 	assert.Equal(t, Discount2xUp, parseDiscountFromElement(discountElem(t, "pro_2up", "", ""), nil))
 	assert.Equal(t, DiscountNone, parseDiscountFromElement(discountElem(t, "normal", "", ""), nil))
 
+	// 25pct must not shadow the more specific keywords checked before it
+	assert.Equal(t, Discount2xFree, parseDiscountFromElement(discountElem(t, "pro_free2up", "", "25%"), nil))
+	assert.Equal(t, DiscountPercent50, parseDiscountFromElement(discountElem(t, "pro_50pctdown", "", "25%"), nil))
+
 	// custom mapping wins
 	custom := map[string]DiscountLevel{"specialtag": Discount2x50}
 	assert.Equal(t, Discount2x50, parseDiscountFromElement(discountElem(t, "specialtag", "", ""), custom))
@@ -1271,6 +1405,52 @@ func TestNexusPHPDriver_ParseDetail_NilDoc(t *testing.T) {
 	assert.ErrorIs(t, err, ErrParseError)
 }
 
+func TestNexusPHPDriver_ParseDetail_PosterURL(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://x.com"})
+	html := `<html><body>
+		<div id="kdescr"><img src="data:image/gif;base64,R0lGOD"><img src="attachments/202601/poster.jpg"></div>
+	</body></html>`
+	doc, _ := goquery.NewDocumentFromReader(strings.NewReader(html))
+	detail, err := d.ParseDetail(NexusPHPResponse{Document: doc})
+	require.NoError(t, err)
+	assert.Equal(t, "https://x.com/attachments/202601/poster.jpg", detail.PosterURL)
+}
+
+func TestNexusPHPDriver_ParseDetail_PosterURL_LazyAndAbsolute(t *testing.T) {
+	sel := DefaultNexusPHPSelectors()
+	sel.DetailPoster = "img.cover"
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://x.com", Selectors: &sel})
+
+	doc, _ := goquery.NewDocumentFromReader(strings.NewReader(
+		`<html><body><img class="cover" data-src="/pic/lazy.png" src="/pic/loading.gif"></body></html>`))
+	detail, err := d.ParseDetail(NexusPHPResponse{Document: doc})
+	require.NoError(t, err)
+	assert.Equal(t, "https://x.com/pic/lazy.png", detail.PosterURL)
+
+	doc, _ = goquery.NewDocumentFromReader(strings.NewReader(
+		`<html><body><img class="cover" src="https://img.example.com/p.jpg"></body></html>`))
+	detail, err = d.ParseDetail(NexusPHPResponse{Document: doc})
+	require.NoError(t, err)
+	assert.Equal(t, "https://img.example.com/p.jpg", detail.PosterURL)
+}
+
+func TestNexusPHPDriver_GetTorrentDetail_PosterURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`<html><body>
+			<input name="torrent_name" value="Poster.Test.2160p">
+			<input name="detail_torrent_id" value="77">
+			<img id="poster" src="/posters/77.jpg">
+		</body></html>`))
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	item, err := d.GetTorrentDetail(context.Background(), "77", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "77", item.ID)
+	assert.Equal(t, server.URL+"/posters/77.jpg", item.PosterURL)
+}
+
 // ---------------------------------------------------------------------------
 // base_site.go — Download error paths, GetUserInfo error
 // ---------------------------------------------------------------------------
@@ -1838,3 +2018,31 @@ func TestNexusPHPDriver_ParseDownload(t *testing.T) {
 	_, err = d.ParseDownload(NexusPHPResponse{})
 	assert.ErrorIs(t, err, ErrParseError)
 }
+
+func TestNexusPHPDriver_ParseSearch_QuarterDiscounts(t *testing.T) {
+	html := `<html><body><table class="torrents"><tbody>
+		<tr><td>Type</td><td>Name</td></tr>
+		<tr><td></td><td><a href="details.php?id=1">A</a><img class="pro_75pctdown" src="pic/trans.gif" /></td></tr>
+		<tr><td></td><td><a href="details.php?id=2">B</a><img class="pro_25pctdown" src="pic/trans.gif" /></td></tr>
+		<tr><td></td><td><a href="details.php?id=3">C</a><img class="pro_quarter" src="pic/trans.gif" /></td></tr>
+	</tbody></table></body></html>`
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, html)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	assert.Equal(t, DiscountPercent75, items[0].DiscountLevel)
+	assert.Equal(t, DiscountPercent25, items[1].DiscountLevel)
+	assert.Equal(t, DiscountNone, items[2].DiscountLevel)
+
+	// A site-specific class reaches the new levels through DiscountMapping
+	sel := DefaultNexusPHPSelectors()
+	sel.DiscountIcon = "img[class^='pro_']"
+	sel.DiscountMapping = map[string]DiscountLevel{"pro_quarter": DiscountPercent25}
+	d = NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+	items, err = d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, html)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	assert.Equal(t, DiscountPercent75, items[0].DiscountLevel)
+	assert.Equal(t, DiscountPercent25, items[2].DiscountLevel)
+}
@@ -0,0 +1,45 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const excludeListingHTML = `<html><body>
+<table class="torrents"><tbody>
+	<tr><td>Type</td><td>Name</td></tr>
+	<tr><td></td><td><a href="details.php?id=1">Movie.2025.1080p.BluRay</a></td></tr>
+	<tr><td></td><td><a href="details.php?id=2">Movie.2025.1080p.CAM.x264</a></td></tr>
+	<tr><td></td><td><a href="details.php?id=3">Show.S01.2160p.WEB-DL-BadGroup</a></td></tr>
+	<tr><td></td><td><a href="details.php?id=4">Show.S02.2160p.WEB-DL</a></td></tr>
+</tbody></table>
+</body></html>`
+
+func TestNexusPHPDriver_ParseSearch_GlobalExcludeKeywords(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{
+		BaseURL:               "https://example.com",
+		GlobalExcludeKeywords: []string{"cam", " badgroup ", ""},
+	})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, excludeListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "1", items[0].ID)
+	assert.Equal(t, "4", items[1].ID)
+
+	var streamed []string
+	for item := range d.ParseSearchStream(NexusPHPResponse{Document: mustDoc(t, excludeListingHTML)}) {
+		streamed = append(streamed, item.ID)
+	}
+	assert.Equal(t, []string{"1", "4"}, streamed)
+}
+
+func TestNexusPHPDriver_ParseSearch_NoExcludeKeywords(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, excludeListingHTML)})
+	require.NoError(t, err)
+	assert.Len(t, items, 4)
+}
@@ -0,0 +1,63 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const exclusiveListingHTML = `<html><body>
+<table class="torrents"><tbody>
+	<tr><td>Type</td><td>Name</td></tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=1">Exclusive Movie 2025</a><span class="tags tjz">禁转</span></td>
+	</tr>
+	<tr>
+		<td><img alt="TV" /></td>
+		<td><a href="details.php?id=2">Internal Show S01</a><img src="pic/internal.png" title="内部资源" /></td>
+	</tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=3">Official Movie 2025</a><span class="tags tgf">官方</span></td>
+	</tr>
+</tbody></table>
+</body></html>`
+
+func TestNexusPHPDriver_ParseSearch_ExclusiveBadge(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, exclusiveListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	assert.True(t, items[0].IsExclusive, "禁转 tag marks an exclusive release")
+	assert.False(t, items[0].IsOfficial)
+	assert.True(t, items[1].IsExclusive, "内部 badge image marks an internal release")
+	assert.False(t, items[2].IsExclusive, "official tag is not exclusive")
+	assert.True(t, items[2].IsOfficial)
+}
+
+func TestNexusPHPDriver_ParseSearch_ExclusiveCustomSelector(t *testing.T) {
+	sel := DefaultNexusPHPSelectors()
+	mergeSelectors(&sel, &SiteSelectors{ExclusiveBadge: "span.tgf", ExclusiveKeywords: []string{"官方"}})
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, exclusiveListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	assert.False(t, items[0].IsExclusive)
+	assert.False(t, items[1].IsExclusive)
+	assert.True(t, items[2].IsExclusive)
+}
+
+func TestHasBadge(t *testing.T) {
+	row := mustDoc(t, `<div><span class="tags">禁转</span><img alt="x" /></div>`).Selection
+
+	assert.True(t, hasBadge(row, "span.tags", []string{"禁转"}))
+	assert.False(t, hasBadge(row, "span.tags", []string{"官方"}))
+	assert.True(t, hasBadge(row, "img", nil), "any match counts without keywords")
+	assert.False(t, hasBadge(row, "", []string{"禁转"}), "empty selector disables detection")
+}
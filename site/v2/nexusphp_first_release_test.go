@@ -0,0 +1,54 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const firstReleaseListingHTML = `<html><body>
+<table class="torrents"><tbody>
+	<tr><td>Type</td><td>Name</td></tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=1">Movie 2025 1080p</a><span class="tags tsf">本站首发</span></td>
+	</tr>
+	<tr>
+		<td><img alt="TV" /></td>
+		<td><a href="details.php?id=2">Show S01 2160p</a><img src="pic/first.png" title="首发" /></td>
+	</tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=3">首发 in the title only</a><span class="tags tgf">官方</span></td>
+	</tr>
+</tbody></table>
+</body></html>`
+
+func TestNexusPHPDriver_ParseSearch_FirstReleaseBadge(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, firstReleaseListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	assert.True(t, items[0].IsFirstRelease, "本站首发 tag marks a first release")
+	assert.False(t, items[0].IsOfficial)
+	assert.True(t, items[1].IsFirstRelease, "首发 badge image marks a first release")
+	assert.False(t, items[2].IsFirstRelease, "a title mentioning 首发 is not a badge")
+	assert.True(t, items[2].IsOfficial)
+}
+
+func TestNexusPHPDriver_ParseSearch_FirstReleaseCustomKeywords(t *testing.T) {
+	sel := DefaultNexusPHPSelectors()
+	mergeSelectors(&sel, &SiteSelectors{FirstReleaseBadge: "span.tags", FirstReleaseKeywords: []string{"官方"}})
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, firstReleaseListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	assert.False(t, items[0].IsFirstRelease)
+	assert.False(t, items[1].IsFirstRelease)
+	assert.True(t, items[2].IsFirstRelease)
+}
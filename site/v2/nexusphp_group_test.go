@@ -0,0 +1,64 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const groupedListingHTML = `<html><body>
+<table class="torrents"><tbody>
+	<tr><td>Type</td><td>Name</td></tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=1">Movie 2025 1080p</a> <a class="group" href="torrents.php?groupid=88">合集</a></td>
+	</tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=2">Movie 2025 2160p</a> <a class="group" href="torrents.php?groupid=88">合集</a></td>
+	</tr>
+	<tr>
+		<td><img alt="TV" /></td>
+		<td><a href="details.php?id=3">Show S01</a> <a class="group" href="/collection/91/">合集</a></td>
+	</tr>
+	<tr>
+		<td><img alt="TV" /></td>
+		<td><a href="details.php?id=4">Standalone</a></td>
+	</tr>
+</tbody></table>
+</body></html>`
+
+func TestNexusPHPDriver_ParseSearch_GroupID(t *testing.T) {
+	sel := DefaultNexusPHPSelectors()
+	mergeSelectors(&sel, &SiteSelectors{GroupLink: "a.group"})
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, groupedListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 4)
+
+	assert.Equal(t, "88", items[0].GroupID)
+	assert.Equal(t, items[0].GroupID, items[1].GroupID, "editions of one group share its ID")
+	assert.Equal(t, "91", items[2].GroupID)
+	assert.Empty(t, items[3].GroupID, "rows without a group link have no group")
+}
+
+func TestNexusPHPDriver_ParseSearch_GroupIDDisabledByDefault(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, groupedListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 4)
+	for _, item := range items {
+		assert.Empty(t, item.GroupID)
+	}
+}
+
+func TestExtractGroupID(t *testing.T) {
+	assert.Equal(t, "12", extractGroupID("torrents.php?groupid=12"))
+	assert.Equal(t, "34", extractGroupID("collection.php?id=34&page=2"))
+	assert.Equal(t, "56", extractGroupID("/collection/56"))
+	assert.Equal(t, "56", extractGroupID("/collection/56/?tab=all"))
+	assert.Empty(t, extractGroupID("collection.php"))
+}
@@ -0,0 +1,59 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const hrBadgeListingHTML = `<html><body>
+<table class="torrents"><tbody>
+	<tr><td>Type</td><td>Name</td></tr>
+	<tr><td></td><td><a href="details.php?id=1">Badged</a> <span class="tag tag-hr">H&amp;R</span></td></tr>
+	<tr><td></td><td><a href="details.php?id=2">Icon</a> <img class="hitandrun" src="pic/trans.gif" /></td></tr>
+	<tr><td></td><td><a href="details.php?id=3">Plain</a> <span class="tag">官方</span></td></tr>
+</tbody></table>
+</body></html>`
+
+func TestNexusPHPDriver_ParseSearch_HRTextBadge(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	d.SetSiteDefinition(&SiteDefinition{ID: "hrtext", HRKeywords: []string{"h&r"}})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, hrBadgeListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	assert.True(t, items[0].HasHR)
+	assert.True(t, items[1].HasHR)
+	assert.False(t, items[2].HasHR)
+}
+
+func TestNexusPHPDriver_ParseSearch_HRTextBadgeNeedsKeywords(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, hrBadgeListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	assert.False(t, items[0].HasHR)
+	assert.True(t, items[1].HasHR)
+}
+
+func TestNexusPHPDriver_ParseDetail_HasHR(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	d.SetSiteDefinition(&SiteDefinition{ID: "hrtext", HRKeywords: []string{"H&R"}})
+
+	badged := `<html><body><a href="myhr.php">H&amp;R 考核</a>
+		<h1 id="top">Movie.2025.1080p <span class="tag-hr">H&amp;R</span></h1>
+		<a href="download.php?id=1&amp;passkey=x">下载</a></body></html>`
+	detail, err := d.ParseDetail(NexusPHPResponse{Document: mustDoc(t, badged)})
+	require.NoError(t, err)
+	assert.True(t, detail.HasHR)
+
+	// The menu link alone doesn't flag the torrent
+	plain := `<html><body><a href="myhr.php">H&amp;R 考核</a>
+		<h1 id="top">Movie.2025.1080p</h1>
+		<a href="download.php?id=1&amp;passkey=x">下载</a></body></html>`
+	detail, err = d.ParseDetail(NexusPHPResponse{Document: mustDoc(t, plain)})
+	require.NoError(t, err)
+	assert.False(t, detail.HasHR)
+}
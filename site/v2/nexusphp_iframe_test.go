@@ -0,0 +1,92 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// iframeOuterHTML is a skin that renders the torrent listing inside an iframe
+const iframeOuterHTML = `<html><body>
+<div id="main"><iframe id="torrentframe" src="torrents_frame.php?inframe=1"></iframe></div>
+</body></html>`
+
+// newIframeServer serves the outer search page and the framed listing fragment
+func newIframeServer(t *testing.T, outer string) (*httptest.Server, *[]string) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.RequestURI())
+		switch r.URL.Path {
+		case "/torrents.php":
+			_, _ = w.Write([]byte(outer))
+		case "/torrents_frame.php":
+			_, _ = w.Write([]byte(v18ListingHTML))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &paths
+}
+
+func iframeSelectors() *SiteSelectors {
+	sel := DefaultNexusPHPSelectors()
+	sel.SearchIframe = "iframe#torrentframe"
+	return &sel
+}
+
+func searchViaDriver(t *testing.T, d *NexusPHPDriver) []TorrentItem {
+	t.Helper()
+	req, err := d.PrepareSearch(SearchQuery{Keyword: "layout"})
+	require.NoError(t, err)
+	res, err := d.Execute(context.Background(), req)
+	require.NoError(t, err)
+	items, err := d.ParseSearch(res)
+	require.NoError(t, err)
+	return items
+}
+
+func TestNexusPHPDriver_Search_FollowsIframe(t *testing.T) {
+	server, paths := newIframeServer(t, iframeOuterHTML)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", Selectors: iframeSelectors()})
+
+	items := searchViaDriver(t, d)
+	require.Len(t, items, 2)
+	assert.Equal(t, "101", items[0].ID)
+	assert.Equal(t, "New Layout Show S01", items[1].Title)
+	assert.Equal(t, "/torrents_frame.php?inframe=1", (*paths)[len(*paths)-1])
+}
+
+func TestNexusPHPDriver_Search_IframeNotConfigured(t *testing.T) {
+	server, paths := newIframeServer(t, iframeOuterHTML)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+
+	assert.Empty(t, searchViaDriver(t, d))
+	assert.Len(t, *paths, 1)
+}
+
+func TestNexusPHPDriver_Search_InlineRowsSkipIframe(t *testing.T) {
+	server, paths := newIframeServer(t, `<html><body>`+v18ListingHTML+`<iframe id="torrentframe" src="torrents_frame.php"></iframe></body></html>`)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", Selectors: iframeSelectors()})
+
+	assert.Len(t, searchViaDriver(t, d), 2)
+	assert.Len(t, *paths, 1)
+}
+
+func TestNexusPHPDriver_Search_CrossHostIframeIgnored(t *testing.T) {
+	server, paths := newIframeServer(t, `<html><body><iframe id="torrentframe" src="https://other.example.com/torrents_frame.php"></iframe></body></html>`)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", Selectors: iframeSelectors()})
+
+	assert.Empty(t, searchViaDriver(t, d))
+	assert.Len(t, *paths, 1)
+}
+
+func TestMergeSelectors_SearchIframe(t *testing.T) {
+	dst := DefaultNexusPHPSelectors()
+	mergeSelectors(&dst, &SiteSelectors{SearchIframe: "iframe.listing"})
+	assert.Equal(t, "iframe.listing", dst.SearchIframe)
+}
@@ -0,0 +1,70 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNexusPHPDriver_PrepareSearch_IMDb(t *testing.T) {
+	tests := []struct {
+		name      string
+		search    *SearchConfig
+		query     SearchQuery
+		wantQuery string
+	}{
+		{
+			name:      "default search area",
+			query:     SearchQuery{IMDbID: "tt1234567"},
+			wantQuery: "search=tt1234567&search_area=4",
+		},
+		{
+			name:      "numeric id gets tt prefix",
+			query:     SearchQuery{IMDbID: "1234567"},
+			wantQuery: "search=tt1234567&search_area=4",
+		},
+		{
+			name:      "imdb replaces keyword",
+			query:     SearchQuery{Keyword: "Some Movie", IMDbID: "TT0111161", Page: 2},
+			wantQuery: "page=1&search=tt0111161&search_area=4",
+		},
+		{
+			name:      "dedicated param without search area",
+			search:    &SearchConfig{IMDbParam: "imdb"},
+			query:     SearchQuery{Keyword: "Some Movie", IMDbID: "tt1234567"},
+			wantQuery: "imdb=tt1234567&search=Some+Movie",
+		},
+		{
+			name:      "custom search area and numeric id",
+			search:    &SearchConfig{IMDbSearchArea: "5", IMDbNumericOnly: true},
+			query:     SearchQuery{IMDbID: "tt1234567"},
+			wantQuery: "search=1234567&search_area=5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+			def := makeMinimalNexusPHP("imdbsite")
+			def.Search = tt.search
+			d.SetSiteDefinition(def)
+
+			req, err := d.PrepareSearch(tt.query)
+			require.NoError(t, err)
+			assert.Equal(t, "/torrents.php", req.Path)
+			assert.Equal(t, tt.wantQuery, req.Params.Encode())
+		})
+	}
+}
+
+func TestSearchQuery_ValidateIMDbID(t *testing.T) {
+	for _, id := range []string{"", "tt1234567", "1234567", " TT0111161 "} {
+		q := SearchQuery{IMDbID: id}
+		assert.NoError(t, q.Validate(), id)
+	}
+	for _, id := range []string{"tt", "imdb:123", "tt12a"} {
+		q := SearchQuery{IMDbID: id}
+		assert.Error(t, q.Validate(), id)
+	}
+}
@@ -0,0 +1,69 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNexusPHPDriver_ParseDetail_InfoHashFormats(t *testing.T) {
+	const want = "303a850dedc19e60bd7cc814f60e0e28d7f2c202"
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "Hash码 label and value in one cell",
+			body: `<table><tr><td class="no_border_wide"><b>Hash码:</b>&nbsp;303a850dedc19e60bd7cc814f60e0e28d7f2c202</td></tr></table>`,
+			want: want,
+		},
+		{
+			name: "Info Hash label in separate cell",
+			body: `<table><tr><td>Info Hash</td><td>303A850DEDC19E60BD7CC814F60E0E28D7F2C202</td></tr></table>`,
+			want: "303A850DEDC19E60BD7CC814F60E0E28D7F2C202",
+		},
+		{
+			name: "Infohash label with full-width colon",
+			body: `<table><tr><td>Infohash：303a850dedc19e60bd7cc814f60e0e28d7f2c202 (v1)</td></tr></table>`,
+			want: want,
+		},
+		{
+			name: "种子特征码 with base32 value",
+			body: `<table><tr><td>种子特征码</td><td>GA5IKDPNYGPGBPL4ZAKPMDQOFDL7FQQC</td></tr></table>`,
+			want: want,
+		},
+		{
+			name: "hash wrapped in code",
+			body: `<div>校验值 <code>303a850dedc19e60bd7cc814f60e0e28d7f2c202</code></div>`,
+			want: want,
+		},
+		{
+			name: "base32 in kbd",
+			body: `<p><kbd>ga5ikdpnygpgbpl4zakpmdqofdl7fqqc</kbd></p>`,
+			want: want,
+		},
+		{
+			name: "40 chars that are not hex",
+			body: `<table><tr><td>Info Hash</td><td>zzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzz</td></tr></table><code>this-is-not-a-hash-at-all-but-40-chars!!</code>`,
+			want: "",
+		},
+		{
+			name: "32 chars outside the base32 alphabet",
+			body: `<table><tr><td>Info Hash</td><td>d41d8cd98f00b204e9800998ecf8427e</td></tr></table>`,
+			want: "",
+		},
+	}
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := mustDoc(t, `<html><body><h1 id="top">T</h1>`+tt.body+`</body></html>`)
+			detail, err := d.ParseDetail(NexusPHPResponse{Document: doc})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, detail.InfoHash)
+		})
+	}
+}
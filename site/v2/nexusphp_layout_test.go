@@ -0,0 +1,134 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// v18ListingHTML mimics the NexusPHP v1.8+ listing: table.torrentsNexus with the header in <thead>
+const v18ListingHTML = `
+<html>
+<body>
+<div class="torrentsNexus">
+<table class="torrentsNexus">
+	<thead>
+		<tr><th>Type</th><th>Name</th><th></th><th>Added</th><th>Size</th><th>S</th><th>L</th><th>C</th></tr>
+	</thead>
+	<tbody>
+		<tr>
+			<td><img alt="Movies" /></td>
+			<td><a href="details.php?id=101">New Layout Movie 2025</a></td>
+			<td></td>
+			<td><span title="2025-06-01 12:00:00">1天</span></td>
+			<td>4.2 GB</td>
+			<td>35</td>
+			<td>2</td>
+			<td>120</td>
+		</tr>
+		<tr>
+			<td><img alt="TV" /></td>
+			<td><a href="details.php?id=102">New Layout Show S01</a><img class="pro_free" src="pic/trans.gif" /></td>
+			<td></td>
+			<td><span title="2025-06-02 08:30:00">2天</span></td>
+			<td>800 MB</td>
+			<td>8</td>
+			<td>1</td>
+			<td>40</td>
+		</tr>
+	</tbody>
+</table>
+</div>
+</body>
+</html>
+`
+
+func TestNexusPHPDriver_ParseSearch_TorrentsNexusLayout(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, v18ListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	assert.Equal(t, "101", items[0].ID)
+	assert.Equal(t, "New Layout Movie 2025", items[0].Title)
+	assert.Equal(t, 35, items[0].Seeders)
+	assert.Equal(t, 120, items[0].Snatched)
+	assert.Equal(t, "Movies", items[0].Category)
+
+	assert.Equal(t, "102", items[1].ID)
+	assert.Equal(t, DiscountFree, items[1].DiscountLevel)
+	assert.Equal(t, int64(800*1024*1024), items[1].SizeBytes)
+}
+
+func TestNexusPHPDriver_ParseSearch_PrimaryLayoutWinsOverAlternate(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	html := `<html><body>
+	<table class="torrents"><tbody>
+		<tr><td>Header</td></tr>
+		<tr><td></td><td><a href="details.php?id=1">Primary</a></td></tr>
+	</tbody></table>
+	<table class="torrentsNexus"><tbody>
+		<tr><td></td><td><a href="details.php?id=2">Alternate</a></td></tr>
+	</tbody></table>
+	</body></html>`
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, html)})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Primary", items[0].Title)
+}
+
+func TestNexusPHPDriver_ParseSearch_NoAlternateConfigured(t *testing.T) {
+	sel := DefaultNexusPHPSelectors()
+	sel.AlternateTableRows = nil
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, v18ListingHTML)})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
+
+// tbodylessDoc parses html and strips the <tbody> elements the HTML parser
+// synthesizes, mimicking documents assembled from nodes or fragments
+func tbodylessDoc(t *testing.T, html string) *goquery.Document {
+	t.Helper()
+	doc := mustDoc(t, html)
+	doc.Find("tbody > tr").Unwrap()
+	require.Zero(t, doc.Find("tbody").Length())
+	return doc
+}
+
+const tbodylessListingHTML = `<html><body>
+<table class="torrents">
+	<tr><td>Type</td><td>Name</td></tr>
+	<tr><td><img alt="Movies" /></td><td><a href="details.php?id=201">Bare Table Movie</a></td><td></td><td></td><td>2 GB</td><td>12</td><td>3</td><td>50</td></tr>
+	<tr><td><img alt="TV" /></td><td><a href="details.php?id=202">Bare Table Show</a></td><td></td><td></td><td>700 MB</td><td>4</td><td>0</td><td>9</td></tr>
+</table>
+</body></html>`
+
+func TestNexusPHPDriver_ParseSearch_TableWithoutTbody(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: tbodylessDoc(t, tbodylessListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "201", items[0].ID)
+	assert.Equal(t, 12, items[0].Seeders)
+	assert.Equal(t, "Bare Table Show", items[1].Title)
+	assert.Equal(t, int64(700*1024*1024), items[1].SizeBytes)
+}
+
+func TestNexusPHPDriver_ParseSearch_TorrentsNexusWithoutTbody(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+
+	doc := tbodylessDoc(t, v18ListingHTML)
+	doc.Find("thead").Remove()
+	items, err := d.ParseSearch(NexusPHPResponse{Document: doc})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "101", items[0].ID)
+	assert.Equal(t, "102", items[1].ID)
+}
@@ -0,0 +1,130 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const leechingListHTML = `<table>
+<tr><td>类型</td><td>标题</td><td>大小</td></tr>
+<tr><td>Movie</td><td>Leech A</td><td>1.00 GB</td></tr>
+<tr><td>TV</td><td>Leech B</td><td>512.00 MB</td></tr>
+</table>`
+
+const seedingListHTML = `<table>
+<tr><td>类型</td><td>标题</td><td>大小</td></tr>
+<tr><td>Movie</td><td>Seed A</td><td>2.00 GB</td></tr>
+</table>`
+
+func leechingDefinition() *SiteDefinition {
+	return &SiteDefinition{
+		ID:     "leechdef",
+		Name:   "LeechDef",
+		Schema: SchemaNexusPHP,
+		UserInfo: &UserInfoConfig{
+			Process: []UserInfoProcess{
+				{
+					RequestConfig: RequestConfig{URL: "/index.php", ResponseType: "document"},
+					Fields:        []string{"id", "name"},
+				},
+			},
+			Selectors: map[string]FieldSelector{
+				"id":   {Selector: []string{"a[href*='userdetails.php']"}, Attr: "href", Filters: []Filter{{Name: "querystring", Args: []any{"id"}}}},
+				"name": {Selector: []string{"a[href*='userdetails.php']"}},
+			},
+		},
+	}
+}
+
+func newLeechingServer(t *testing.T, leechingBody string, barrier *sync.WaitGroup) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/getusertorrentlistajax.php" {
+			_, _ = w.Write([]byte(`<html><body><a href="userdetails.php?id=7">Me</a></body></html>`))
+			return
+		}
+		if barrier != nil {
+			// Both list requests must be in flight at once to get past here
+			barrier.Done()
+			done := make(chan struct{})
+			go func() { barrier.Wait(); close(done) }()
+			select {
+			case <-done:
+			case <-time.After(2 * time.Second):
+				t.Error("seeding and leeching requests did not overlap")
+			}
+		}
+		switch r.URL.Query().Get("type") {
+		case "leeching":
+			_, _ = w.Write([]byte(leechingBody))
+		default:
+			_, _ = w.Write([]byte(seedingListHTML))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNexusPHPDriver_FetchLeechingStatus(t *testing.T) {
+	server := newLeechingServer(t, leechingListHTML, nil)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+
+	count, size, err := d.FetchLeechingStatus(context.Background(), "7")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, int64(1024*1024*1024+512*1024*1024), size)
+}
+
+func TestNexusPHPDriver_FetchLeechingStatus_Empty(t *testing.T) {
+	for name, body := range map[string]string{
+		"header only": `<table><tr><td>类型</td><td>标题</td><td>大小</td></tr></table>`,
+		"no table":    `<b>没有记录</b>`,
+		"blank":       ``,
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := newLeechingServer(t, body, nil)
+			d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+
+			count, size, err := d.FetchLeechingStatus(context.Background(), "7")
+			require.NoError(t, err)
+			assert.Zero(t, count)
+			assert.Zero(t, size)
+		})
+	}
+}
+
+func TestNexusPHPDriver_GetUserInfoWithDefinition_LeechingStatus(t *testing.T) {
+	var barrier sync.WaitGroup
+	barrier.Add(2)
+	server := newLeechingServer(t, leechingListHTML, &barrier)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	d.SetSiteDefinition(leechingDefinition())
+
+	info, err := d.GetUserInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, info.Leeching)
+	assert.Equal(t, 2, info.LeecherCount)
+	assert.Equal(t, int64(1024*1024*1024+512*1024*1024), info.LeecherSize)
+	assert.Equal(t, int64(2*1024*1024*1024), info.SeederSize)
+}
+
+func TestNexusPHPDriver_GetUserInfoWithDefinition_EmptyLeechingList(t *testing.T) {
+	server := newLeechingServer(t, `<table><tr><td>类型</td><td>标题</td><td>大小</td></tr></table>`, nil)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	d.SetSiteDefinition(leechingDefinition())
+
+	info, err := d.GetUserInfo(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, info.Leeching)
+	assert.Zero(t, info.LeecherSize)
+	assert.Equal(t, int64(2*1024*1024*1024), info.SeederSize)
+}
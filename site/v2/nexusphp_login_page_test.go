@@ -0,0 +1,58 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNexusPHPDriver_DetectLoginPage(t *testing.T) {
+	tests := []struct {
+		name     string
+		def      *SiteDefinition
+		html     string
+		expected bool
+	}{
+		{"built-in heuristic without definition", nil, `<form action="takelogin.php"></form>`, true},
+		{"normal page without definition", nil, `<div id="custom-login">x</div>`, false},
+		{"definition selector match", &SiteDefinition{LoginPageSelectors: []string{"#custom-login"}}, `<div id="custom-login">x</div>`, true},
+		{"definition selector miss", &SiteDefinition{LoginPageSelectors: []string{"#custom-login"}}, `<div>hello</div>`, false},
+		{"keyword in script redirect", &SiteDefinition{LoginPageKeywords: []string{"signin.php"}}, `<script>location.href="/SIGNIN.php"</script>`, true},
+		{"keyword in title", &SiteDefinition{LoginPageKeywords: []string{"请先登入"}}, `<title>请先登入 - Site</title>`, true},
+		{"keyword only in body text", &SiteDefinition{LoginPageKeywords: []string{"signin.php"}}, `<a href="signin.php">signin.php</a>`, false},
+		{"built-ins still apply with definition", &SiteDefinition{LoginPageSelectors: []string{"#x"}}, `<div class="login-form"></div>`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+			if tt.def != nil {
+				d.SetSiteDefinition(tt.def)
+			}
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, d.detectLoginPage(doc))
+		})
+	}
+}
+
+func TestNexusPHPDriver_Execute_DefinitionLoginSelector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><body><div class="sso-box">Sign in</div></body></html>`))
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	_, err := d.Execute(context.Background(), NexusPHPRequest{Path: "/index.php"})
+	require.NoError(t, err)
+
+	d.SetSiteDefinition(&SiteDefinition{ID: "sso", LoginPageSelectors: []string{".sso-box"}})
+	_, err = d.Execute(context.Background(), NexusPHPRequest{Path: "/index.php"})
+	assert.ErrorIs(t, err, ErrSessionExpired)
+}
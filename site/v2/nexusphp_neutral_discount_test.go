@@ -0,0 +1,78 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDiscountFromElement_Neutral(t *testing.T) {
+	tests := []struct {
+		name    string
+		html    string
+		mapping map[string]DiscountLevel
+		want    DiscountLevel
+	}{
+		{
+			name: "pro_custom icon with upload not counted",
+			html: `<img class="pro_custom" src="pic/trans.gif" alt="Free" title="下载免费，上传不计" />`,
+			want: DiscountNeutral,
+		},
+		{
+			name: "neutral class",
+			html: `<img class="pro_neutral" src="pic/trans.gif" />`,
+			want: DiscountNeutral,
+		},
+		{
+			name: "中性 alt",
+			html: `<img class="pro_custom" src="pic/trans.gif" alt="中性" />`,
+			want: DiscountNeutral,
+		},
+		{
+			name: "plain pro_custom stays unrecognised",
+			html: `<img class="pro_custom" src="pic/trans.gif" alt="Custom" />`,
+			want: DiscountNone,
+		},
+		{
+			name: "免费 alone is plain free",
+			html: `<img class="pro_free" src="pic/trans.gif" alt="免费" />`,
+			want: DiscountFree,
+		},
+		{
+			name:    "custom mapping wins",
+			html:    `<img class="pro_custom" src="pic/trans.gif" title="上传不计" />`,
+			mapping: map[string]DiscountLevel{"pro_custom": DiscountFree},
+			want:    DiscountFree,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			elem := mustDoc(t, `<html><body>`+tt.html+`</body></html>`).Find("img")
+			assert.Equal(t, tt.want, parseDiscountFromElement(elem, tt.mapping))
+		})
+	}
+}
+
+func TestNexusPHPDriver_ParseSearch_NeutralIcon(t *testing.T) {
+	html := `<html><body><table class="torrents"><tbody>
+		<tr><td>Type</td><td>Name</td></tr>
+		<tr><td></td><td><a href="details.php?id=1">Neutral</a><img class="pro_custom" src="pic/trans.gif" title="免：上传不计" /></td></tr>
+	</tbody></table></body></html>`
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, html)})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, DiscountNeutral, items[0].DiscountLevel)
+	assert.True(t, items[0].IsFree(), "neutral download is not counted")
+}
+
+func TestDiscountNeutral_Ratios(t *testing.T) {
+	assert.Zero(t, DiscountNeutral.GetDownloadRatio())
+	assert.Zero(t, DiscountNeutral.GetUploadRatio())
+	assert.Equal(t, DiscountNeutral, CombineDiscountLevels(DiscountNeutral))
+	assert.True(t, IsBetterDiscount(DiscountFree, DiscountNeutral))
+	assert.True(t, IsBetterDiscount(DiscountNeutral, DiscountPercent50))
+}
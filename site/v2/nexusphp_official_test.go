@@ -0,0 +1,89 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const officialListingHTML = `<html><body>
+<table class="torrents"><tbody>
+	<tr><td>Type</td><td>Name</td></tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=1">Official Movie 2025</a><span class="tags tgf">官方</span></td>
+	</tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=2">Original Doc 2025</a><img src="pic/yc.png" alt="原创" /></td>
+	</tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=3">Reseed Movie 2025</a><span class="tags tzz">中字</span></td>
+	</tr>
+</tbody></table>
+</body></html>`
+
+func TestNexusPHPDriver_ParseSearch_OfficialBadge(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, officialListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	assert.True(t, items[0].IsOfficial, "tgf tag marks an official upload")
+	assert.True(t, items[1].IsOfficial, "原创 badge image marks an original upload")
+	assert.False(t, items[2].IsOfficial, "other tags are not official")
+}
+
+func TestNexusPHPDriver_ParseSearch_OfficialCustomKeywords(t *testing.T) {
+	sel := DefaultNexusPHPSelectors()
+	mergeSelectors(&sel, &SiteSelectors{OfficialBadge: "span.tags", OfficialKeywords: []string{"中字"}})
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, officialListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	assert.False(t, items[0].IsOfficial)
+	assert.False(t, items[1].IsOfficial)
+	assert.True(t, items[2].IsOfficial)
+}
+
+func TestNexusPHPDriver_ParseSearch_OfficialDisabled(t *testing.T) {
+	sel := DefaultNexusPHPSelectors()
+	sel.OfficialBadge = ""
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, officialListingHTML)})
+	require.NoError(t, err)
+	for _, item := range items {
+		assert.False(t, item.IsOfficial)
+	}
+}
+
+func TestNexusPHPDriver_GetTorrentDetail_Official(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tag := `<span class="tags tzz">中字</span>`
+		if r.URL.Query().Get("id") == "1" {
+			tag = `<span class="tags tgf">官方</span>`
+		}
+		_, _ = w.Write([]byte(`<html><body><h1 id="top">Movie 2025 ` + tag + `</h1>
+			<table><tr><td class="rowhead">大小</td><td>1.5 GB</td></tr></table></body></html>`))
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+
+	item, err := d.GetTorrentDetail(context.Background(), "1", "", "")
+	require.NoError(t, err)
+	assert.True(t, item.IsOfficial)
+
+	item, err = d.GetTorrentDetail(context.Background(), "2", "", "")
+	require.NoError(t, err)
+	assert.False(t, item.IsOfficial)
+}
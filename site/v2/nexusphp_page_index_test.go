@@ -0,0 +1,49 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNexusPHPDriver_PrepareSearch_PageIndexing(t *testing.T) {
+	zero, one := true, false
+	tests := []struct {
+		name     string
+		def      *SiteDefinition
+		page     int
+		expected string
+	}{
+		{"no definition page 1", nil, 1, "0"},
+		{"no definition page 3", nil, 3, "2"},
+		{"default page 1", &SiteDefinition{ID: "def"}, 1, "0"},
+		{"default page 3", &SiteDefinition{ID: "def"}, 3, "2"},
+		{"zero-indexed page 1", &SiteDefinition{ID: "zero", ZeroIndexedPages: &zero}, 1, "0"},
+		{"zero-indexed page 3", &SiteDefinition{ID: "zero", ZeroIndexedPages: &zero}, 3, "2"},
+		{"one-indexed page 1", &SiteDefinition{ID: "one", ZeroIndexedPages: &one}, 1, "1"},
+		{"one-indexed page 3", &SiteDefinition{ID: "one", ZeroIndexedPages: &one}, 3, "3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+			if tt.def != nil {
+				d.SetSiteDefinition(tt.def)
+			}
+			req, err := d.PrepareSearch(SearchQuery{Keyword: "x", Page: tt.page})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, req.Params.Get("page"))
+		})
+	}
+}
+
+func TestNexusPHPDriver_PrepareSearch_NoPageParam(t *testing.T) {
+	one := false
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	d.SetSiteDefinition(&SiteDefinition{ID: "one", ZeroIndexedPages: &one})
+
+	req, err := d.PrepareSearch(SearchQuery{Keyword: "x"})
+	require.NoError(t, err)
+	assert.False(t, req.Params.Has("page"))
+}
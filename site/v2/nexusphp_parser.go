@@ -153,20 +153,21 @@ func (p *NexusPHPParser) ParseTitleAndID(doc *goquery.Selection) (title, torrent
 }
 
 func (p *NexusPHPParser) ParseDiscount(doc *goquery.Selection) (DiscountLevel, time.Time) {
-	discount := DiscountNone
-	doc.Find(p.config.DiscountSelector).EachWithBreak(func(_ int, el *goquery.Selection) bool {
+	// 同时存在多个优惠标记时（如 halfdown + free）取价值最高的组合
+	var levels []DiscountLevel
+	doc.Find(p.config.DiscountSelector).Each(func(_ int, el *goquery.Selection) {
 		class, exists := el.Attr("class")
-		if exists {
-			// Handle multiple classes and whitespace: class="free highlight" or class="free "
-			for _, cls := range strings.Fields(class) {
-				if level, ok := p.config.DiscountMapping[cls]; ok {
-					discount = level
-					return false
-				}
+		if !exists {
+			return
+		}
+		// Handle multiple classes and whitespace: class="free highlight" or class="free "
+		for _, cls := range strings.Fields(class) {
+			if level, ok := p.config.DiscountMapping[cls]; ok {
+				levels = append(levels, level)
 			}
 		}
-		return true
 	})
+	discount := CombineDiscountLevels(levels...)
 
 	var endTime time.Time
 	if attr := doc.Find(p.config.EndTimeSelector).First().AttrOr("title", ""); attr != "" {
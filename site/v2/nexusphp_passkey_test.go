@@ -0,0 +1,99 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNexusPHPDriver_PrepareDownloadDirect(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{
+		BaseURL:           "https://example.com",
+		Passkey:           " a&b=c ",
+		DownloadURLParams: url.Values{"type": {"torrent"}},
+	})
+
+	req, err := d.PrepareDownloadDirect("42")
+	require.NoError(t, err)
+	assert.Equal(t, "/download.php", req.Path)
+	assert.True(t, req.Raw)
+	assert.Equal(t, "42", req.Params.Get("id"))
+	assert.Equal(t, "a&b=c", req.Params.Get("passkey"), "passkey is trimmed and escaped by the query encoder")
+	assert.Equal(t, "torrent", req.Params.Get("type"))
+	assert.Equal(t, "id=42&passkey=a%26b%3Dc&type=torrent", req.Params.Encode())
+
+	_, err = d.PrepareDownloadDirect(" ")
+	assert.Error(t, err)
+}
+
+func TestNexusPHPDriver_PrepareDownload_EmptyPasskeyUsesDetailPage(t *testing.T) {
+	for _, passkey := range []string{"", "   "} {
+		d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Passkey: passkey})
+
+		_, err := d.PrepareDownloadDirect("42")
+		assert.ErrorIs(t, err, ErrNoPasskey)
+
+		req, err := d.PrepareDownload("42")
+		require.NoError(t, err)
+		assert.Equal(t, "/details.php", req.Path)
+		assert.False(t, req.Raw)
+		assert.Empty(t, req.Params.Get("passkey"))
+	}
+}
+
+func TestNexusPHPDriver_DownloadAndHash_Passkey(t *testing.T) {
+	torrent := createTestTorrent("passkey")
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.URL.Path != "/download.php" || r.URL.Query().Get("passkey") != "secret" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(torrent)
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", Passkey: "secret"})
+	data, hash, err := d.DownloadAndHash(context.Background(), "7")
+	require.NoError(t, err)
+	assert.Equal(t, torrent, data)
+	assert.NotEmpty(t, hash)
+	assert.Equal(t, []string{"/download.php"}, paths, "no detail page round-trip")
+}
+
+func TestNexusPHPDriver_DownloadDirect_HTMLResponse(t *testing.T) {
+	page := `<html><body><form action="takelogin.php"></form></body></html>`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("id") == "1" {
+			_, _ = w.Write([]byte(page))
+			return
+		}
+		_, _ = w.Write([]byte("<html><body>Invalid passkey</body></html>"))
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", Passkey: "bad"})
+
+	_, _, err := d.DownloadAndHash(context.Background(), "1")
+	assert.ErrorIs(t, err, ErrSessionExpired)
+
+	_, _, err = d.DownloadAndHash(context.Background(), "2")
+	assert.ErrorIs(t, err, ErrParseError)
+}
+
+func TestCreateNexusPHPSite_Passkey(t *testing.T) {
+	opts, err := json.Marshal(NexusPHPOptions{Cookie: "c=1", Passkey: "secret"})
+	require.NoError(t, err)
+	site, err := createNexusPHPSite(SiteConfig{ID: "passkeysite", BaseURL: "https://example.com", Options: opts}, zap.NewNop())
+	require.NoError(t, err)
+	driver := site.(*BaseSite[NexusPHPRequest, NexusPHPResponse]).driver.(*NexusPHPDriver)
+	assert.Equal(t, "secret", driver.Passkey)
+}
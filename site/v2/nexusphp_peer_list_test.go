@@ -0,0 +1,84 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNexusPHPDriver_ParsePeerList(t *testing.T) {
+	raw, err := os.ReadFile("testdata/nexusphp_viewpeerlist.html")
+	require.NoError(t, err)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	peers, err := d.ParsePeerList(NexusPHPResponse{Document: mustDoc(t, string(raw))})
+	require.NoError(t, err)
+	require.Len(t, peers, 3)
+
+	assert.Equal(t, Peer{IsSeeder: true, Client: "qBittorrent 4.6.2", Progress: 1}, peers[0])
+	assert.Equal(t, Peer{IsSeeder: true, Client: "Transmission 4.0.5", Progress: 1}, peers[1])
+	assert.False(t, peers[2].IsSeeder)
+	assert.Equal(t, "Deluge 2.1.1", peers[2].Client)
+	assert.InDelta(t, 0.455, peers[2].Progress, 0.0001)
+}
+
+func TestNexusPHPDriver_ParsePeerList_NoHeadings(t *testing.T) {
+	doc := mustDoc(t, `<table>
+<tr><td>User</td><td>Complete</td><td>Client</td></tr>
+<tr><td>a</td><td>100%</td><td>rTorrent 0.9.8</td></tr>
+<tr><td>b</td><td>12%</td><td>libtorrent 2.0</td></tr>
+</table>`)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	peers, err := d.ParsePeerList(NexusPHPResponse{Document: doc})
+	require.NoError(t, err)
+	require.Len(t, peers, 2)
+	assert.True(t, peers[0].IsSeeder, "a complete peer counts as a seeder")
+	assert.False(t, peers[1].IsSeeder)
+	assert.InDelta(t, 0.12, peers[1].Progress, 0.0001)
+}
+
+func TestNexusPHPDriver_ParsePeerList_Empty(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	peers, err := d.ParsePeerList(NexusPHPResponse{Document: mustDoc(t, `<b>没有同伴</b>`)})
+	require.NoError(t, err)
+	assert.Empty(t, peers)
+
+	_, err = d.ParsePeerList(NexusPHPResponse{})
+	assert.ErrorIs(t, err, ErrParseError)
+}
+
+func TestNexusPHPDriver_GetPeerList(t *testing.T) {
+	raw, err := os.ReadFile("testdata/nexusphp_viewpeerlist.html")
+	require.NoError(t, err)
+
+	var gotPath, gotID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotID = r.URL.Path, r.URL.Query().Get("id")
+		_, _ = w.Write(raw)
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", PeerListPath: "peers.php"})
+	peers, err := d.GetPeerList(context.Background(), "42")
+	require.NoError(t, err)
+	assert.Len(t, peers, 3)
+	assert.Equal(t, "/peers.php", gotPath)
+	assert.Equal(t, "42", gotID)
+
+	_, err = d.GetPeerList(context.Background(), "")
+	assert.Error(t, err)
+}
+
+func TestNexusPHPDriver_PreparePeerList_DefaultPath(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	req, err := d.PreparePeerList("7")
+	require.NoError(t, err)
+	assert.Equal(t, "/viewpeerlist.php", req.Path)
+	assert.Equal(t, "7", req.Params.Get("id"))
+}
@@ -0,0 +1,57 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const pendingListingHTML = `<html><body>
+<table class="torrents"><tbody>
+	<tr><td>Type</td><td>Name</td></tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=1">Pending Movie 2025</a><span class="tags">待审核</span></td>
+	</tr>
+	<tr>
+		<td><img alt="TV" /></td>
+		<td><a href="details.php?id=2">Approved Show S01</a><span class="tags tgf">官方</span></td>
+	</tr>
+	<tr>
+		<td><img alt="TV" /></td>
+		<td><a href="details.php?id=3">Queued Show S02</a><img src="pic/pending.png" title="Pending review" /></td>
+	</tr>
+</tbody></table>
+</body></html>`
+
+func TestNexusPHPDriver_ParseSearch_PendingBadge(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, pendingListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	assert.False(t, items[0].IsApproved(), "待审核 tag marks a pending torrent")
+	assert.True(t, items[1].IsApproved(), "rows without a pending marker are approved")
+	assert.False(t, items[2].IsApproved(), "pending badge image marks a pending torrent")
+}
+
+func TestNexusPHPDriver_ParseSearch_PendingCustomKeywords(t *testing.T) {
+	sel := DefaultNexusPHPSelectors()
+	mergeSelectors(&sel, &SiteSelectors{PendingBadge: "span.tags", PendingKeywords: []string{"官方"}})
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, pendingListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	assert.True(t, items[0].IsApproved())
+	assert.False(t, items[1].IsApproved())
+	assert.True(t, items[2].IsApproved(), "image badge is outside the custom selector")
+}
+
+func TestTorrentItem_IsApprovedByDefault(t *testing.T) {
+	item := TorrentItem{ID: "1"}
+	assert.True(t, item.IsApproved())
+}
@@ -0,0 +1,109 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func postProcessDefinition(second RequestConfig) *SiteDefinition {
+	return &SiteDefinition{
+		ID:     "postdef",
+		Name:   "PostDef",
+		Schema: SchemaNexusPHP,
+		UserInfo: &UserInfoConfig{
+			Process: []UserInfoProcess{
+				{
+					RequestConfig: RequestConfig{URL: "/index.php"},
+					Fields:        []string{"id", "name"},
+				},
+				{
+					RequestConfig: second,
+					Assertion:     map[string]string{"id": "params.id"},
+					Fields:        []string{"uploaded"},
+				},
+			},
+			Selectors: map[string]FieldSelector{
+				"id":       {Selector: []string{"a[href*='userdetails.php']"}, Attr: "href", Filters: []Filter{{Name: "querystring", Args: []any{"id"}}}},
+				"name":     {Selector: []string{"a[href*='userdetails.php']"}},
+				"uploaded": {Selector: []string{"td.rowhead:contains('上传量') + td"}},
+			},
+		},
+	}
+}
+
+type profileRequest struct {
+	method      string
+	contentType string
+	body        map[string]any
+}
+
+func newProfileServer(t *testing.T) (*httptest.Server, *profileRequest) {
+	t.Helper()
+	var mu sync.Mutex
+	got := &profileRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/profile":
+			mu.Lock()
+			got.method = r.Method
+			got.contentType = r.Header.Get("Content-Type")
+			_ = json.NewDecoder(r.Body).Decode(&got.body)
+			mu.Unlock()
+			if got.body["uid"] != "42" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`<table><tr><td class="rowhead">上传量</td><td class="rowfollow">1.5 TB</td></tr></table>`))
+		case "/getusertorrentlistajax.php":
+			_, _ = w.Write([]byte(`<table></table>`))
+		default:
+			_, _ = w.Write([]byte(`<div id="info_block"><a href="userdetails.php?id=42">poster</a></div>`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, got
+}
+
+func TestNexusPHPDriver_UserInfoProcess_PostBody(t *testing.T) {
+	tests := []struct {
+		name   string
+		config RequestConfig
+	}{
+		{"body template", RequestConfig{URL: "/api/profile", Method: "POST", Body: `{"uid":"{id}","scope":"profile"}`}},
+		{"data map", RequestConfig{URL: "/api/profile", Method: "post", Data: map[string]any{"uid": "{id}", "scope": "profile"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, got := newProfileServer(t)
+			d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+			d.SetSiteDefinition(postProcessDefinition(tt.config))
+
+			info, err := d.GetUserInfo(context.Background())
+			require.NoError(t, err)
+
+			assert.Equal(t, http.MethodPost, got.method)
+			assert.Equal(t, "application/json", got.contentType)
+			assert.Equal(t, map[string]any{"uid": "42", "scope": "profile"}, got.body)
+			assert.Equal(t, "poster", info.Username)
+			assert.Equal(t, parseSize("1.5 TB"), info.Uploaded)
+		})
+	}
+}
+
+func TestProcessRequestBody(t *testing.T) {
+	body, err := processRequestBody(RequestConfig{Body: `id={id}&x={missing}`}, map[string]string{"id": "7"})
+	require.NoError(t, err)
+	assert.Equal(t, "id=7&x={missing}", string(body))
+
+	body, err = processRequestBody(RequestConfig{Data: map[string]any{"id": "{id}", "page": 2}}, map[string]string{"id": "7"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"7","page":2}`, string(body))
+}
@@ -0,0 +1,93 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const promotionSecondPageHTML = `<html><body>
+<table class="torrents"><tbody>
+	<tr><td>类型</td><td>标题</td></tr>
+	<tr>
+		<td><img alt="Music" /></td>
+		<td><a href="details.php?id=9003&amp;hit=1">Promo Album FLAC</a><img class="pro_free2up" src="pic/trans.gif" /></td>
+		<td>0</td><td></td><td>1.2 GB</td><td>8</td><td>0</td><td>5</td>
+	</tr>
+</tbody></table>
+</body></html>`
+
+func newPromotionServer(t *testing.T, path string) (*httptest.Server, *[]string) {
+	t.Helper()
+	first, err := os.ReadFile("testdata/nexusphp_promotion.html")
+	require.NoError(t, err)
+
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		if page == "" {
+			_, _ = w.Write(first)
+			return
+		}
+		// Out-of-range pages repeat the last page, as NexusPHP does
+		_, _ = w.Write([]byte(promotionSecondPageHTML))
+	}))
+	t.Cleanup(server.Close)
+	return server, &pages
+}
+
+func TestNexusPHPDriver_GetPromotions(t *testing.T) {
+	server, pages := newPromotionServer(t, "/promotion.php")
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+
+	items, err := d.GetPromotions(context.Background(), 5)
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	assert.Equal(t, "9001", items[0].ID)
+	assert.Equal(t, "Promo Movie 2025 1080p", items[0].Title)
+	assert.Equal(t, DiscountFree, items[0].DiscountLevel)
+	assert.Equal(t, "9002", items[1].ID)
+	assert.Equal(t, DiscountPercent50, items[1].DiscountLevel)
+	assert.Equal(t, "9003", items[2].ID)
+	assert.Equal(t, Discount2xFree, items[2].DiscountLevel)
+
+	assert.Equal(t, []string{"", "1", "2"}, *pages, "paging stops when a page only repeats seen torrents")
+}
+
+func TestNexusPHPDriver_GetPromotions_SinglePage(t *testing.T) {
+	server, pages := newPromotionServer(t, "/promotion.php")
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+
+	items, err := d.GetPromotions(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+	assert.Equal(t, []string{""}, *pages)
+}
+
+func TestNexusPHPDriver_GetPromotions_CustomPath(t *testing.T) {
+	server, _ := newPromotionServer(t, "/special.php")
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", PromotionPath: "special.php"})
+
+	items, err := d.GetPromotions(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+}
+
+func TestNexusPHPDriver_GetPromotions_HTTPError(t *testing.T) {
+	server, _ := newPromotionServer(t, "/special.php")
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+
+	_, err := d.GetPromotions(context.Background(), 1)
+	assert.ErrorContains(t, err, "promotion page 0")
+}
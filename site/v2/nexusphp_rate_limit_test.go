@@ -0,0 +1,80 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newCountingServer(t *testing.T, hits *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		_, _ = w.Write([]byte(`<html><body><a href="userdetails.php?id=7">Me</a></body></html>`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNexusPHPDriver_RateLimitSharedAcrossConcurrentRequests(t *testing.T) {
+	var hits atomic.Int32
+	server := newCountingServer(t, &hits)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", RateLimit: 20, RateBurst: 1})
+
+	const requests = 5
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := d.Execute(context.Background(), NexusPHPRequest{Path: "/index.php"})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// The first request uses the burst token, each later one waits 1/20s
+	assert.GreaterOrEqual(t, time.Since(start), time.Duration(requests-1)*50*time.Millisecond-10*time.Millisecond)
+	assert.Equal(t, int32(requests), hits.Load())
+}
+
+func TestNexusPHPDriver_RateLimitHonorsContext(t *testing.T) {
+	var hits atomic.Int32
+	server := newCountingServer(t, &hits)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", RateLimit: 0.1, RateBurst: 1})
+
+	_, err := d.Execute(context.Background(), NexusPHPRequest{Path: "/index.php"})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = d.Execute(ctx, NexusPHPRequest{Path: "/index.php"})
+	assert.ErrorContains(t, err, "rate limit")
+	assert.Equal(t, int32(1), hits.Load(), "a throttled request must not reach the site")
+}
+
+func TestNexusPHPDriver_RateLimitConfig(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	assert.Nil(t, d.limiter, "unthrottled unless a rate is configured")
+
+	site, err := createNexusPHPSite(SiteConfig{
+		ID:        "ratelimited",
+		BaseURL:   "https://example.com",
+		Options:   []byte(`{"cookie":"c=1"}`),
+		RateLimit: 2,
+	}, zap.NewNop())
+	require.NoError(t, err)
+	driver := site.(*BaseSite[NexusPHPRequest, NexusPHPResponse]).driver.(*NexusPHPDriver)
+	require.NotNil(t, driver.limiter)
+	assert.InDelta(t, 2.0, float64(driver.limiter.Limit()), 0.001)
+	assert.Equal(t, 3, driver.limiter.Burst())
+}
@@ -0,0 +1,69 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRelativeTime(t *testing.T) {
+	now := time.Date(2025, 3, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"刚刚", now},
+		{"Just now", now},
+		{"30秒前", now.Add(-30 * time.Second)},
+		{"5分钟前", now.Add(-5 * time.Minute)},
+		{"2小时前", now.Add(-2 * time.Hour)},
+		{"1小时20分钟前", now.Add(-80 * time.Minute)},
+		{"3天前", now.AddDate(0, 0, -3)},
+		{"2周前", now.AddDate(0, 0, -14)},
+		{"1个月前", now.AddDate(0, -1, 0)},
+		{"2月前", now.AddDate(0, -2, 0)},
+		{"1年前", now.AddDate(-1, 0, 0)},
+		{"2 hours ago", now.Add(-2 * time.Hour)},
+		{"1 day ago", now.AddDate(0, 0, -1)},
+		{"3 weeks ago", now.AddDate(0, 0, -21)},
+		{"10 mins ago", now.Add(-10 * time.Minute)},
+		{"昨天 14:30", time.Date(2025, 3, 14, 14, 30, 0, 0, time.UTC)},
+		{"前天 08:05:09", time.Date(2025, 3, 13, 8, 5, 9, 0, time.UTC)},
+		{"今天 09:15", time.Date(2025, 3, 15, 9, 15, 0, 0, time.UTC)},
+		{"yesterday 23:59", time.Date(2025, 3, 14, 23, 59, 0, 0, time.UTC)},
+		{"3天", time.Time{}},
+		{"昨天 25:00", time.Time{}},
+		{"2025-03-15 10:00:00", time.Time{}},
+		{"", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseRelativeTime(tt.input, now))
+		})
+	}
+}
+
+func TestNexusPHPDriver_ParseSearch_RelativeUploadTime(t *testing.T) {
+	html := `<html><body><table class="torrents"><tbody>
+		<tr><td>Type</td><td>Name</td></tr>
+		<tr><td></td><td><a href="details.php?id=1">Relative</a></td><td></td><td><span>3天前</span></td></tr>
+		<tr><td></td><td><a href="details.php?id=2">Titled</a></td><td></td><td><span title="2025-03-01 12:00:00">2周前</span></td></tr>
+		<tr><td></td><td><a href="details.php?id=3">Yesterday</a></td><td></td><td><span>昨天 14:30</span></td></tr>
+	</tbody></table></body></html>`
+
+	now := time.Date(2025, 3, 15, 10, 0, 0, 0, time.UTC)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	d.now = func() time.Time { return now }
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, html)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	assert.Equal(t, now.AddDate(0, 0, -3).Unix(), items[0].UploadedAt)
+	assert.Equal(t, time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC).Unix(), items[1].UploadedAt, "absolute title wins")
+	assert.Equal(t, time.Date(2025, 3, 14, 14, 30, 0, 0, time.UTC).Unix(), items[2].UploadedAt)
+}
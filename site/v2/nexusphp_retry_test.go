@@ -0,0 +1,96 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFlakyServer answers the first failures requests with status, then 200
+func newFlakyServer(t *testing.T, hits *atomic.Int32, failures int32, status int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		_, _ = w.Write([]byte(`<html><body><a href="userdetails.php?id=7">Me</a></body></html>`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNexusPHPDriver_RetriesTransientStatus(t *testing.T) {
+	var hits atomic.Int32
+	server := newFlakyServer(t, &hits, 2, http.StatusServiceUnavailable)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", RetryBaseDelay: 10 * time.Millisecond})
+
+	start := time.Now()
+	res, err := d.Execute(context.Background(), NexusPHPRequest{Path: "/index.php"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, int32(3), hits.Load())
+	// Backoff doubles: 10ms then 20ms
+	assert.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+}
+
+func TestNexusPHPDriver_RetryGivesUpAfterMaxAttempts(t *testing.T) {
+	var hits atomic.Int32
+	server := newFlakyServer(t, &hits, 10, http.StatusBadGateway)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", RetryMaxAttempts: 2, RetryBaseDelay: time.Millisecond})
+
+	res, err := d.Execute(context.Background(), NexusPHPRequest{Path: "/index.php"})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadGateway, res.StatusCode)
+	assert.Equal(t, int32(2), hits.Load())
+}
+
+func TestNexusPHPDriver_NoRetryOnNonTransientStatus(t *testing.T) {
+	for _, status := range []int{http.StatusForbidden, http.StatusUnauthorized, http.StatusNotFound} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			var hits atomic.Int32
+			server := newFlakyServer(t, &hits, 10, status)
+			d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", RetryBaseDelay: time.Millisecond})
+
+			_, err := d.Execute(context.Background(), NexusPHPRequest{Path: "/index.php"})
+			require.Error(t, err)
+			if status != http.StatusNotFound {
+				assert.ErrorIs(t, err, ErrInvalidCredentials)
+			}
+			assert.Equal(t, int32(1), hits.Load())
+		})
+	}
+}
+
+func TestNexusPHPDriver_RetriesNetworkError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: url, Cookie: "c=1", RetryBaseDelay: 10 * time.Millisecond})
+	start := time.Now()
+	_, err := d.Execute(context.Background(), NexusPHPRequest{Path: "/index.php"})
+	require.Error(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+}
+
+func TestNexusPHPDriver_RetryRespectsContextDeadline(t *testing.T) {
+	var hits atomic.Int32
+	server := newFlakyServer(t, &hits, 10, http.StatusServiceUnavailable)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", RetryBaseDelay: time.Second})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := d.Execute(ctx, NexusPHPRequest{Path: "/index.php"})
+	require.Error(t, err)
+	// The backoff would outlive the deadline, so no retry is attempted
+	assert.Equal(t, int32(1), hits.Load())
+	assert.Less(t, time.Since(start), 200*time.Millisecond)
+}
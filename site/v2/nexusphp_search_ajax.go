@@ -0,0 +1,139 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ajaxSearchResponse is the JSON page returned by a SearchConfig.AjaxPath endpoint
+type ajaxSearchResponse struct {
+	Total int      `json:"total"`
+	Rows  []string `json:"rows"`
+}
+
+// ajaxSearchPath returns the site's AJAX search endpoint, empty when unset
+func (d *NexusPHPDriver) ajaxSearchPath() string {
+	if d.siteDefinition == nil || d.siteDefinition.Search == nil {
+		return ""
+	}
+	return d.siteDefinition.Search.AjaxPath
+}
+
+// PrepareSearchAjax converts a SearchQuery to a request against the site's
+// AJAX search endpoint, with the same parameters PrepareSearch sends
+func (d *NexusPHPDriver) PrepareSearchAjax(query SearchQuery) (NexusPHPRequest, error) {
+	path := d.ajaxSearchPath()
+	if path == "" {
+		return NexusPHPRequest{}, fmt.Errorf("ajax search: %w", ErrNotImplemented)
+	}
+	req, err := d.PrepareSearch(query)
+	if err != nil {
+		return NexusPHPRequest{}, err
+	}
+	req.Path = path
+	req.IframeSelector = ""
+	return req, nil
+}
+
+// ParseSearchAjax parses one AJAX search page. Each row is an HTML <tr>
+// fragment parsed like a torrents.php row; total is the number of matching
+// torrents across all pages, 0 when the endpoint does not report it.
+func (d *NexusPHPDriver) ParseSearchAjax(res NexusPHPResponse) (items []TorrentItem, total int, err error) {
+	var page ajaxSearchResponse
+	if err := json.Unmarshal(res.RawBody, &page); err != nil {
+		return nil, 0, fmt.Errorf("%w: ajax search: %v", ErrParseError, err)
+	}
+	if len(page.Rows) == 0 {
+		return nil, page.Total, nil
+	}
+
+	html := `<table class="torrents"><tbody>` + strings.Join(page.Rows, "") + `</tbody></table>`
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: ajax search rows: %v", ErrParseError, err)
+	}
+	doc.Find("table.torrents > tbody > tr").Each(func(_ int, s *goquery.Selection) {
+		if item, ok := d.parseSearchRow(s); ok {
+			items = append(items, item)
+		}
+	})
+	return items, page.Total, nil
+}
+
+// SearchAjax runs one page of query against the site's AJAX search endpoint
+// and also returns the total number of matching torrents (0 when unknown)
+func (d *NexusPHPDriver) SearchAjax(ctx context.Context, query SearchQuery) ([]TorrentItem, int, error) {
+	req, err := d.PrepareSearchAjax(query)
+	if err != nil {
+		return nil, 0, err
+	}
+	res, err := d.Execute(ctx, req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("ajax search: %w", err)
+	}
+	return d.ParseSearchAjax(res)
+}
+
+// SearchAllPages fetches up to maxPages pages (at least one) of query,
+// starting from query.Page, through the AJAX endpoint when the site has one
+// and torrents.php otherwise. Paging stops once the reported total has been
+// collected, at an empty page, or when a page repeats torrents already seen.
+func (d *NexusPHPDriver) SearchAllPages(ctx context.Context, query SearchQuery, maxPages int) ([]TorrentItem, error) {
+	if maxPages <= 0 {
+		maxPages = 1
+	}
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+	useAjax := d.ajaxSearchPath() != ""
+
+	var items []TorrentItem
+	seen := make(map[string]struct{})
+	for i := 0; i < maxPages; i++ {
+		var (
+			pageItems []TorrentItem
+			total     int
+			err       error
+		)
+		if useAjax {
+			pageItems, total, err = d.SearchAjax(ctx, query)
+		} else {
+			pageItems, err = d.searchPage(ctx, query)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("search page %d: %w", query.Page, err)
+		}
+
+		added := 0
+		for _, item := range pageItems {
+			if _, ok := seen[item.ID]; ok {
+				continue
+			}
+			seen[item.ID] = struct{}{}
+			items = append(items, item)
+			added++
+		}
+		if added == 0 || (total > 0 && len(items) >= total) {
+			break
+		}
+		query.Page++
+	}
+	return items, nil
+}
+
+// searchPage fetches and parses one torrents.php result page
+func (d *NexusPHPDriver) searchPage(ctx context.Context, query SearchQuery) ([]TorrentItem, error) {
+	req, err := d.PrepareSearch(query)
+	if err != nil {
+		return nil, err
+	}
+	res, err := d.Execute(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return d.ParseSearch(res)
+}
@@ -0,0 +1,117 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ajaxRow(id int) string {
+	return fmt.Sprintf(`<tr><td></td><td><a href="details.php?id=%d">Movie %d</a></td></tr>`, id, id)
+}
+
+// newAjaxSearchServer serves total torrents, perPage at a time, from
+// /ajax_search.php with 0-indexed pages
+func newAjaxSearchServer(t *testing.T, total, perPage int) (*httptest.Server, *int32) {
+	t.Helper()
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ajax_search.php" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		atomic.AddInt32(&requests, 1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		rows := []string{}
+		for id := page*perPage + 1; id <= min((page+1)*perPage, total); id++ {
+			rows = append(rows, ajaxRow(id))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"total": total, "rows": rows})
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func newAjaxSearchDriver(baseURL string) *NexusPHPDriver {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: baseURL, Cookie: "c=1"})
+	d.SetSiteDefinition(&SiteDefinition{ID: "ajax", Search: &SearchConfig{AjaxPath: "/ajax_search.php"}})
+	return d
+}
+
+func TestNexusPHPDriver_SearchAjax_ReturnsTotal(t *testing.T) {
+	server, _ := newAjaxSearchServer(t, 5, 2)
+	d := newAjaxSearchDriver(server.URL)
+
+	items, total, err := d.SearchAjax(context.Background(), SearchQuery{Keyword: "movie", Page: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 5, total)
+	require.Len(t, items, 2)
+	assert.Equal(t, "1", items[0].ID)
+	assert.Equal(t, "Movie 2", items[1].Title)
+}
+
+func TestNexusPHPDriver_SearchAllPages_StopsAtTotal(t *testing.T) {
+	server, requests := newAjaxSearchServer(t, 5, 2)
+	d := newAjaxSearchDriver(server.URL)
+
+	items, err := d.SearchAllPages(context.Background(), SearchQuery{Keyword: "movie"}, 10)
+	require.NoError(t, err)
+	assert.Len(t, items, 5)
+	// Three pages hold five torrents; no fourth request is needed
+	assert.Equal(t, int32(3), atomic.LoadInt32(requests))
+}
+
+func TestNexusPHPDriver_SearchAllPages_RespectsMaxPages(t *testing.T) {
+	server, requests := newAjaxSearchServer(t, 50, 2)
+	d := newAjaxSearchDriver(server.URL)
+
+	items, err := d.SearchAllPages(context.Background(), SearchQuery{Keyword: "movie"}, 2)
+	require.NoError(t, err)
+	assert.Len(t, items, 4)
+	assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+}
+
+func TestNexusPHPDriver_ParseSearchAjax(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+
+	items, total, err := d.ParseSearchAjax(NexusPHPResponse{RawBody: []byte(`{"total": 0, "rows": []}`)})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+	assert.Zero(t, total)
+
+	_, _, err = d.ParseSearchAjax(NexusPHPResponse{RawBody: []byte(`<html>login</html>`)})
+	assert.ErrorIs(t, err, ErrParseError)
+}
+
+func TestNexusPHPDriver_SearchAjax_NotConfigured(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	_, _, err := d.SearchAjax(context.Background(), SearchQuery{Keyword: "movie"})
+	assert.ErrorIs(t, err, ErrNotImplemented)
+}
+
+func TestNexusPHPDriver_SearchAllPages_HTMLStopsAtRepeatedPage(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		// Out-of-range pages repeat the last page
+		page := min(atomic.LoadInt32(&requests), 2)
+		_, _ = fmt.Fprintf(w, `<html><body><table class="torrents"><tbody><tr><td>Type</td><td>Name</td></tr>%s</tbody></table></body></html>`,
+			ajaxRow(int(page)))
+	}))
+	t.Cleanup(server.Close)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	items, err := d.SearchAllPages(context.Background(), SearchQuery{Keyword: "movie"}, 10)
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
@@ -0,0 +1,56 @@
+package v2
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func drainTorrentItems(ch <-chan TorrentItem) []TorrentItem {
+	var items []TorrentItem
+	for item := range ch {
+		items = append(items, item)
+	}
+	return items
+}
+
+func TestNexusPHPDriver_ParseSearchStream_MatchesParseSearch(t *testing.T) {
+	raw, err := os.ReadFile("testdata/nexusphp_promotion.html")
+	require.NoError(t, err)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	for name, html := range map[string]string{
+		"promotion fixture": string(raw),
+		"official listing":  officialListingHTML,
+		"v1.8 listing":      v18ListingHTML,
+	} {
+		t.Run(name, func(t *testing.T) {
+			res := NexusPHPResponse{Document: mustDoc(t, html)}
+			want, err := d.ParseSearch(res)
+			require.NoError(t, err)
+			require.NotEmpty(t, want)
+
+			assert.Equal(t, want, drainTorrentItems(d.ParseSearchStream(res)))
+		})
+	}
+}
+
+func TestNexusPHPDriver_ParseSearchStream_NoDocument(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	assert.Empty(t, drainTorrentItems(d.ParseSearchStream(NexusPHPResponse{})))
+}
+
+func TestNexusPHPDriver_ParseSearchStream_SkipsUntitledRows(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	html := `<html><body><table class="torrents"><tbody>
+		<tr><td>Type</td><td>Name</td></tr>
+		<tr><td></td><td><span>no link</span></td></tr>
+		<tr><td></td><td><a href="details.php?id=5">Kept</a></td></tr>
+	</tbody></table></body></html>`
+
+	items := drainTorrentItems(d.ParseSearchStream(NexusPHPResponse{Document: mustDoc(t, html)}))
+	require.Len(t, items, 1)
+	assert.Equal(t, "5", items[0].ID)
+}
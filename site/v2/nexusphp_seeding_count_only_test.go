@@ -0,0 +1,51 @@
+package v2
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNexusPHPDriver_ParseSeedingStatus_CountOnlySummary(t *testing.T) {
+	raw, err := os.ReadFile("testdata/nexusphp_seeding_count_only.html")
+	require.NoError(t, err)
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(raw)))
+	require.NoError(t, err)
+
+	d := &NexusPHPDriver{}
+	seeding, size, err := d.ParseSeedingStatus(NexusPHPResponse{Document: doc, RawBody: raw})
+	require.NoError(t, err)
+
+	// Count from the summary, size accumulated from the listed rows
+	assert.Equal(t, 94, seeding)
+	assert.Equal(t, parseSize("20.00 GB")+parseSize("1.50 TB"), size)
+}
+
+func TestNexusPHPDriver_ParseSeedingStatus_CountOnlyVariants(t *testing.T) {
+	tests := []struct {
+		name    string
+		html    string
+		seeding int
+		size    int64
+	}{
+		{"records suffix", `<p>1,024 条记录</p><table><tr><td>标题</td><td>大小</td></tr><tr><td>a</td><td>1 GB</td></tr></table>`, 1024, parseSize("1 GB")},
+		{"traditional", `<p>共 7 條</p><table><tr><td>標題</td><td>大小</td></tr><tr><td>a</td><td>2 GB</td></tr></table>`, 7, parseSize("2 GB")},
+		{"summary without rows", `<p>共 5 条</p>`, 5, 0},
+	}
+
+	d := &NexusPHPDriver{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			require.NoError(t, err)
+			seeding, size, err := d.ParseSeedingStatus(NexusPHPResponse{Document: doc, RawBody: []byte(tt.html)})
+			require.NoError(t, err)
+			assert.Equal(t, tt.seeding, seeding)
+			assert.Equal(t, tt.size, size)
+		})
+	}
+}
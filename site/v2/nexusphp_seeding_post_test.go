@@ -0,0 +1,71 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// postOnlySeedingServer answers the seeding AJAX endpoint only for form POSTs
+func postOnlySeedingServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		require.NoError(t, r.ParseForm())
+		if r.URL.RawQuery != "" || r.PostForm.Get("userid") != "42" || r.PostForm.Get("type") != "seeding" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`<html><body><table>
+			<tr><th>name</th><th>x</th><th>size</th></tr>
+			<tr><td>t1</td><td>-</td><td>1.00 GB</td></tr>
+			<tr><td>t2</td><td>-</td><td>3.00 GB</td></tr>
+			<tr><td>t3</td><td>-</td><td>4.00 GB</td></tr>
+		</table></body></html>`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNexusPHPDriver_FetchSeedingStatus_POST(t *testing.T) {
+	server := postOnlySeedingServer(t)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	d.SetSiteDefinition(&SiteDefinition{ID: "postsite", UserInfo: &UserInfoConfig{SeedingRequestMethod: "post"}})
+
+	seeding, size, err := d.FetchSeedingStatus(context.Background(), "42")
+	require.NoError(t, err)
+	assert.Equal(t, 3, seeding)
+	assert.Equal(t, int64(8*1024*1024*1024), size)
+}
+
+func TestNexusPHPDriver_FetchSeedingStatus_GETRejectedByPOSTOnlySite(t *testing.T) {
+	server := postOnlySeedingServer(t)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+
+	_, _, err := d.FetchSeedingStatus(context.Background(), "42")
+	assert.ErrorContains(t, err, "405")
+}
+
+func TestNexusPHPDriver_PrepareUserSeedingPage_Method(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	req, err := d.PrepareUserSeedingPage("1", "seeding")
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodGet, req.Method, "GET by default")
+
+	d.SetSiteDefinition(&SiteDefinition{ID: "x", UserInfo: &UserInfoConfig{SeedingRequestMethod: "GET"}})
+	req, err = d.PrepareUserSeedingPage("1", "seeding")
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodGet, req.Method)
+
+	d.SetSiteDefinition(&SiteDefinition{ID: "x", UserInfo: &UserInfoConfig{SeedingRequestMethod: "POST"}})
+	req, err = d.PrepareUserSeedingPage("1", "seeding")
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPost, req.Method)
+}
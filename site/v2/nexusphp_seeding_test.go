@@ -0,0 +1,147 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const seedingListHRFixture = `<table>
+<tr><td>类型</td><td>标题</td><td>大小</td><td>做种数</td><td>H&R</td></tr>
+<tr>
+	<td><img alt="Movie"></td>
+	<td><a href="details.php?id=11" title="Satisfied.Movie.2024"><b>Satisfied.Movie.2024</b></a></td>
+	<td>10.5 GB</td>
+	<td>12</td>
+	<td>H&R: 已满足</td>
+</tr>
+<tr>
+	<td><img alt="TV"></td>
+	<td><a href="details.php?id=12" title="Pending.Show.S01">Pending.Show.S01</a></td>
+	<td>3.2 GB</td>
+	<td>4</td>
+	<td>H&R: 未满足</td>
+</tr>
+<tr>
+	<td><img alt="Music"></td>
+	<td><a href="details.php?id=13">No.HR.Album</a></td>
+	<td>500 MB</td>
+	<td>2</td>
+	<td></td>
+</tr>
+</table>`
+
+func boolPtr(b bool) *bool { return &b }
+
+func TestParseHRSatisfied(t *testing.T) {
+	tests := []struct {
+		input string
+		want  *bool
+	}{
+		{"H&R: 已满足", boolPtr(true)},
+		{"H&R: 未满足", boolPtr(false)},
+		{"H&R Satisfied", boolPtr(true)},
+		{"H&R not satisfied", boolPtr(false)},
+		{"unsatisfied", boolPtr(false)},
+		{"", nil},
+		{"做种中", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseHRSatisfied(tt.input))
+		})
+	}
+}
+
+func TestNexusPHPDriver_ParseSeedingList_HRSatisfied(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	items, err := d.ParseSeedingList(NexusPHPResponse{Document: mustDoc(t, seedingListHRFixture)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	assert.Equal(t, "11", items[0].ID)
+	assert.Equal(t, "Satisfied.Movie.2024", items[0].Title)
+	assert.Equal(t, int64(10.5*1024*1024*1024), items[0].SizeBytes)
+	require.NotNil(t, items[0].HRSatisfied)
+	assert.True(t, *items[0].HRSatisfied)
+
+	require.NotNil(t, items[1].HRSatisfied)
+	assert.False(t, *items[1].HRSatisfied)
+
+	assert.Equal(t, "No.HR.Album", items[2].Title)
+	assert.Nil(t, items[2].HRSatisfied)
+}
+
+func TestNexusPHPDriver_ParseSeedingList_HRKeywordInTitle(t *testing.T) {
+	const fixture = `<table>
+<tr><td>类型</td><td>标题</td><td>大小</td><td>H&R</td></tr>
+<tr>
+	<td><img alt="Movie"></td>
+	<td><a href="details.php?id=21" title="Unsatisfied.Love.2024">Unsatisfied.Love.2024</a></td>
+	<td>8 GB</td>
+	<td>H&R: 已满足</td>
+</tr>
+<tr>
+	<td><img alt="Movie"></td>
+	<td><a href="details.php?id=22" title="I.Am.Not.Satisfied.2023">I.Am.Not.Satisfied.2023 已满足</a></td>
+	<td>4 GB</td>
+	<td></td>
+</tr>
+</table>`
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	items, err := d.ParseSeedingList(NexusPHPResponse{Document: mustDoc(t, fixture)})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	require.NotNil(t, items[0].HRSatisfied)
+	assert.True(t, *items[0].HRSatisfied, "status comes from the H&R cell, not the title")
+	assert.Nil(t, items[1].HRSatisfied, "keywords in the title cell are ignored")
+}
+
+func TestNexusPHPDriver_ParseSeedingList_CustomHRStatus(t *testing.T) {
+	const fixture = `<table>
+<tr><td>标题</td><td>大小</td><td>备注</td></tr>
+<tr>
+	<td><a href="details.php?id=31">Show.S01</a><img class="hr-badge" title="H&R 未满足" src="hr.png"></td>
+	<td>2 GB</td>
+	<td>已满足其他条件</td>
+</tr>
+</table>`
+
+	sel := DefaultNexusPHPSelectors()
+	mergeSelectors(&sel, &SiteSelectors{SeedingHRStatus: "img.hr-badge"})
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+	items, err := d.ParseSeedingList(NexusPHPResponse{Document: mustDoc(t, fixture)})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.NotNil(t, items[0].HRSatisfied)
+	assert.False(t, *items[0].HRSatisfied)
+}
+
+func TestNexusPHPDriver_ParseSeedingList_NilDoc(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	_, err := d.ParseSeedingList(NexusPHPResponse{})
+	assert.ErrorIs(t, err, ErrParseError)
+}
+
+func TestNexusPHPDriver_FetchSeedingList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/getusertorrentlistajax.php", r.URL.Path)
+		assert.Equal(t, "seeding", r.URL.Query().Get("type"))
+		_, _ = w.Write([]byte(seedingListHRFixture))
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	items, err := d.FetchSeedingList(context.Background(), "42")
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	require.NotNil(t, items[1].HRSatisfied)
+	assert.False(t, *items[1].HRSatisfied)
+}
@@ -0,0 +1,84 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSessionServer serves the index page while loggedIn is set and the login
+// page otherwise; a 2FA page is served when twoFA is set
+func newSessionServer(t *testing.T, loggedIn, twoFA *atomic.Bool) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case twoFA.Load():
+			_, _ = w.Write([]byte(`<html><head><title>二次验证</title></head><body><form action="take2fa.php"></form></body></html>`))
+		case loggedIn.Load():
+			_, _ = w.Write([]byte(`<html><body><a href="userdetails.php?id=7">Me</a><a href="logout.php">Logout</a></body></html>`))
+		default:
+			_, _ = w.Write([]byte(`<html><head><title>Login</title></head><body><form action="takelogin.php"><input name="username"/><input type="password" name="password"/></form></body></html>`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNexusPHPDriver_IsSessionValid(t *testing.T) {
+	var loggedIn, twoFA atomic.Bool
+	loggedIn.Store(true)
+	server := newSessionServer(t, &loggedIn, &twoFA)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	ctx := context.Background()
+
+	assert.True(t, d.IsSessionValid(ctx))
+	assert.NoError(t, d.LastAuthError())
+
+	loggedIn.Store(false)
+	assert.False(t, d.IsSessionValid(ctx))
+	assert.ErrorIs(t, d.LastAuthError(), ErrSessionExpired)
+
+	twoFA.Store(true)
+	assert.False(t, d.IsSessionValid(ctx))
+	assert.ErrorIs(t, d.LastAuthError(), Err2FARequired)
+
+	twoFA.Store(false)
+	loggedIn.Store(true)
+	assert.True(t, d.IsSessionValid(ctx))
+	assert.NoError(t, d.LastAuthError())
+}
+
+func TestNexusPHPDriver_IsSessionValid_Forbidden(t *testing.T) {
+	for _, status := range []int{http.StatusUnauthorized, http.StatusForbidden} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}))
+		d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", RetryMaxAttempts: 1})
+
+		assert.False(t, d.IsSessionValid(context.Background()), "status %d", status)
+		assert.ErrorIs(t, d.LastAuthError(), ErrInvalidCredentials)
+		server.Close()
+	}
+}
+
+func TestNexusPHPDriver_LastAuthError_KeptAcrossNetworkErrors(t *testing.T) {
+	var loggedIn, twoFA atomic.Bool
+	server := newSessionServer(t, &loggedIn, &twoFA)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1", RetryMaxAttempts: 1})
+	ctx := context.Background()
+
+	_, err := d.Execute(ctx, NexusPHPRequest{Path: "/index.php"})
+	require.ErrorIs(t, err, ErrSessionExpired)
+
+	// A network failure says nothing about the cookie
+	server.Close()
+	_, err = d.Execute(ctx, NexusPHPRequest{Path: "/index.php"})
+	require.Error(t, err)
+	assert.ErrorIs(t, d.LastAuthError(), ErrSessionExpired)
+	assert.True(t, d.IsSessionValid(ctx))
+}
@@ -0,0 +1,68 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNexusPHPDriver_GetSiteStats(t *testing.T) {
+	raw, err := os.ReadFile("testdata/nexusphp_index_stats.html")
+	require.NoError(t, err)
+
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		_, _ = w.Write(raw)
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	stats, err := d.GetSiteStats(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "/index.php", path)
+	assert.Equal(t, SiteStats{OnlineUsers: 1287, TorrentsToday: 76, TotalTorrents: 183905}, stats)
+}
+
+func TestNexusPHPDriver_ParseSiteStats(t *testing.T) {
+	t.Run("absent stats default to zero", func(t *testing.T) {
+		doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<div>hello</div>`))
+		require.NoError(t, err)
+		d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+
+		stats, err := d.ParseSiteStats(NexusPHPResponse{Document: doc})
+		require.NoError(t, err)
+		assert.Equal(t, SiteStats{}, stats)
+	})
+
+	t.Run("custom selectors", func(t *testing.T) {
+		doc, err := goquery.NewDocumentFromReader(strings.NewReader(
+			`<ul class="stats"><li id="online">在线 321 人</li><li id="today">今日 9</li><li id="total">共 4,500</li></ul>`))
+		require.NoError(t, err)
+		selectors := DefaultNexusPHPSelectors()
+		mergeSelectors(&selectors, &SiteSelectors{
+			StatsOnlineUsers:   "#online",
+			StatsTorrentsToday: "#today",
+			StatsTotalTorrents: "#total",
+		})
+		d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &selectors})
+
+		stats, err := d.ParseSiteStats(NexusPHPResponse{Document: doc})
+		require.NoError(t, err)
+		assert.Equal(t, SiteStats{OnlineUsers: 321, TorrentsToday: 9, TotalTorrents: 4500}, stats)
+	})
+
+	t.Run("nil document", func(t *testing.T) {
+		d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+		_, err := d.ParseSiteStats(NexusPHPResponse{})
+		assert.ErrorIs(t, err, ErrParseError)
+	})
+}
@@ -0,0 +1,112 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSizeWithBase(t *testing.T) {
+	tests := []struct {
+		input    string
+		base     int
+		expected int64
+	}{
+		{"1 KiB", 1024, 1024},
+		{"1 KiB", 1000, 1024},
+		{"1.5 GiB", 1000, int64(1.5 * 1024 * 1024 * 1024)},
+		{"2 MiB", 1024, 2 * 1024 * 1024},
+		{"1 KB", 1000, 1000},
+		{"1.5 GB", 1000, 1_500_000_000},
+		{"1 TB", 1000, 1_000_000_000_000},
+		{"1 GB", 0, 1024 * 1024 * 1024},
+		{"1.5 吉字节", 1024, int64(1.5 * 1024 * 1024 * 1024)},
+		{"500 兆字节", 1000, 500_000_000},
+		{"2 千字节", 1024, 2048},
+		{"100 字节", 1024, 100},
+		{"1.5 万亿字节", 1024, 1_500_000_000_000},
+		{"1.5万亿字节", 1000, 1_500_000_000_000},
+		{"3 亿字节", 1024, 300_000_000},
+		{"2.5 万字节", 1000, 25_000},
+		{"2 万吉字节", 1000, 20_000_000_000_000},
+		{"invalid", 1000, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseSizeWithBase(tt.input, tt.base))
+		})
+	}
+}
+
+const sizeAttrListingHTML = `<html><body>
+<table class="torrents"><tbody>
+	<tr><td>Type</td><td>Name</td></tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=1">Exact Bytes</a></td>
+		<td></td><td></td>
+		<td data-bytes="1610612737">1.50 GB</td>
+	</tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=2">Data Size</a></td>
+		<td></td><td></td>
+		<td data-size="12345">12 KB</td>
+	</tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=3">Empty Attribute</a></td>
+		<td></td><td></td>
+		<td data-bytes="" data-raw="">2 MB</td>
+	</tr>
+	<tr>
+		<td><img alt="Movies" /></td>
+		<td><a href="details.php?id=4">Custom Attribute</a></td>
+		<td></td><td></td>
+		<td data-raw="4096" data-bytes="1">4 KB</td>
+	</tr>
+</tbody></table>
+</body></html>`
+
+func TestNexusPHPDriver_ParseSearch_SizeBytesAttr(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, sizeAttrListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 4)
+
+	assert.Equal(t, int64(1610612737), items[0].SizeBytes, "data-bytes wins over the rounded text")
+	assert.Equal(t, int64(12345), items[1].SizeBytes)
+	assert.Equal(t, int64(2*1024*1024), items[2].SizeBytes, "empty attribute falls back to the text")
+	assert.Equal(t, int64(1), items[3].SizeBytes)
+}
+
+func TestNexusPHPDriver_ParseSearch_CustomSizeBytesAttr(t *testing.T) {
+	sel := DefaultNexusPHPSelectors()
+	mergeSelectors(&sel, &SiteSelectors{SizeBytesAttr: "data-raw"})
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, sizeAttrListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 4)
+
+	assert.Equal(t, int64(1536*1024*1024), items[0].SizeBytes, "only the configured attribute is read")
+	assert.Equal(t, int64(2*1024*1024), items[2].SizeBytes, "empty configured attribute falls back to the text")
+	assert.Equal(t, int64(4096), items[3].SizeBytes)
+}
+
+func TestNexusPHPDriver_ParseSearch_SizeBase(t *testing.T) {
+	sel := DefaultNexusPHPSelectors()
+	mergeSelectors(&sel, &SiteSelectors{SizeBytesAttr: "data-none", SizeBase: 1000})
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, sizeAttrListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 4)
+
+	assert.Equal(t, int64(1_500_000_000), items[0].SizeBytes)
+	assert.Equal(t, int64(12_000), items[1].SizeBytes)
+	assert.Equal(t, int64(2_000_000), items[2].SizeBytes)
+}
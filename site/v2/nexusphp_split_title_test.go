@@ -0,0 +1,40 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNexusPHPDriver_ParseSearch_SplitTitle(t *testing.T) {
+	html := `<html><body><table class="torrents"><tbody>
+		<tr><td>Type</td><td>Name</td></tr>
+		<tr><td></td><td><table class="torrentname"><tr><td class="embedded">
+			<a href="details.php?id=1"><span>The.Movie.2024.1080p</span><span>电影</span></a>
+		</td></tr></table></td></tr>
+		<tr><td></td><td><table class="torrentname"><tr><td class="embedded">
+			<a href="details.php?id=2">Show.S01.2160p</a><a href="details.php?id=2">剧集 第一季</a>
+			<a href="details.php?id=2&amp;hit=1#startcomments">评论</a>
+		</td></tr></table></td></tr>
+		<tr><td></td><td><table class="torrentname"><tr><td class="embedded">
+			<a href="details.php?id=3">Album.<b>FLAC</b>.2023</a>
+		</td></tr></table></td></tr>
+	</tbody></table></body></html>`
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, html)})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	assert.Equal(t, "The.Movie.2024.1080p 电影", items[0].Title)
+	assert.Equal(t, "1", items[0].ID)
+
+	assert.Equal(t, "Show.S01.2160p 剧集 第一季", items[1].Title)
+	assert.Equal(t, "剧集 第一季", items[1].Subtitle)
+	assert.Equal(t, "2", items[1].ID)
+
+	// Highlight tags stay part of the word
+	assert.Equal(t, "Album.FLAC.2023", items[2].Title)
+	assert.Empty(t, items[2].Subtitle)
+}
@@ -0,0 +1,88 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newThanksServer only shows the download link on details.php once the
+// torrent ID has been posted to /thanks.php
+func newThanksServer(t *testing.T, torrent []byte) (*httptest.Server, *[]string) {
+	t.Helper()
+	var (
+		mu     sync.Mutex
+		thanks []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/details.php":
+			mu.Lock()
+			thanked := len(thanks) > 0
+			mu.Unlock()
+			if !thanked {
+				_, _ = w.Write([]byte(`<html><body><h1 id="top">Movie</h1>
+					<input type="button" id="saythanks" value="说谢谢" onclick="saythanks(42);" />
+					<p>请先说谢谢后再下载</p></body></html>`))
+				return
+			}
+			_, _ = w.Write([]byte(`<html><body><h1 id="top">Movie</h1>
+				<a href="download.php?id=42&amp;passkey=abc">下载种子</a></body></html>`))
+		case "/thanks.php":
+			if r.Method != http.MethodPost {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			_ = r.ParseForm()
+			mu.Lock()
+			thanks = append(thanks, r.PostForm.Get("id"))
+			mu.Unlock()
+			_, _ = w.Write([]byte("ok"))
+		case "/download.php":
+			_, _ = w.Write(torrent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &thanks
+}
+
+func TestNexusPHPDriver_ParseDownload_SaysThanksFirst(t *testing.T) {
+	torrent := createTestTorrent("thanks")
+	server, thanks := newThanksServer(t, torrent)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{
+		BaseURL:    server.URL,
+		Cookie:     "c=1",
+		ThanksPath: "/thanks.php",
+	})
+
+	data, _, err := d.DownloadAndHash(context.Background(), "42")
+	require.NoError(t, err)
+	assert.Equal(t, torrent, data)
+	assert.Equal(t, []string{"42"}, *thanks)
+}
+
+func TestNexusPHPDriver_ParseDownload_WithoutThanksPath(t *testing.T) {
+	server, thanks := newThanksServer(t, createTestTorrent("thanks"))
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+
+	_, _, err := d.DownloadAndHash(context.Background(), "42")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no download URL")
+	assert.Empty(t, *thanks)
+}
+
+func TestDetailTorrentID(t *testing.T) {
+	assert.Equal(t, "42", detailTorrentID(mustDoc(t, `<input id="saythanks" onclick="saythanks(42)">`)))
+	assert.Equal(t, "7", detailTorrentID(mustDoc(t, `<input name="detail_torrent_id" value="7">`)))
+	assert.Equal(t, "8", detailTorrentID(mustDoc(t, `<form action="thanks.php"><input name="id" value="8"></form>`)))
+	assert.Empty(t, detailTorrentID(mustDoc(t, `<p>nothing</p>`)))
+}
@@ -0,0 +1,78 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func todayTrafficDefinition(withToday bool) *SiteDefinition {
+	fields := []string{"id", "name"}
+	if withToday {
+		fields = append(fields, "uploadedToday", "downloadedToday")
+	}
+	return &SiteDefinition{
+		ID:     "todaydef",
+		Name:   "TodayDef",
+		Schema: SchemaNexusPHP,
+		UserInfo: &UserInfoConfig{
+			Process: []UserInfoProcess{
+				{
+					RequestConfig: RequestConfig{URL: "/userdetails.php", ResponseType: "document"},
+					Fields:        fields,
+				},
+			},
+			Selectors: map[string]FieldSelector{
+				"id":              {Selector: []string{"a[href*='userdetails.php']"}, Attr: "href", Filters: []Filter{{Name: "querystring", Args: []any{"id"}}}},
+				"name":            {Selector: []string{"a[href*='userdetails.php']"}},
+				"uploadedToday":   {Selector: []string{"td.rowhead:contains('今日上传') + td"}},
+				"downloadedToday": {Selector: []string{"td.rowhead:contains('今日下载') + td"}},
+			},
+		},
+	}
+}
+
+func newTodayTrafficServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	raw, err := os.ReadFile("testdata/nexusphp_userdetails_today_traffic.html")
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/getusertorrentlistajax.php" {
+			_, _ = w.Write([]byte(`<table><tr><td>类型</td><td>标题</td><td>大小</td></tr></table>`))
+			return
+		}
+		_, _ = w.Write(raw)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNexusPHPDriver_GetUserInfoWithDefinition_TodayTraffic(t *testing.T) {
+	server := newTodayTrafficServer(t)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	d.SetSiteDefinition(todayTrafficDefinition(true))
+
+	info, err := d.GetUserInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "todayuser", info.Username)
+	assert.Equal(t, int64(12.5*1024*1024*1024), info.UploadedToday)
+	assert.Equal(t, int64(768*1024*1024), info.DownloadedToday)
+}
+
+func TestNexusPHPDriver_GetUserInfoWithDefinition_TodayTrafficUndeclared(t *testing.T) {
+	server := newTodayTrafficServer(t)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	d.SetSiteDefinition(todayTrafficDefinition(false))
+
+	info, err := d.GetUserInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "todayuser", info.Username)
+	assert.Zero(t, info.UploadedToday)
+	assert.Zero(t, info.DownloadedToday)
+}
@@ -0,0 +1,54 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const uploaderClassListingHTML = `<html><body>
+<table class="torrents"><tbody>
+	<tr><td>Type</td><td>Name</td><td>Uploader</td></tr>
+	<tr>
+		<td></td><td><a href="details.php?id=1">Staff Release</a></td>
+		<td class="rowfollow"><i><a href="userdetails.php?id=3" class="Uploader_Name"><b>alice</b></a></i></td>
+	</tr>
+	<tr>
+		<td></td><td><a href="details.php?id=2">Leader Release</a></td>
+		<td class="rowfollow"><a href="userdetails.php?id=4"><b class="Staff_Leader_Name">bob</b></a></td>
+	</tr>
+	<tr>
+		<td></td><td><a href="details.php?id=3">Titled Release</a></td>
+		<td class="rowfollow"><a href="userdetails.php?id=5" title="发布员">carol</a></td>
+	</tr>
+	<tr>
+		<td></td><td><a href="details.php?id=4">Anonymous</a></td>
+		<td class="rowfollow"><i>匿名</i></td>
+	</tr>
+</tbody></table>
+</body></html>`
+
+func TestNexusPHPDriver_ParseSearch_UploaderClass(t *testing.T) {
+	sel := DefaultNexusPHPSelectors()
+	mergeSelectors(&sel, &SiteSelectors{UploaderClass: "a[href*='userdetails.php']"})
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com", Selectors: &sel})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, uploaderClassListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 4)
+
+	assert.Equal(t, "Uploader", items[0].UploaderClass)
+	assert.Equal(t, "Staff_Leader", items[1].UploaderClass)
+	assert.Equal(t, "发布员", items[2].UploaderClass)
+	assert.Empty(t, items[3].UploaderClass)
+}
+
+func TestNexusPHPDriver_ParseSearch_UploaderClassDisabled(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+
+	items, err := d.ParseSearch(NexusPHPResponse{Document: mustDoc(t, uploaderClassListingHTML)})
+	require.NoError(t, err)
+	require.Len(t, items, 4)
+	assert.Empty(t, items[0].UploaderClass)
+}
@@ -0,0 +1,71 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDuration(t *testing.T) {
+	const day = 86400
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{"1年30天", 395 * day},
+		{"2天", 2 * day},
+		{"2天3时", 2*day + 3*3600},
+		{"5小时20分钟", 5*3600 + 20*60},
+		{"45秒", 45},
+		{"127天10:20:30", 127*day + 10*3600 + 20*60 + 30},
+		{"1周", 7 * day},
+		{"3d 4h 5m", 3*day + 4*3600 + 5*60},
+		{"1 year 2 days", 367 * day},
+		{"10 hours", 10 * 3600},
+		{"", 0},
+		{"N/A", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseDuration(tt.input))
+		})
+	}
+}
+
+func TestNexusPHPDriver_ParseUserDetails_SeedingTime(t *testing.T) {
+	html := `<html><body><table>
+		<tr><td class="rowhead">下载量</td><td class="rowfollow">500.00 GB</td></tr>
+		<tr><td class="rowhead">下载时间</td><td class="rowfollow">2天</td></tr>
+		<tr><td class="rowhead">做种时间</td><td class="rowfollow">1年30天</td></tr>
+		<tr><td class="rowhead">做种/下载时间比率</td><td class="rowfollow">197.50</td></tr>
+		<tr><td class="rowhead">做种积分</td><td class="rowfollow">12</td></tr>
+	</table></body></html>`
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	info, err := d.ParseUserDetails(NexusPHPResponse{Document: mustDoc(t, html)})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(500*1024*1024*1024), info.Downloaded, "下载时间 must not overwrite 下载量")
+	assert.Equal(t, int64(2*86400), info.LeechingTimeSeconds)
+	assert.Equal(t, int64(395*86400), info.SeedingTimeSeconds)
+	assert.Equal(t, 12, info.Seeding)
+}
+
+func TestNexusPHPDriver_ParseUserDetails_SeedingTimeEnglish(t *testing.T) {
+	html := `<html><body><table>
+		<tr><td class="rowhead">Downloaded</td><td class="rowfollow">1.00 TB</td></tr>
+		<tr><td class="rowhead">Seeding time</td><td class="rowfollow">3d 4h</td></tr>
+		<tr><td class="rowhead">Leeching time</td><td class="rowfollow">5h 30m</td></tr>
+	</table></body></html>`
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	info, err := d.ParseUserDetails(NexusPHPResponse{Document: mustDoc(t, html)})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1024*1024*1024*1024), info.Downloaded)
+	assert.Equal(t, int64(3*86400+4*3600), info.SeedingTimeSeconds)
+	assert.Equal(t, int64(5*3600+30*60), info.LeechingTimeSeconds)
+	assert.Zero(t, info.Seeding, "Seeding time is not the seeding count")
+}
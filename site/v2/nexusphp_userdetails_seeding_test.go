@@ -0,0 +1,98 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const userDetailsSeedingTotal = int64(10*1024*1024*1024 + 1536*1024*1024 + 512*1024*1024)
+
+func loadUserDetailsSeedingFixture(t *testing.T) []byte {
+	t.Helper()
+	raw, err := os.ReadFile("testdata/nexusphp_userdetails_seeding.html")
+	require.NoError(t, err)
+	return raw
+}
+
+func TestNexusPHPDriver_ParseUserDetailsSeeding(t *testing.T) {
+	raw := loadUserDetailsSeedingFixture(t)
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(raw)))
+	require.NoError(t, err)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	seeding, size, err := d.ParseUserDetailsSeeding(NexusPHPResponse{Document: doc, RawBody: raw})
+	require.NoError(t, err)
+	assert.Equal(t, 3, seeding)
+	assert.Equal(t, userDetailsSeedingTotal, size)
+}
+
+func TestNexusPHPDriver_ParseUserDetailsSeeding_NoSection(t *testing.T) {
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	doc := mustDoc(t, `<table><tr><td class="rowhead">用户名</td><td class="rowfollow">u</td></tr></table>`)
+
+	seeding, size, err := d.ParseUserDetailsSeeding(NexusPHPResponse{Document: doc})
+	require.NoError(t, err)
+	assert.Zero(t, seeding)
+	assert.Zero(t, size)
+
+	_, _, err = d.ParseUserDetailsSeeding(NexusPHPResponse{})
+	assert.ErrorIs(t, err, ErrParseError)
+}
+
+// newUserDetailsSeedingServer serves an index page, the userdetails fixture and
+// an AJAX seeding endpoint returning ajaxBody
+func newUserDetailsSeedingServer(t *testing.T, ajaxBody string) *httptest.Server {
+	raw := loadUserDetailsSeedingFixture(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/index.php":
+			_, _ = w.Write([]byte(`<html><body><div id="info_block"><a class="User_Name" href="userdetails.php?id=42">testuser</a></div></body></html>`))
+		case "/userdetails.php":
+			_, _ = w.Write(raw)
+		case "/getusertorrentlistajax.php":
+			_, _ = w.Write([]byte(ajaxBody))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNexusPHPDriver_GetUserInfo_SeedingFallsBackToUserDetails(t *testing.T) {
+	server := newUserDetailsSeedingServer(t, "")
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+
+	info, err := d.GetUserInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "42", info.UserID)
+	assert.Equal(t, 3, info.Seeding)
+	assert.Equal(t, userDetailsSeedingTotal, info.SeederSize)
+}
+
+func TestNexusPHPDriver_GetUserInfo_AjaxSeedingPreferred(t *testing.T) {
+	server := newUserDetailsSeedingServer(t, `<table><tr><td>标题</td><td>大小</td></tr><tr><td>X</td><td>2 GB</td></tr></table>`)
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+
+	info, err := d.GetUserInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, info.Seeding)
+	assert.Equal(t, int64(2*1024*1024*1024), info.SeederSize)
+}
+
+func TestNexusPHPDriver_SeedingFallback_DefinitionPath(t *testing.T) {
+	server := newUserDetailsSeedingServer(t, "")
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+
+	seeding, size := d.seedingStatusWithFallback(context.Background(), "42", nil)
+	assert.Equal(t, 3, seeding)
+	assert.Equal(t, userDetailsSeedingTotal, size)
+}
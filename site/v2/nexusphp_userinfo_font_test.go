@@ -0,0 +1,70 @@
+package v2
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractSizeText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "plain", in: "1.5 TB", want: "1.5 TB"},
+		{name: "leading counter", in: "[2] 1.50 TB", want: "1.50 TB"},
+		{name: "trailing noise", in: "512.00 GB(今日 +3)", want: "512.00 GB"},
+		{name: "binary unit", in: "800 GiB", want: "800 GiB"},
+		{name: "chinese unit", in: "1.5吉", want: "1.5吉"},
+		{name: "thousands separator", in: "1,024.5 MB", want: "1,024.5 MB"},
+		{name: "no unit", in: " 1024 ", want: "1024"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, extractSizeText(tt.in))
+		})
+	}
+}
+
+func TestFindSizeByLabel_FontWrapped(t *testing.T) {
+	doc := mustDoc(t, `<table><tr><td><font color="green">上传量</font></td><td><font color="gray">[2]</font> <font color="green">1.50 TB</font></td></tr></table>`)
+	assert.Equal(t, "1.50 TB", findSizeByLabel(doc, "上传量"))
+	assert.Equal(t, "", findSizeByLabel(doc, "不存在"))
+}
+
+func TestFindSizeByLabel_FallsBackToText(t *testing.T) {
+	doc := mustDoc(t, `<table><tr><td>上传量</td><td>1024</td></tr></table>`)
+	assert.Equal(t, "1024", findSizeByLabel(doc, "上传量"))
+}
+
+func TestNexusPHPDriver_ParseUserInfo_FontWrappedSizes(t *testing.T) {
+	raw, err := os.ReadFile("testdata/nexusphp_index_font_wrapped.html")
+	require.NoError(t, err)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	info, err := d.ParseUserInfo(NexusPHPResponse{Document: mustDoc(t, string(raw))})
+	require.NoError(t, err)
+
+	assert.Equal(t, "fontuser", info.Username)
+	assert.Equal(t, int64(1.5*1024*1024*1024*1024), info.Uploaded)
+	assert.Equal(t, int64(512)*1024*1024*1024, info.Downloaded)
+	assert.InDelta(t, 3.0, info.Ratio, 0.001)
+}
+
+func TestNexusPHPDriver_ParseUserDetails_FontWrappedSizes(t *testing.T) {
+	doc := mustDoc(t, `<table>
+<tr><td class="rowhead">上传量</td><td class="rowfollow"><font color="gray">[2]</font> <font color="green">1.50 TB</font></td></tr>
+<tr><td class="rowhead">下载量</td><td class="rowfollow"><font color="gray">[7]</font><font color="red">512.00 GB</font></td></tr>
+</table>`)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	info, err := d.ParseUserDetails(NexusPHPResponse{Document: doc})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1.5*1024*1024*1024*1024), info.Uploaded)
+	assert.Equal(t, int64(512)*1024*1024*1024, info.Downloaded)
+}
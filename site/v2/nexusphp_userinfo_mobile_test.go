@@ -0,0 +1,52 @@
+package v2
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNexusPHPDriver_ParseUserInfo_MobileSkin(t *testing.T) {
+	raw, err := os.ReadFile("testdata/nexusphp_index_mobile.html")
+	require.NoError(t, err)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	info, err := d.ParseUserInfo(NexusPHPResponse{Document: mustDoc(t, string(raw))})
+	require.NoError(t, err)
+
+	assert.Equal(t, "mobileuser", info.Username)
+	assert.Equal(t, "4321", info.UserID)
+	assert.Equal(t, int64(2.25*1024*1024*1024*1024), info.Uploaded)
+	assert.Equal(t, int64(750)*1024*1024*1024, info.Downloaded)
+	assert.InDelta(t, 3.072, info.Ratio, 0.0001)
+	assert.InDelta(t, 123456.7, info.Bonus, 0.01)
+	assert.Equal(t, 87, info.Seeding)
+	assert.Equal(t, 2, info.Invites)
+}
+
+func TestNexusPHPDriver_ParseUserInfo_MobileBlockIgnoredWhenDesktopParses(t *testing.T) {
+	doc := mustDoc(t, `<html><body>
+<div id="info_block"><a class="User_Name" href="userdetails.php?id=1">desk</a> 上传量: 1.00 TB 下载量: 100.00 GB</div>
+<div class="m-userinfo"><span>上传</span><b>9.00 TB</b><span>邀请</span><b>5</b></div>
+</body></html>`)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	info, err := d.ParseUserInfo(NexusPHPResponse{Document: doc})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1024)*1024*1024*1024, info.Uploaded)
+	assert.Zero(t, info.Invites)
+}
+
+func TestParseMobileUserInfo_InlineLabels(t *testing.T) {
+	block := mustDoc(t, `<div><p>上传量: 10.00 GB | 下载量: 5.00 GB</p><dl><dt>做种中</dt><dd>3</dd><dt>邀請</dt><dd>1</dd></dl></div>`).Find("div")
+
+	var info UserInfo
+	parseMobileUserInfo(block, &info)
+
+	assert.Equal(t, int64(10)*1024*1024*1024, info.Uploaded)
+	assert.Equal(t, 3, info.Seeding)
+	assert.Equal(t, 1, info.Invites)
+}
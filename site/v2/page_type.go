@@ -0,0 +1,105 @@
+package v2
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// PageType is the kind of page a NexusPHP site returned, used to explain
+// parse failures
+type PageType string
+
+const (
+	PageLogin       PageType = "login"
+	PageTwoFA       PageType = "2fa"
+	PageChallenge   PageType = "challenge"
+	PageRateLimited PageType = "rate_limited"
+	PageMaintenance PageType = "maintenance"
+	PageDisabled    PageType = "disabled"
+	PageSearch      PageType = "search"
+	PageDetail      PageType = "detail"
+	PageUserDetails PageType = "user_details"
+	PageUnknown     PageType = "unknown"
+)
+
+// challengeSelectors match anti-bot interstitials (Cloudflare, DDoS-Guard)
+var challengeSelectors = []string{
+	"#challenge-form",
+	"#challenge-running",
+	"#cf-wrapper",
+	".cf-browser-verification",
+	"script[src*='challenge-platform']",
+	"#ddg-captcha",
+}
+
+var (
+	challengeTitleKeywords   = []string{"just a moment", "attention required", "ddos-guard"}
+	maintenanceKeywords      = []string{"维护中", "站点维护", "系统维护", "maintenance"}
+	disabledAccountKeywords  = []string{"账号已被禁用", "帐号已被禁用", "账户已被禁用", "帳號已被禁用", "账号已被封禁", "account has been disabled", "account is disabled", "you have been banned"}
+	userDetailsLabelKeywords = []string{"加入日期", "注册时间", "註冊時間", "Join date"}
+)
+
+// ClassifyPage reports what kind of page doc is. Session and access problems
+// (login, 2FA, challenge, cooldown, maintenance, disabled account) are checked
+// before the content pages, so a login form wrapped in the site chrome is
+// still reported as PageLogin.
+func (d *NexusPHPDriver) ClassifyPage(doc *goquery.Document) PageType {
+	if doc == nil {
+		return PageUnknown
+	}
+	title := strings.ToLower(strings.TrimSpace(doc.Find("title").Text()))
+
+	switch {
+	case isChallengePage(doc, title):
+		return PageChallenge
+	case d.detectLoginPage(doc):
+		return PageLogin
+	case is2FAPage(doc):
+		return PageTwoFA
+	case d.cooldownError(doc) != nil:
+		return PageRateLimited
+	}
+
+	hasRows := d.findSearchRows(doc).Length() > 0
+	if !hasRows {
+		headings := strings.ToLower(title + " " + doc.Find("h1, h2").Text())
+		if containsAny(headings, maintenanceKeywords...) {
+			return PageMaintenance
+		}
+		if containsAny(doc.Find("body").Text(), disabledAccountKeywords...) {
+			return PageDisabled
+		}
+	}
+
+	switch {
+	case hasRows:
+		return PageSearch
+	case hasRowheadLabel(doc, userDetailsLabelKeywords...):
+		return PageUserDetails
+	case doc.Find("input[name='detail_torrent_id']").Length() > 0,
+		doc.Find("h1#top").Length() > 0 && doc.Find("a[href*='download.php?id=']").Length() > 0:
+		return PageDetail
+	}
+	return PageUnknown
+}
+
+// isChallengePage reports whether doc is an anti-bot interstitial
+func isChallengePage(doc *goquery.Document, title string) bool {
+	for _, sel := range challengeSelectors {
+		if doc.Find(sel).Length() > 0 {
+			return true
+		}
+	}
+	return containsAny(title, challengeTitleKeywords...)
+}
+
+// hasRowheadLabel reports whether any td.rowhead contains one of labels
+func hasRowheadLabel(doc *goquery.Document, labels ...string) bool {
+	found := false
+	doc.Find("td.rowhead").EachWithBreak(func(_ int, s *goquery.Selection) bool {
+		found = containsAny(s.Text(), labels...)
+		return !found
+	})
+	return found
+}
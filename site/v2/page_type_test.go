@@ -0,0 +1,82 @@
+package v2
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNexusPHPDriver_ClassifyPage_Fixtures(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		expected PageType
+	}{
+		{"nexusphp_details_peers.html", PageDetail},
+		{"nexusphp_details_min_ratio.html", PageDetail},
+		{"nexusphp_userdetails_joindate.html", PageUserDetails},
+		{"nexusphp_cooldown.html", PageRateLimited},
+		{"nexusphp_index_font_wrapped.html", PageUnknown},
+	}
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			raw, err := os.ReadFile("testdata/" + tt.fixture)
+			require.NoError(t, err)
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(raw)))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, d.ClassifyPage(doc))
+		})
+	}
+}
+
+func TestNexusPHPDriver_ClassifyPage(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected PageType
+	}{
+		{"login form", `<title>登录</title><form action="takelogin.php"><input name="username"><input name="password"></form>`, PageLogin},
+		{"two factor", `<title>二次验证</title><form action="take2fa.php"></form>`, PageTwoFA},
+		{"cloudflare", `<title>Just a moment...</title><div id="cf-wrapper"></div>`, PageChallenge},
+		{"challenge script", `<script src="/cdn-cgi/challenge-platform/h/b/orchestrate.js"></script>`, PageChallenge},
+		{"maintenance", `<title>站点维护中</title><p>预计两小时后恢复</p>`, PageMaintenance},
+		{"disabled account", `<title>错误</title><td class="text">您的账号已被禁用，请联系管理员</td>`, PageDisabled},
+		{"search results", `<table class="torrents"><tbody>
+			<tr><td>header</td></tr>
+			<tr><td><a href="details.php?id=1">Torrent</a></td></tr>
+		</tbody></table>`, PageSearch},
+		{"search mentioning maintenance", `<h1>维护中 notice</h1><table class="torrents"><tbody>
+			<tr><td>header</td></tr>
+			<tr><td><a href="details.php?id=1">Torrent</a></td></tr>
+		</tbody></table>`, PageSearch},
+		{"detail by hidden id", `<input name="detail_torrent_id" value="9">`, PageDetail},
+		{"empty", `<div>hello</div>`, PageUnknown},
+	}
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, d.ClassifyPage(doc))
+		})
+	}
+
+	assert.Equal(t, PageUnknown, d.ClassifyPage(nil))
+}
+
+func TestNexusPHPDriver_ClassifyPage_DefinitionLoginSelectors(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<div class="sso-box">Sign in</div>`))
+	require.NoError(t, err)
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: "https://example.com"})
+	assert.Equal(t, PageUnknown, d.ClassifyPage(doc))
+
+	d.SetSiteDefinition(&SiteDefinition{ID: "sso", LoginPageSelectors: []string{".sso-box"}})
+	assert.Equal(t, PageLogin, d.ClassifyPage(doc))
+}
@@ -0,0 +1,120 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// CrawlDelayFetcher is implemented by drivers that can read the site's
+// declared crawl delay
+type CrawlDelayFetcher interface {
+	FetchCrawlDelay(ctx context.Context) (time.Duration, error)
+}
+
+// CrawlDelayApplier is implemented by drivers with their own request limiter,
+// so the crawl delay also spaces requests they send concurrently
+type CrawlDelayApplier interface {
+	ApplyCrawlDelay(delay time.Duration)
+}
+
+// slowLimiter limits l to one request per delay. It only ever slows the
+// limiter down and reports whether it changed.
+func slowLimiter(l *rate.Limiter, delay time.Duration) bool {
+	if l == nil || delay <= 0 {
+		return false
+	}
+	limit := rate.Every(delay)
+	if limit >= l.Limit() {
+		return false
+	}
+	l.SetLimit(limit)
+	l.SetBurst(1)
+	return true
+}
+
+// ParseCrawlDelay returns the Crawl-delay declared in a robots.txt body.
+// A group naming userAgent takes precedence over the "*" group; zero means
+// no delay is declared.
+func ParseCrawlDelay(robots, userAgent string) time.Duration {
+	userAgent = strings.ToLower(userAgent)
+
+	var (
+		agents        []string
+		inRules       bool
+		wildcardDelay time.Duration
+		agentDelay    time.Duration
+	)
+	for _, line := range strings.Split(robots, "\n") {
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		if key == "user-agent" {
+			// A user-agent line after rules starts a new group
+			if inRules {
+				agents, inRules = nil, false
+			}
+			agents = append(agents, strings.ToLower(value))
+			continue
+		}
+		inRules = true
+		if key != "crawl-delay" {
+			continue
+		}
+
+		seconds, err := strconv.ParseFloat(value, 64)
+		if err != nil || seconds <= 0 {
+			continue
+		}
+		delay := time.Duration(seconds * float64(time.Second))
+		for _, agent := range agents {
+			switch {
+			case agent == "*":
+				wildcardDelay = delay
+			case agent != "" && userAgent != "" && strings.Contains(userAgent, agent):
+				agentDelay = delay
+			}
+		}
+	}
+
+	if agentDelay > 0 {
+		return agentDelay
+	}
+	return wildcardDelay
+}
+
+// FetchCrawlDelay reads /robots.txt and returns its Crawl-delay for this
+// driver's user agent. A missing robots.txt means no delay.
+func (d *NexusPHPDriver) FetchCrawlDelay(ctx context.Context) (time.Duration, error) {
+	resp, err := d.httpClient.Get(ctx, d.BaseURL+"/robots.txt", map[string]string{
+		"User-Agent": d.userAgent,
+		"Accept":     "text/plain,*/*;q=0.8",
+	})
+	if err != nil {
+		return 0, fmt.Errorf("fetch robots.txt: %w", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return 0, nil
+	}
+	if !resp.IsSuccess() {
+		return 0, fmt.Errorf("fetch robots.txt: status %d", resp.StatusCode)
+	}
+	return ParseCrawlDelay(string(resp.Body), d.userAgent), nil
+}
+
+// ApplyCrawlDelay slows the driver's request limiter to the crawl delay
+func (d *NexusPHPDriver) ApplyCrawlDelay(delay time.Duration) {
+	slowLimiter(d.limiter, delay)
+}
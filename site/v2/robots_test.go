@@ -0,0 +1,200 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+func TestParseCrawlDelay(t *testing.T) {
+	tests := []struct {
+		name      string
+		robots    string
+		userAgent string
+		want      time.Duration
+	}{
+		{name: "wildcard", robots: "User-agent: *\nDisallow: /admin\nCrawl-delay: 5\n", want: 5 * time.Second},
+		{name: "fractional seconds", robots: "User-agent: *\nCrawl-delay: 0.5", want: 500 * time.Millisecond},
+		{name: "case and comments", robots: "USER-AGENT: * # everyone\ncrawl-DELAY: 3 # be nice", want: 3 * time.Second},
+		{
+			name:      "specific agent wins",
+			robots:    "User-agent: *\nCrawl-delay: 10\n\nUser-agent: Mozilla\nCrawl-delay: 2\n",
+			userAgent: "Mozilla/5.0 (X11; Linux x86_64)",
+			want:      2 * time.Second,
+		},
+		{
+			name:   "other agent ignored",
+			robots: "User-agent: Googlebot\nCrawl-delay: 20\n\nUser-agent: *\nDisallow:\n",
+			want:   0,
+		},
+		{
+			name:   "grouped agents share rules",
+			robots: "User-agent: Googlebot\nUser-agent: *\nCrawl-delay: 4\n",
+			want:   4 * time.Second,
+		},
+		{name: "invalid value", robots: "User-agent: *\nCrawl-delay: soon", want: 0},
+		{name: "no directive", robots: "User-agent: *\nDisallow: /", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseCrawlDelay(tt.robots, tt.userAgent))
+		})
+	}
+}
+
+func TestNexusPHPDriver_FetchCrawlDelay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			_, _ = w.Write([]byte("User-agent: *\nCrawl-delay: 5\n"))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	delay, err := d.FetchCrawlDelay(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, delay)
+}
+
+func TestNexusPHPDriver_FetchCrawlDelay_NoRobots(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	d := NewNexusPHPDriver(NexusPHPDriverConfig{BaseURL: server.URL, Cookie: "c=1"})
+	delay, err := d.FetchCrawlDelay(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, delay)
+}
+
+func TestCreateNexusPHPSite_RespectCrawlDelay(t *testing.T) {
+	var robotsHits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/robots.txt":
+			robotsHits.Add(1)
+			_, _ = w.Write([]byte("User-agent: *\nCrawl-delay: 5\n"))
+		default:
+			_, _ = w.Write([]byte(officialListingHTML))
+		}
+	}))
+	defer server.Close()
+
+	opts, err := json.Marshal(NexusPHPOptions{Cookie: "c=1", RespectCrawlDelay: true})
+	require.NoError(t, err)
+	site, err := createNexusPHPSite(SiteConfig{ID: "robotsite", BaseURL: server.URL, Options: opts, RateLimit: 2}, zap.NewNop())
+	require.NoError(t, err)
+	base := site.(*BaseSite[NexusPHPRequest, NexusPHPResponse])
+	assert.Equal(t, rate.Limit(2), base.GetRateLimiter().Limit(), "limiter untouched before the first request")
+
+	_, err = site.Search(context.Background(), SearchQuery{Keyword: "movie"})
+	require.NoError(t, err)
+
+	assert.Equal(t, rate.Every(5*time.Second), base.GetRateLimiter().Limit())
+	assert.Equal(t, 1, base.GetRateLimiter().Burst())
+	assert.Equal(t, int32(1), robotsHits.Load())
+
+	driver := base.GetDriver().(*NexusPHPDriver)
+	assert.Equal(t, rate.Every(5*time.Second), driver.limiter.Limit(), "driver requests such as concurrent user info follow the delay too")
+	assert.Equal(t, 1, driver.limiter.Burst())
+}
+
+func TestBaseSite_CrawlDelayIgnoresCallerContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("User-agent: *\nCrawl-delay: 5\n"))
+	}))
+	defer server.Close()
+
+	opts, err := json.Marshal(NexusPHPOptions{Cookie: "c=1", RespectCrawlDelay: true})
+	require.NoError(t, err)
+	site, err := createNexusPHPSite(SiteConfig{ID: "robotsite", BaseURL: server.URL, Options: opts, RateLimit: 2}, zap.NewNop())
+	require.NoError(t, err)
+	base := site.(*BaseSite[NexusPHPRequest, NexusPHPResponse])
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Error(t, base.wait(ctx))
+	assert.Equal(t, rate.Every(5*time.Second), base.GetRateLimiter().Limit(), "a cancelled caller does not skip the fetch")
+}
+
+func TestBaseSite_CrawlDelayRetriedAfterFailure(t *testing.T) {
+	var robotsHits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if robotsHits.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte("User-agent: *\nCrawl-delay: 5\n"))
+	}))
+	defer server.Close()
+
+	opts, err := json.Marshal(NexusPHPOptions{Cookie: "c=1", RespectCrawlDelay: true})
+	require.NoError(t, err)
+	site, err := createNexusPHPSite(SiteConfig{ID: "robotsite", BaseURL: server.URL, Options: opts, RateLimit: 2}, zap.NewNop())
+	require.NoError(t, err)
+	base := site.(*BaseSite[NexusPHPRequest, NexusPHPResponse])
+	ctx := context.Background()
+
+	require.NoError(t, base.wait(ctx))
+	assert.Equal(t, rate.Limit(2), base.GetRateLimiter().Limit())
+
+	require.NoError(t, base.wait(ctx))
+	assert.Equal(t, int32(1), robotsHits.Load(), "no refetch before the retry interval")
+
+	base.crawlDelayMu.Lock()
+	base.crawlDelayRetryAt = time.Time{}
+	base.crawlDelayMu.Unlock()
+
+	base.ensureCrawlDelay()
+	assert.Equal(t, int32(2), robotsHits.Load())
+	assert.Equal(t, rate.Every(5*time.Second), base.GetRateLimiter().Limit())
+
+	base.ensureCrawlDelay()
+	assert.Equal(t, int32(2), robotsHits.Load(), "a successful fetch is not repeated")
+}
+
+func TestCreateNexusPHPSite_CrawlDelayDisabledByDefault(t *testing.T) {
+	var robotsHits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			robotsHits.Add(1)
+		}
+		_, _ = w.Write([]byte(officialListingHTML))
+	}))
+	defer server.Close()
+
+	opts, err := json.Marshal(NexusPHPOptions{Cookie: "c=1"})
+	require.NoError(t, err)
+	site, err := createNexusPHPSite(SiteConfig{ID: "robotsite", BaseURL: server.URL, Options: opts, RateLimit: 2}, zap.NewNop())
+	require.NoError(t, err)
+
+	_, err = site.Search(context.Background(), SearchQuery{Keyword: "movie"})
+	require.NoError(t, err)
+	assert.Equal(t, rate.Limit(2), site.(*BaseSite[NexusPHPRequest, NexusPHPResponse]).GetRateLimiter().Limit())
+	assert.Zero(t, robotsHits.Load())
+}
+
+func TestBaseSite_ApplyCrawlDelayOnlySlowsDown(t *testing.T) {
+	site := NewBaseSite[NexusPHPRequest, NexusPHPResponse](nil, BaseSiteConfig{ID: "s", RateLimit: 0.1, RateBurst: 3})
+
+	site.ApplyCrawlDelay(time.Second)
+	assert.Equal(t, rate.Limit(0.1), site.GetRateLimiter().Limit(), "faster delay than the configured rate is ignored")
+	assert.Equal(t, 3, site.GetRateLimiter().Burst())
+
+	site.ApplyCrawlDelay(20 * time.Second)
+	assert.Equal(t, rate.Every(20*time.Second), site.GetRateLimiter().Limit())
+	assert.Equal(t, 1, site.GetRateLimiter().Burst())
+}
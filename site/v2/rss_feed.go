@@ -0,0 +1,61 @@
+package v2
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+)
+
+// RSSItem is a single entry of a site RSS feed
+type RSSItem struct {
+	Title string `json:"title"`
+	Link  string `json:"link"`
+	// Enclosure is the .torrent download URL
+	Enclosure string `json:"enclosure,omitempty"`
+	// EnclosureLength is the advertised torrent content size in bytes, 0 when unknown
+	EnclosureLength int64     `json:"enclosureLength,omitempty"`
+	PubDate         time.Time `json:"pubDate"`
+	Description     string    `json:"description,omitempty"`
+}
+
+// ParseRSSFeed parses a raw RSS 2.0 (or Atom) feed into RSSItems. NexusPHP
+// feeds put the download URL and content size in the enclosure element.
+func ParseRSSFeed(data []byte) ([]RSSItem, error) {
+	feed, err := gofeed.NewParser().Parse(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("parse rss feed: %w", err)
+	}
+
+	items := make([]RSSItem, 0, len(feed.Items))
+	for _, it := range feed.Items {
+		if it == nil {
+			continue
+		}
+		item := RSSItem{
+			Title:       strings.TrimSpace(it.Title),
+			Link:        strings.TrimSpace(it.Link),
+			Description: strings.TrimSpace(it.Description),
+		}
+		if it.PublishedParsed != nil {
+			item.PubDate = *it.PublishedParsed
+		} else if it.UpdatedParsed != nil {
+			item.PubDate = *it.UpdatedParsed
+		}
+		for _, enc := range it.Enclosures {
+			if enc == nil || strings.TrimSpace(enc.URL) == "" {
+				continue
+			}
+			item.Enclosure = strings.TrimSpace(enc.URL)
+			if n, err := strconv.ParseInt(strings.TrimSpace(enc.Length), 10, 64); err == nil && n > 0 {
+				item.EnclosureLength = n
+			}
+			break
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
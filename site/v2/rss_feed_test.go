@@ -0,0 +1,56 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleNexusPHPFeed = `<?xml version="1.0" encoding="utf-8"?>
+<rss version="2.0">
+<channel>
+	<title>Example Torrents</title>
+	<link>https://example.com</link>
+	<item>
+		<title>Movie.2025.1080p.BluRay.x264 [12.50 GB]</title>
+		<link>https://example.com/details.php?id=101&amp;hit=1</link>
+		<description><![CDATA[<p>Some description</p>]]></description>
+		<enclosure url="https://example.com/download.php?id=101&amp;passkey=abc" length="13421772800" type="application/x-bittorrent" />
+		<guid isPermaLink="false">abcdef0123456789</guid>
+		<pubDate>Mon, 13 Oct 2025 10:30:00 +0800</pubDate>
+	</item>
+	<item>
+		<title>Show.S01E01.2160p</title>
+		<link>https://example.com/details.php?id=102</link>
+		<enclosure url="https://example.com/download.php?id=102" type="application/x-bittorrent" />
+	</item>
+</channel>
+</rss>`
+
+func TestParseRSSFeed(t *testing.T) {
+	items, err := ParseRSSFeed([]byte(sampleNexusPHPFeed))
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	first := items[0]
+	assert.Equal(t, "Movie.2025.1080p.BluRay.x264 [12.50 GB]", first.Title)
+	assert.Equal(t, "https://example.com/details.php?id=101&hit=1", first.Link)
+	assert.Equal(t, "https://example.com/download.php?id=101&passkey=abc", first.Enclosure)
+	assert.Equal(t, int64(13421772800), first.EnclosureLength)
+	assert.Equal(t, "<p>Some description</p>", first.Description)
+	want := time.Date(2025, 10, 13, 2, 30, 0, 0, time.UTC)
+	assert.True(t, first.PubDate.Equal(want), "got %v", first.PubDate)
+
+	second := items[1]
+	assert.Equal(t, "https://example.com/download.php?id=102", second.Enclosure)
+	assert.Zero(t, second.EnclosureLength)
+	assert.True(t, second.PubDate.IsZero())
+	assert.Empty(t, second.Description)
+}
+
+func TestParseRSSFeed_Invalid(t *testing.T) {
+	_, err := ParseRSSFeed([]byte("not a feed"))
+	assert.Error(t, err)
+}
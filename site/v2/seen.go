@@ -0,0 +1,51 @@
+package v2
+
+import "sync"
+
+// SeenStore remembers which torrents have already been grabbed, keyed by
+// site and torrent ID. Implementations backed by persistent storage keep
+// AddFromSite from grabbing the same torrent again after a restart.
+type SeenStore interface {
+	// Seen reports whether the torrent was marked before
+	Seen(siteID, torrentID string) bool
+	// MarkSeen records the torrent as grabbed
+	MarkSeen(siteID, torrentID string)
+}
+
+// MemorySeenStore is an in-memory SeenStore, safe for concurrent use.
+// Its contents are lost when the process exits.
+type MemorySeenStore struct {
+	mu   sync.RWMutex
+	seen map[string]struct{}
+}
+
+// NewMemorySeenStore creates an empty MemorySeenStore
+func NewMemorySeenStore() *MemorySeenStore {
+	return &MemorySeenStore{seen: make(map[string]struct{})}
+}
+
+// Seen reports whether the torrent was marked before
+func (s *MemorySeenStore) Seen(siteID, torrentID string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.seen[seenKey(siteID, torrentID)]
+	return ok
+}
+
+// MarkSeen records the torrent as grabbed
+func (s *MemorySeenStore) MarkSeen(siteID, torrentID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seen[seenKey(siteID, torrentID)] = struct{}{}
+}
+
+// Len returns the number of torrents marked
+func (s *MemorySeenStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.seen)
+}
+
+func seenKey(siteID, torrentID string) string {
+	return siteID + "\x00" + torrentID
+}
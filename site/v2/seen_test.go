@@ -0,0 +1,83 @@
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunerpy/pt-tools/mocks"
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+func TestMemorySeenStore(t *testing.T) {
+	store := NewMemorySeenStore()
+	assert.False(t, store.Seen("a", "1"))
+
+	store.MarkSeen("a", "1")
+	assert.True(t, store.Seen("a", "1"))
+	assert.False(t, store.Seen("b", "1"), "IDs are scoped per site")
+	assert.False(t, store.Seen("a", "10"))
+
+	store.MarkSeen("a", "1")
+	assert.Equal(t, 1, store.Len())
+}
+
+func TestAddFromSite_SeenSkipsSecondGrab(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+
+	data := createTestTorrent("seen")
+	hash, err := ComputeTorrentHash(data)
+	require.NoError(t, err)
+	// The downloader is only consulted once; the second grab stops at the store
+	mockDl.EXPECT().CheckTorrentExists(hash).Return(false, nil).Times(1)
+	mockDl.EXPECT().AddTorrentFileEx(data, gomock.Any()).Return(downloader.AddTorrentResult{Success: true, Hash: hash}, nil).Times(1)
+
+	site := &fakeBatchSite{id: "test", data: data}
+	store := NewMemorySeenStore()
+	opts := GrabOptions{Seen: store}
+
+	first, err := AddFromSite(context.Background(), site, mockDl, TorrentItem{ID: "11"}, opts)
+	require.NoError(t, err)
+	assert.False(t, first.Skipped)
+	assert.True(t, store.Seen("test", "11"))
+
+	second, err := AddFromSite(context.Background(), site, mockDl, TorrentItem{ID: "11"}, opts)
+	require.NoError(t, err)
+	assert.True(t, second.Skipped)
+	assert.Equal(t, "torrent already grabbed", second.Message)
+}
+
+func TestAddFromSite_SeenMarksExistingTorrent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+
+	data := createTestTorrent("seen-exists")
+	hash, err := ComputeTorrentHash(data)
+	require.NoError(t, err)
+	mockDl.EXPECT().CheckTorrentExists(hash).Return(true, nil)
+
+	store := NewMemorySeenStore()
+	site := &fakeBatchSite{id: "test", data: data}
+	result, err := AddFromSite(context.Background(), site, mockDl, TorrentItem{ID: "12"}, GrabOptions{Seen: store})
+	require.NoError(t, err)
+	assert.True(t, result.Skipped)
+	assert.True(t, store.Seen("test", "12"))
+}
+
+func TestAddFromSite_SeenNotMarkedOnFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDl := mocks.NewMockDownloader(ctrl)
+
+	store := NewMemorySeenStore()
+	site := &fakeBatchSite{id: "test", data: createTestTorrent("seen-pending")}
+	_, err := AddFromSite(context.Background(), site, mockDl, TorrentItem{ID: "13", Pending: true}, GrabOptions{Seen: store})
+	assert.ErrorIs(t, err, ErrTorrentPending)
+	assert.False(t, store.Seen("test", "13"))
+}
@@ -2,6 +2,7 @@ package v2
 
 import (
 	"fmt"
+	"net/http"
 	"net/url"
 	"regexp"
 	"strings"
@@ -196,6 +197,13 @@ func (d *SiteDefinition) validateSchemaSpecific(addErr func(field, rule, detail
 func (d *SiteDefinition) validateUserInfo(addErr func(field, rule, detail string)) {
 	ui := d.UserInfo
 
+	switch strings.ToUpper(ui.SeedingRequestMethod) {
+	case "", http.MethodGet, http.MethodPost:
+	default:
+		addErr("UserInfo.SeedingRequestMethod", "InvalidValue",
+			fmt.Sprintf("%q must be GET or POST", ui.SeedingRequestMethod))
+	}
+
 	if len(ui.Process) == 0 {
 		addErr("UserInfo.Process", "Required", "must have at least one process step")
 		return
@@ -371,6 +379,29 @@ type SiteDefinition struct {
 	LevelRequirements []SiteLevelRequirement    `json:"levelRequirements,omitempty"`
 	Selectors         *SiteSelectors            `json:"selectors,omitempty"`
 	DetailParser      *DetailParserConfig       `json:"detailParser,omitempty"`
+	Search            *SearchConfig             `json:"search,omitempty"`
+	// ZeroIndexedPages reports whether the site's page= parameter starts at 0,
+	// as in stock NexusPHP. Nil means true; set false for 1-indexed forks.
+	ZeroIndexedPages *bool `json:"zeroIndexedPages,omitempty"`
+	// LoginPageSelectors mark a response as the login page (session expired)
+	// when any of them matches, in addition to the built-in heuristics
+	LoginPageSelectors []string `json:"loginPageSelectors,omitempty"`
+	// LoginPageKeywords mark a response as the login page when the page title
+	// or an inline script contains any of them (case-insensitive)
+	LoginPageKeywords []string `json:"loginPageKeywords,omitempty"`
+	// HRKeywords mark a search row as H&R when its text or HTML contains any of
+	// them (case-insensitive), for sites showing H&R as a text badge instead of
+	// an icon. On detail pages only the title heading is scanned
+	HRKeywords []string `json:"hrKeywords,omitempty"`
+	// CategoryMapping maps raw category keywords to canonical categories
+	// ("movie", "tv", "music", "anime", ...). Keys are matched, case-insensitively,
+	// against the category alt text and the icon's src and class
+	CategoryMapping map[string]string `json:"categoryMapping,omitempty"`
+	// DownloadMethod "POST" makes torrent downloads post id and passkey as a
+	// form to download.php, for sites where a GET download returns an error
+	DownloadMethod string `json:"downloadMethod,omitempty"`
+	// DownloadFormFields are extra form fields sent with a POST download
+	DownloadFormFields map[string]string `json:"downloadFormFields,omitempty"`
 
 	// CreateDriver is an optional custom driver factory for this site.
 	// If nil, the driver is created based on Schema field.
@@ -378,6 +409,43 @@ type SiteDefinition struct {
 	CreateDriver DriverFactory `json:"-"`
 }
 
+// PagesZeroIndexed reports whether the site numbers result pages from 0
+func (d *SiteDefinition) PagesZeroIndexed() bool {
+	return d == nil || d.ZeroIndexedPages == nil || *d.ZeroIndexedPages
+}
+
+// GetDownloadMethod returns the download method, empty for a nil definition
+func (d *SiteDefinition) GetDownloadMethod() string {
+	if d == nil {
+		return ""
+	}
+	return d.DownloadMethod
+}
+
+// GetDownloadFormFields returns the extra POST download form fields, nil for
+// a nil definition
+func (d *SiteDefinition) GetDownloadFormFields() map[string]string {
+	if d == nil {
+		return nil
+	}
+	return d.DownloadFormFields
+}
+
+// SearchConfig customizes how search queries are sent to the site
+type SearchConfig struct {
+	// IMDbParam is the query parameter carrying the IMDb id (default "search")
+	IMDbParam string `json:"imdbParam,omitempty"`
+	// IMDbSearchArea is the search_area sent with an IMDb search. When unset and
+	// IMDbParam is "search", NexusPHP's IMDb area "4" is used
+	IMDbSearchArea string `json:"imdbSearchArea,omitempty"`
+	// IMDbNumericOnly sends the id without its "tt" prefix
+	IMDbNumericOnly bool `json:"imdbNumericOnly,omitempty"`
+	// AjaxPath is an endpoint answering searches with JSON of the form
+	// {"total": N, "rows": ["<tr>...</tr>", ...]}, used by SearchAjax and
+	// SearchAllPages. It takes the same query parameters as torrents.php
+	AjaxPath string `json:"ajaxPath,omitempty"`
+}
+
 // UserInfoConfig defines how to fetch and parse user info
 type UserInfoConfig struct {
 	// PickLast specifies fields that should retain last known value
@@ -391,6 +459,10 @@ type UserInfoConfig struct {
 
 	// Selectors for parsing user info fields
 	Selectors map[string]FieldSelector `json:"selectors,omitempty"`
+
+	// SeedingRequestMethod is the HTTP method for the seeding list AJAX request
+	// (GET by default; POST sends the params as a form body)
+	SeedingRequestMethod string `json:"seedingRequestMethod,omitempty"`
 }
 
 // UserInfoProcess defines a single step in user info fetching
@@ -413,8 +485,11 @@ type RequestConfig struct {
 	Method string `json:"method,omitempty"`
 	// Params are query parameters
 	Params map[string]string `json:"params,omitempty"`
-	// Data is the request body for POST requests
+	// Data is the request body for POST requests, sent as JSON
 	Data map[string]any `json:"data,omitempty"`
+	// Body is a raw POST body template that takes precedence over Data.
+	// "{key}" is replaced with the value of the assertion param "key".
+	Body string `json:"body,omitempty"`
 	// ResponseType is "document" for HTML or "json" for JSON
 	ResponseType string `json:"responseType,omitempty"`
 	// Headers are additional HTTP headers
@@ -493,6 +568,26 @@ type HRSeedTimeRule struct {
 	SeedTimeH int `json:"seedTimeH"`
 }
 
+// HRInfo is a torrent's H&R requirement
+type HRInfo struct {
+	// HasHR reports whether the torrent is under H&R
+	HasHR bool `json:"hasHR"`
+	// HRSeedTimeH is the required seed time in hours, as parsed from the detail
+	// page or computed by CalcHRSeedTimeH; 0 means no seed time requirement
+	HRSeedTimeH int `json:"hrSeedTimeH,omitempty"`
+}
+
+// TimeToSatisfy returns how much longer a torrent seeded for
+// currentSeedSeconds must keep seeding to meet the H&R requirement, or 0 when
+// it is already met or there is none.
+func (h HRInfo) TimeToSatisfy(currentSeedSeconds int64) time.Duration {
+	if !h.HasHR || h.HRSeedTimeH <= 0 {
+		return 0
+	}
+	remaining := time.Duration(h.HRSeedTimeH)*time.Hour - time.Duration(max(currentSeedSeconds, 0))*time.Second
+	return max(remaining, 0)
+}
+
 // CalcHRSeedTimeH calculates the required HR seed time (hours) for a torrent.
 // Priority chain:
 //  1. HRCalcSeedTime — custom function (site-specific logic, e.g., ratio-based, tier-based)
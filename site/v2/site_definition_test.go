@@ -2,6 +2,7 @@ package v2
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -409,6 +410,18 @@ func TestCalcHRSeedTimeH_EmptyRulesFallback(t *testing.T) {
 	assert.Equal(t, 100, def.CalcHRSeedTimeH(50*1024*1024*1024))
 }
 
+func TestHRInfo_TimeToSatisfy(t *testing.T) {
+	hr := HRInfo{HasHR: true, HRSeedTimeH: 72}
+	assert.Equal(t, 72*time.Hour, hr.TimeToSatisfy(0))
+	assert.Equal(t, 22*time.Hour, hr.TimeToSatisfy(50*3600))
+	assert.Equal(t, time.Duration(0), hr.TimeToSatisfy(72*3600))
+	assert.Equal(t, time.Duration(0), hr.TimeToSatisfy(100*3600))
+	assert.Equal(t, 72*time.Hour, hr.TimeToSatisfy(-5))
+
+	assert.Equal(t, time.Duration(0), HRInfo{HRSeedTimeH: 72}.TimeToSatisfy(0))
+	assert.Equal(t, time.Duration(0), HRInfo{HasHR: true}.TimeToSatisfy(0))
+}
+
 func TestValidate_HRSeedTimeRules(t *testing.T) {
 	t.Run("valid rules", func(t *testing.T) {
 		def := SiteDefinition{
@@ -728,3 +741,14 @@ func TestDefaultDetailParserConfig(t *testing.T) {
 	assert.Equal(t, DiscountFree, cfg.DiscountMapping["free"])
 	assert.NotEmpty(t, cfg.HRKeywords)
 }
+
+func TestValidate_UserInfoSeedingRequestMethod(t *testing.T) {
+	def := makeMinimalNexusPHP("test")
+	def.UserInfo.SeedingRequestMethod = "post"
+	require.NoError(t, def.Validate())
+
+	def.UserInfo.SeedingRequestMethod = "PUT"
+	err := def.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "SeedingRequestMethod")
+}
@@ -1,7 +1,12 @@
 package v2
 
 import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 )
@@ -61,10 +66,30 @@ var DefaultSiteURLs = map[SiteName][]string{
 // It provides a centralized way to register and retrieve site URLs
 type SiteURLRegistry struct {
 	urls   map[SiteName][]string
+	health map[SiteName]map[string]URLHealth
 	mu     sync.RWMutex
 	logger *zap.Logger
+	// healthTimeout bounds each mirror probe in CheckURLHealth
+	healthTimeout time.Duration
 }
 
+// URLHealth is the result of probing one site mirror
+type URLHealth struct {
+	// URL is the probed base URL
+	URL string `json:"url"`
+	// Reachable is true when the mirror answered without a server error
+	Reachable bool `json:"reachable"`
+	// Latency is the time until the response headers arrived
+	Latency time.Duration `json:"latency"`
+	// Error describes why the mirror is unreachable
+	Error string `json:"error,omitempty"`
+	// CheckedAt is when the probe ran
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+// defaultURLHealthTimeout is the per-mirror probe timeout of CheckURLHealth
+const defaultURLHealthTimeout = 5 * time.Second
+
 // globalRegistry is the default global registry instance
 var (
 	globalRegistry *SiteURLRegistry
@@ -89,8 +114,10 @@ func NewSiteURLRegistry(logger *zap.Logger) *SiteURLRegistry {
 		logger = zap.NewNop()
 	}
 	return &SiteURLRegistry{
-		urls:   make(map[SiteName][]string),
-		logger: logger,
+		urls:          make(map[SiteName][]string),
+		health:        make(map[SiteName]map[string]URLHealth),
+		logger:        logger,
+		healthTimeout: defaultURLHealthTimeout,
 	}
 }
 
@@ -162,6 +189,120 @@ func (r *SiteURLRegistry) HasSite(siteName SiteName) bool {
 	return ok
 }
 
+// CheckURLHealth probes every mirror of a site concurrently with a GET of "/"
+// and records latency and reachability in memory for ReorderByHealth.
+// Results are returned in the site's current URL order.
+func (r *SiteURLRegistry) CheckURLHealth(ctx context.Context, siteName SiteName) []URLHealth {
+	urls := r.GetURLs(siteName)
+	if len(urls) == 0 {
+		return nil
+	}
+	r.mu.RLock()
+	timeout := r.healthTimeout
+	r.mu.RUnlock()
+
+	client := &http.Client{
+		Timeout: timeout,
+		// A redirect still proves the mirror is up
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	results := make([]URLHealth, len(urls))
+	var wg sync.WaitGroup
+	for i, u := range urls {
+		wg.Add(1)
+		go func(i int, u string) {
+			defer wg.Done()
+			results[i] = probeURL(ctx, client, u)
+		}(i, u)
+	}
+	wg.Wait()
+
+	r.mu.Lock()
+	byURL := make(map[string]URLHealth, len(results))
+	for _, h := range results {
+		byURL[h.URL] = h
+	}
+	r.health[siteName] = byURL
+	r.mu.Unlock()
+
+	for _, h := range results {
+		r.logger.Debug(
+			"Checked site URL health",
+			zap.String("site", siteName.String()),
+			zap.String("url", h.URL),
+			zap.Bool("reachable", h.Reachable),
+			zap.Duration("latency", h.Latency),
+		)
+	}
+	return results
+}
+
+// probeURL performs a single health probe of a base URL
+func probeURL(ctx context.Context, client *http.Client, baseURL string) URLHealth {
+	h := URLHealth{URL: baseURL, CheckedAt: time.Now()}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(baseURL, "/")+"/", nil)
+	if err != nil {
+		h.Error = err.Error()
+		return h
+	}
+	start := time.Now()
+	resp, err := client.Do(req)
+	h.Latency = time.Since(start)
+	if err != nil {
+		h.Error = err.Error()
+		return h
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusInternalServerError {
+		h.Error = resp.Status
+		return h
+	}
+	h.Reachable = true
+	return h
+}
+
+// ReorderByHealth sorts a site's URLs by the last CheckURLHealth results so
+// failover clients and drivers created afterwards prefer the healthiest
+// mirror: reachable mirrors by ascending latency, then unchecked ones, then
+// unreachable ones. The relative order is kept among equals. It returns the
+// new order.
+func (r *SiteURLRegistry) ReorderByHealth(siteName SiteName) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	urls, ok := r.urls[siteName]
+	if !ok {
+		return nil
+	}
+	health := r.health[siteName]
+	rank := func(u string) int {
+		h, checked := health[u]
+		switch {
+		case !checked:
+			return 1
+		case h.Reachable:
+			return 0
+		default:
+			return 2
+		}
+	}
+	sort.SliceStable(urls, func(i, j int) bool {
+		ri, rj := rank(urls[i]), rank(urls[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return ri == 0 && health[urls[i]].Latency < health[urls[j]].Latency
+	})
+
+	result := make([]string, len(urls))
+	copy(result, urls)
+	r.logger.Debug(
+		"Reordered site URLs by health",
+		zap.String("site", siteName.String()),
+		zap.Strings("urls", result),
+	)
+	return result
+}
+
 // SiteKindMap maps site names to their architecture kinds
 var SiteKindMap = map[SiteName]SiteKind{
 	SiteNameMTeam:        SiteMTorrent,
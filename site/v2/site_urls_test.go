@@ -1,7 +1,11 @@
 package v2
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,3 +29,64 @@ func TestGetSiteURLsForKind(t *testing.T) {
 	result := GetSiteURLsForKind(SiteNexusPHP)
 	assert.NotNil(t, result)
 }
+
+func TestSiteURLRegistry_CheckURLHealthAndReorder(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer slow.Close()
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer fast.Close()
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	down.Close()
+
+	reg := NewSiteURLRegistry(nil)
+	site := SiteName("mirrors")
+	reg.RegisterURLs(site, []string{down.URL, slow.URL, fast.URL})
+
+	health := reg.CheckURLHealth(context.Background(), site)
+	require.Len(t, health, 3)
+	assert.Equal(t, down.URL, health[0].URL)
+	assert.False(t, health[0].Reachable)
+	assert.NotEmpty(t, health[0].Error)
+	assert.True(t, health[1].Reachable)
+	assert.True(t, health[2].Reachable)
+	assert.Greater(t, health[1].Latency, health[2].Latency)
+
+	order := reg.ReorderByHealth(site)
+	assert.Equal(t, []string{fast.URL, slow.URL, down.URL}, order)
+	assert.Equal(t, order, reg.GetURLs(site))
+
+	cfg, err := reg.GetFailoverConfig(site)
+	require.NoError(t, err)
+	assert.Equal(t, fast.URL, cfg.BaseURLs[0])
+}
+
+func TestSiteURLRegistry_CheckURLHealthTimeout(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(300 * time.Millisecond)
+	}))
+	defer slow.Close()
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer broken.Close()
+
+	reg := NewSiteURLRegistry(nil)
+	reg.healthTimeout = 50 * time.Millisecond
+	site := SiteName("timeouts")
+	reg.RegisterURLs(site, []string{slow.URL, broken.URL})
+
+	health := reg.CheckURLHealth(context.Background(), site)
+	require.Len(t, health, 2)
+	assert.False(t, health[0].Reachable, "probe exceeding the timeout is unreachable")
+	assert.False(t, health[1].Reachable, "5xx responses are unhealthy")
+
+	// A URL added after the check ranks between healthy and unhealthy ones
+	reg.RegisterURLs(site, []string{slow.URL, "https://new.example", broken.URL})
+	assert.Equal(t, []string{"https://new.example", slow.URL, broken.URL}, reg.ReorderByHealth(site))
+	assert.Nil(t, reg.ReorderByHealth(SiteName("unknown")))
+}
@@ -39,6 +39,8 @@ type ParsedTorrent struct {
 	Files []TorrentFile `json:"files,omitempty"`
 	// PieceLength is the piece size in bytes
 	PieceLength int64 `json:"pieceLength"`
+	// PieceCount is the number of pieces
+	PieceCount int `json:"pieceCount"`
 	// Comment is the torrent comment
 	Comment string `json:"comment,omitempty"`
 	// CreatedBy is the creator of the torrent
@@ -171,9 +173,35 @@ func ParseTorrent(data []byte) (*ParsedTorrent, error) {
 		}
 	}
 
+	// Each piece has a 20-byte SHA1 hash; derive the count from the size when
+	// the pieces field is missing or malformed
+	if n := len(metainfo.Info.Pieces); n > 0 && n%sha1.Size == 0 {
+		parsed.PieceCount = n / sha1.Size
+	} else if parsed.PieceLength > 0 {
+		parsed.PieceCount = int((parsed.Size + parsed.PieceLength - 1) / parsed.PieceLength)
+	}
+
 	return parsed, nil
 }
 
+// EstimateRecheckSeconds estimates how long a client needs to hash-check the
+// torrent at hashRateMBs MiB/s. All content is read once; when the size is
+// unknown it is approximated by PieceCount*PieceLength. Returns 0 for unknown
+// rates or empty torrents.
+func EstimateRecheckSeconds(meta *ParsedTorrent, hashRateMBs float64) float64 {
+	if meta == nil || hashRateMBs <= 0 {
+		return 0
+	}
+	hashed := meta.Size
+	if hashed <= 0 {
+		hashed = int64(meta.PieceCount) * meta.PieceLength
+	}
+	if hashed <= 0 {
+		return 0
+	}
+	return float64(hashed) / (hashRateMBs * float64(MB))
+}
+
 // ParseTorrentFromFile parses a torrent file from path
 func ParseTorrentFromFile(path string) (*ParsedTorrent, error) {
 	data, err := os.ReadFile(path)
@@ -397,3 +397,41 @@ func TestParseTorrentFromFile(t *testing.T) {
 	_, err = ParseTorrentFromFile(filepath.Join(dir, "missing.torrent"))
 	assert.Error(t, err)
 }
+
+func TestParseTorrentFromFile_PieceInfo(t *testing.T) {
+	parsed, err := ParseTorrentFromFile("testdata/multi_piece.torrent")
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1024*1024), parsed.PieceLength)
+	assert.Equal(t, int64(4719592), parsed.Size)
+	wantPieces := int((parsed.Size + parsed.PieceLength - 1) / parsed.PieceLength)
+	assert.Equal(t, wantPieces, parsed.PieceCount)
+	assert.Equal(t, 5, parsed.PieceCount)
+}
+
+func TestParseTorrent_PieceCountWithoutPieces(t *testing.T) {
+	data, err := bencode.EncodeBytes(map[string]any{
+		"info": map[string]any{
+			"name":         "no-pieces",
+			"piece length": int64(16384),
+			"length":       int64(16384*3 + 1),
+		},
+	})
+	require.NoError(t, err)
+
+	parsed, err := ParseTorrent(data)
+	require.NoError(t, err)
+	assert.Equal(t, 4, parsed.PieceCount)
+}
+
+func TestEstimateRecheckSeconds(t *testing.T) {
+	meta := &ParsedTorrent{Size: 10 * GB, PieceLength: 4 * MB, PieceCount: 2560}
+	assert.InDelta(t, 51.2, EstimateRecheckSeconds(meta, 200), 0.001)
+
+	sizeless := &ParsedTorrent{PieceLength: 4 * MB, PieceCount: 25}
+	assert.InDelta(t, 1.0, EstimateRecheckSeconds(sizeless, 100), 0.001)
+
+	assert.Zero(t, EstimateRecheckSeconds(meta, 0))
+	assert.Zero(t, EstimateRecheckSeconds(nil, 100))
+	assert.Zero(t, EstimateRecheckSeconds(&ParsedTorrent{}, 100))
+}
@@ -6,6 +6,9 @@ package v2
 import (
 	"context"
 	"errors"
+	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/sunerpy/pt-tools/utils"
@@ -33,6 +36,7 @@ var (
 	ErrNetworkError       = errors.New("network error")
 	ErrCircuitOpen        = errors.New("circuit breaker open")
 	ErrNotImplemented     = errors.New("not implemented")
+	ErrNoPasskey          = errors.New("passkey not configured")
 )
 
 // SiteKind represents the type of PT site architecture
@@ -163,16 +167,33 @@ const (
 	DiscountPercent30 DiscountLevel = "PERCENT_30"
 	// DiscountPercent70 represents 70% download counting
 	DiscountPercent70 DiscountLevel = "PERCENT_70"
+	// DiscountPercent25 represents 25% download counting
+	DiscountPercent25 DiscountLevel = "PERCENT_25"
+	// DiscountPercent75 represents 75% download counting
+	DiscountPercent75 DiscountLevel = "PERCENT_75"
 	// Discount2xUp represents 2x upload counting
 	Discount2xUp DiscountLevel = "2XUP"
 	// Discount2x50 represents 2x upload and 50% download counting
 	Discount2x50 DiscountLevel = "2X50"
+	// Discount2x25 represents 2x upload and 25% download counting
+	Discount2x25 DiscountLevel = "2X25"
+	// Discount2x30 represents 2x upload and 30% download counting
+	Discount2x30 DiscountLevel = "2X30"
+	// Discount2x70 represents 2x upload and 70% download counting
+	Discount2x70 DiscountLevel = "2X70"
+	// Discount2x75 represents 2x upload and 75% download counting
+	Discount2x75 DiscountLevel = "2X75"
+	// DiscountNeutral represents a neutral (中性) torrent: download is free
+	// and upload does not count either
+	DiscountNeutral DiscountLevel = "NEUTRAL"
 )
 
-// FreeDiscountLevels contains all discount levels that result in free download
+// FreeDiscountLevels contains all discount levels that result in free download.
+// Neutral counts as free: its download is not counted, matching CheckRatioRisk
 var FreeDiscountLevels = []DiscountLevel{
 	DiscountFree,
 	Discount2xFree,
+	DiscountNeutral,
 }
 
 // IsFreeTorrent checks if the given discount level results in free download
@@ -188,23 +209,71 @@ func IsFreeTorrent(level DiscountLevel) bool {
 // GetDownloadRatio returns the download counting ratio (0.0 = free, 1.0 = normal)
 func (d DiscountLevel) GetDownloadRatio() float64 {
 	switch d {
-	case DiscountFree, Discount2xFree:
+	case DiscountFree, Discount2xFree, DiscountNeutral:
 		return 0.0
-	case DiscountPercent30:
+	case DiscountPercent25, Discount2x25:
+		return 0.25
+	case DiscountPercent30, Discount2x30:
 		return 0.3
 	case DiscountPercent50, Discount2x50:
 		return 0.5
-	case DiscountPercent70:
+	case DiscountPercent70, Discount2x70:
 		return 0.7
+	case DiscountPercent75, Discount2x75:
+		return 0.75
 	default:
 		return 1.0
 	}
 }
 
-// GetUploadRatio returns the upload counting ratio (1.0 = normal, 2.0 = double)
+// CombineDiscountLevels merges the levels of several promotion icons on one
+// torrent, taking the best download and the best upload dimension, e.g.
+// free + 2xup is 2xfree and 30% + 2xup is 2x30. Every download ratio has a
+// 2x upload counterpart, so both dimensions are always kept; the best single
+// level by DiscountPriority is only a fallback for unknown levels.
+func CombineDiscountLevels(levels ...DiscountLevel) DiscountLevel {
+	best := DiscountNone
+	download, upload := 1.0, 0.0
+	for _, level := range levels {
+		download = min(download, level.GetDownloadRatio())
+		upload = max(upload, level.GetUploadRatio())
+		if DiscountPriority(level) > DiscountPriority(best) {
+			best = level
+		}
+	}
+	for _, level := range combinedDiscountLevels {
+		if level.GetDownloadRatio() == download && level.GetUploadRatio() == upload {
+			return level
+		}
+	}
+	return best
+}
+
+// combinedDiscountLevels lists the levels CombineDiscountLevels can produce
+var combinedDiscountLevels = []DiscountLevel{
+	DiscountNone,
+	DiscountFree,
+	Discount2xFree,
+	DiscountPercent25,
+	DiscountPercent30,
+	DiscountPercent50,
+	DiscountPercent70,
+	DiscountPercent75,
+	Discount2xUp,
+	Discount2x50,
+	Discount2x25,
+	Discount2x30,
+	Discount2x70,
+	Discount2x75,
+	DiscountNeutral,
+}
+
+// GetUploadRatio returns the upload counting ratio (0.0 = not counted, 1.0 = normal, 2.0 = double)
 func (d DiscountLevel) GetUploadRatio() float64 {
 	switch d {
-	case Discount2xFree, Discount2xUp, Discount2x50:
+	case DiscountNeutral:
+		return 0.0
+	case Discount2xFree, Discount2xUp, Discount2x50, Discount2x25, Discount2x30, Discount2x70, Discount2x75:
 		return 2.0
 	default:
 		return 1.0
@@ -235,6 +304,23 @@ type SearchQuery struct {
 	SortBy string `json:"sortBy,omitempty"`
 	// OrderDesc specifies descending order when true
 	OrderDesc bool `json:"orderDesc,omitempty"`
+	// IMDbID searches by IMDb id ("tt1234567" or "1234567"); drivers that
+	// support it send it in place of Keyword
+	IMDbID string `json:"imdbId,omitempty"`
+	// MinSnatched drops results completed fewer times than this; rows on
+	// sites without a snatched column count as zero
+	MinSnatched int `json:"minSnatched,omitempty"`
+}
+
+var imdbIDRegex = regexp.MustCompile(`^(?i:tt)?\d+$`)
+
+// NormalizedIMDbID returns IMDbID in "tt1234567" form, or "" when unset
+func (q *SearchQuery) NormalizedIMDbID() string {
+	id := strings.TrimSpace(q.IMDbID)
+	if id == "" {
+		return ""
+	}
+	return "tt" + strings.TrimPrefix(strings.ToLower(id), "tt")
 }
 
 // Validate validates the search query
@@ -245,6 +331,12 @@ func (q *SearchQuery) Validate() error {
 	if q.PageSize < 0 {
 		return errors.New("pageSize must be non-negative")
 	}
+	if q.MinSnatched < 0 {
+		return errors.New("minSnatched must be non-negative")
+	}
+	if id := strings.TrimSpace(q.IMDbID); id != "" && !imdbIDRegex.MatchString(id) {
+		return fmt.Errorf("invalid imdbId %q", q.IMDbID)
+	}
 	return nil
 }
 
@@ -258,6 +350,12 @@ type TorrentItem struct {
 	Title string `json:"title"`
 	// Subtitle is the torrent subtitle (副标题)
 	Subtitle string `json:"subtitle,omitempty"`
+	// GroupID is the site's group/collection (合集) identifier linking editions
+	// of the same title; empty when the site has no groups
+	GroupID string `json:"groupId,omitempty"`
+	// UploaderClass is the uploader's user class (e.g. "Uploader" for 发布员),
+	// a hint of how trusted the release is; empty when not parsed
+	UploaderClass string `json:"uploaderClass,omitempty"`
 	// InfoHash is the torrent info hash (if available)
 	InfoHash string `json:"infoHash,omitempty"`
 	// Magnet is the magnet link (if available)
@@ -282,10 +380,27 @@ type TorrentItem struct {
 	DiscountEndTime time.Time `json:"discountEndTime,omitempty"`
 	// HasHR indicates if the torrent has H&R requirements
 	HasHR bool `json:"hasHR,omitempty"`
+	// IsOfficial indicates an official/original (官方/原创) upload
+	IsOfficial bool `json:"isOfficial,omitempty"`
+	// IsExclusive indicates an internal/exclusive (内部/禁转) release, which
+	// sites often pair with special seeding rules
+	IsExclusive bool `json:"isExclusive,omitempty"`
+	// IsFirstRelease indicates a site-first release (本站首发/首发)
+	IsFirstRelease bool `json:"isFirstRelease,omitempty"`
+	// Pending indicates the torrent awaits moderation (待审核) and cannot be
+	// downloaded yet; see IsApproved
+	Pending bool `json:"pending,omitempty"`
 	// DownloadURL is the direct download URL
 	DownloadURL string `json:"downloadUrl,omitempty"`
 	// Category is the torrent category
 	Category string `json:"category,omitempty"`
+	// NormalizedCategory is Category mapped through the site's CategoryMapping
+	// to a canonical value such as "movie" or "tv"; the raw value when unmapped
+	NormalizedCategory string `json:"normalizedCategory,omitempty"`
+	// PosterURL is the poster/cover image URL (if available)
+	PosterURL string `json:"posterUrl,omitempty"`
+	// HRSatisfied is the current user's H&R status for this torrent (nil when unknown)
+	HRSatisfied *bool `json:"hrSatisfied,omitempty"`
 }
 
 // IsFree returns true if the torrent is currently free
@@ -293,6 +408,16 @@ func (t *TorrentItem) IsFree() bool {
 	return IsFreeTorrent(t.DiscountLevel)
 }
 
+// IsFreeAndDoubleUp returns true when download is free and upload counts double
+func (t *TorrentItem) IsFreeAndDoubleUp() bool {
+	return t.DiscountLevel.GetDownloadRatio() == 0 && t.DiscountLevel.GetUploadRatio() >= 2
+}
+
+// IsApproved returns true unless the torrent is pending moderation
+func (t *TorrentItem) IsApproved() bool {
+	return !t.Pending
+}
+
 // IsDiscountActive returns true if the discount is still active
 func (t *TorrentItem) IsDiscountActive() bool {
 	if t.DiscountLevel == DiscountNone {
@@ -360,6 +485,26 @@ func (t *TorrentItem) GetSubTitle() string {
 	return result
 }
 
+// SiteStats holds the site-wide counters shown on a site's homepage
+type SiteStats struct {
+	// OnlineUsers is the number of users currently online
+	OnlineUsers int `json:"onlineUsers"`
+	// TorrentsToday is the number of torrents uploaded today
+	TorrentsToday int `json:"torrentsToday"`
+	// TotalTorrents is the total number of torrents on the site
+	TotalTorrents int `json:"totalTorrents"`
+}
+
+// Peer is one connection in a torrent's peer list
+type Peer struct {
+	// IsSeeder reports whether the peer has the complete torrent
+	IsSeeder bool `json:"isSeeder"`
+	// Client is the BitTorrent client name and version the peer reports
+	Client string `json:"client,omitempty"`
+	// Progress is the peer's completion as a fraction from 0 to 1
+	Progress float64 `json:"progress"`
+}
+
 // UserInfo represents user information from a PT site
 type UserInfo struct {
 	// Site is the site identifier
@@ -388,6 +533,10 @@ type UserInfo struct {
 	LastAccess int64 `json:"lastAccess,omitempty"`
 	// LastLogin is the last login time (Unix seconds)
 	LastLogin int64 `json:"lastLogin,omitempty"`
+	// SeedingTimeSeconds is the accumulated seeding time (做种时间)
+	SeedingTimeSeconds int64 `json:"seedingTimeSeconds,omitempty"`
+	// LeechingTimeSeconds is the accumulated downloading time (下载时间)
+	LeechingTimeSeconds int64 `json:"leechingTimeSeconds,omitempty"`
 	// LastUpdate is when this info was last updated (Unix seconds)
 	LastUpdate int64 `json:"lastUpdate"`
 	// NextLevel contains level progression info (optional)
@@ -404,6 +553,9 @@ type UserInfo struct {
 	SeedingBonus float64 `json:"seedingBonus,omitempty"`
 	// SeedingBonusPerHour is the seeding bonus per hour
 	SeedingBonusPerHour float64 `json:"seedingBonusPerHour,omitempty"`
+	// BonusExchangeRate is the bonus points needed per GiB of upload credit in
+	// the bonus shop (积分商城); 0 when the site does not expose it
+	BonusExchangeRate float64 `json:"bonusExchangeRate,omitempty"`
 	// UnreadMessageCount is the number of unread messages
 	UnreadMessageCount int `json:"unreadMessageCount,omitempty"`
 	// TotalMessageCount is the total number of messages
@@ -424,8 +576,23 @@ type UserInfo struct {
 	TrueUploaded int64 `json:"trueUploaded,omitempty"`
 	// TrueDownloaded is the true downloaded bytes
 	TrueDownloaded int64 `json:"trueDownloaded,omitempty"`
+	// UploadedToday is the traffic uploaded today (今日上传), for daily-cap awareness
+	UploadedToday int64 `json:"uploadedToday,omitempty"`
+	// DownloadedToday is the traffic downloaded today (今日下载)
+	DownloadedToday int64 `json:"downloadedToday,omitempty"`
 	// Uploads is the number of torrents uploaded by user
 	Uploads int `json:"uploads,omitempty"`
+	// Invites is the number of invitations available (邀请)
+	Invites int `json:"invites,omitempty"`
+}
+
+// MeetsMinRatio reports whether the user's ratio satisfies the detail page's
+// minimum-ratio-to-download requirement. An infinite ratio always qualifies.
+func (u UserInfo) MeetsMinRatio(detail TorrentDetail) bool {
+	if detail.MinRatioRequired <= 0 || u.Ratio < 0 {
+		return true
+	}
+	return u.Ratio >= detail.MinRatioRequired
 }
 
 // LevelProgress represents progress towards the next user level
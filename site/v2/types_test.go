@@ -22,6 +22,8 @@ func TestDiscountLevelConstants(t *testing.T) {
 	assert.Equal(t, DiscountLevel("PERCENT_50"), DiscountPercent50)
 	assert.Equal(t, DiscountLevel("PERCENT_30"), DiscountPercent30)
 	assert.Equal(t, DiscountLevel("PERCENT_70"), DiscountPercent70)
+	assert.Equal(t, DiscountLevel("PERCENT_25"), DiscountPercent25)
+	assert.Equal(t, DiscountLevel("PERCENT_75"), DiscountPercent75)
 	assert.Equal(t, DiscountLevel("2XUP"), Discount2xUp)
 	assert.Equal(t, DiscountLevel("2X50"), Discount2x50)
 }
@@ -39,6 +41,7 @@ func TestIsFreeTorrent(t *testing.T) {
 		{DiscountPercent70, false},
 		{Discount2xUp, false},
 		{Discount2x50, false},
+		{DiscountNeutral, true},
 	}
 
 	for _, tt := range tests {
@@ -59,6 +62,8 @@ func TestDiscountLevel_GetDownloadRatio(t *testing.T) {
 		{DiscountPercent50, 0.5},
 		{DiscountPercent30, 0.3},
 		{DiscountPercent70, 0.7},
+		{DiscountPercent25, 0.25},
+		{DiscountPercent75, 0.75},
 		{Discount2xUp, 1.0},
 		{Discount2x50, 0.5},
 	}
@@ -203,9 +208,10 @@ func TestTorrentItem_IsDiscountActive(t *testing.T) {
 }
 
 func TestFreeDiscountLevels(t *testing.T) {
-	require.Len(t, FreeDiscountLevels, 2)
+	require.Len(t, FreeDiscountLevels, 3)
 	assert.Contains(t, FreeDiscountLevels, DiscountFree)
 	assert.Contains(t, FreeDiscountLevels, Discount2xFree)
+	assert.Contains(t, FreeDiscountLevels, DiscountNeutral)
 }
 
 func TestSchema_DefaultAuthMethod(t *testing.T) {
@@ -32,6 +32,7 @@ type UserInfoRecord struct {
 	BonusPerHour        float64 `json:"bonusPerHour"`
 	SeedingBonus        float64 `json:"seedingBonus"`
 	SeedingBonusPerHour float64 `json:"seedingBonusPerHour"`
+	BonusExchangeRate   float64 `json:"bonusExchangeRate"`
 	UnreadMessageCount  int     `json:"unreadMessageCount"`
 	TotalMessageCount   int     `json:"totalMessageCount"`
 	SeederCount         int     `json:"seederCount"`
@@ -71,6 +72,7 @@ func (r *UserInfoRecord) ToUserInfo() UserInfo {
 		BonusPerHour:        r.BonusPerHour,
 		SeedingBonus:        r.SeedingBonus,
 		SeedingBonusPerHour: r.SeedingBonusPerHour,
+		BonusExchangeRate:   r.BonusExchangeRate,
 		UnreadMessageCount:  r.UnreadMessageCount,
 		TotalMessageCount:   r.TotalMessageCount,
 		SeederCount:         r.SeederCount,
@@ -106,6 +108,7 @@ func FromUserInfo(info UserInfo) UserInfoRecord {
 		BonusPerHour:        info.BonusPerHour,
 		SeedingBonus:        info.SeedingBonus,
 		SeedingBonusPerHour: info.SeedingBonusPerHour,
+		BonusExchangeRate:   info.BonusExchangeRate,
 		UnreadMessageCount:  info.UnreadMessageCount,
 		TotalMessageCount:   info.TotalMessageCount,
 		SeederCount:         info.SeederCount,
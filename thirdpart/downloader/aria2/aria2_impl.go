@@ -0,0 +1,885 @@
+package aria2
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+	"github.com/sunerpy/pt-tools/thirdpart/downloader/qbit"
+)
+
+// Aria2Client Aria2 客户端实现
+// 通过 HTTP JSON-RPC 通信，配置了 RPC 令牌时每个请求的第一个参数为 "token:xxx"
+type Aria2Client struct {
+	name         string
+	rpcURL       string
+	secret       string
+	autoStart    bool
+	client       downloader.HTTPDoer
+	requestID    int
+	mu           sync.Mutex
+	healthy      bool
+	lastActivity time.Time
+}
+
+// 确保 Aria2Client 实现 Downloader 接口
+var _ downloader.Downloader = (*Aria2Client)(nil)
+
+// errUnauthorized Aria2 令牌错误时返回的错误码
+const errUnauthorized = 1
+
+// listPageSize tellWaiting/tellStopped 单次拉取的数量
+const listPageSize = 1000
+
+// errUnsupported 表示 Aria2 没有对应功能
+var errUnsupported = errors.New("aria2 does not support this operation")
+
+// Aria2 JSON-RPC 请求/响应结构
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      string `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("RPC error %d: %s", e.Code, e.Message)
+}
+
+type rpcResponse struct {
+	ID     string          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+// statusKeys 列表查询的字段
+var statusKeys = []string{
+	"gid", "status", "totalLength", "completedLength", "uploadLength",
+	"downloadSpeed", "uploadSpeed", "infoHash", "numSeeders", "connections",
+	"dir", "files", "bittorrent", "seeder", "errorMessage",
+}
+
+// aria2Status Aria2 下载状态，数值字段均以字符串返回
+type aria2Status struct {
+	GID             string      `json:"gid"`
+	Status          string      `json:"status"` // active, waiting, paused, error, complete, removed
+	TotalLength     string      `json:"totalLength"`
+	CompletedLength string      `json:"completedLength"`
+	UploadLength    string      `json:"uploadLength"`
+	DownloadSpeed   string      `json:"downloadSpeed"`
+	UploadSpeed     string      `json:"uploadSpeed"`
+	InfoHash        string      `json:"infoHash"`
+	NumSeeders      string      `json:"numSeeders"`
+	Connections     string      `json:"connections"`
+	Dir             string      `json:"dir"`
+	Seeder          string      `json:"seeder"`
+	ErrorMessage    string      `json:"errorMessage"`
+	Files           []aria2File `json:"files"`
+	Bittorrent      *struct {
+		AnnounceList [][]string `json:"announceList"`
+		Info         struct {
+			Name string `json:"name"`
+		} `json:"info"`
+	} `json:"bittorrent"`
+}
+
+type aria2File struct {
+	Index           string `json:"index"`
+	Path            string `json:"path"`
+	Length          string `json:"length"`
+	CompletedLength string `json:"completedLength"`
+	Selected        string `json:"selected"`
+}
+
+// parseInt64 解析 Aria2 以字符串返回的数值，失败返回 0
+func parseInt64(s string) int64 {
+	v, _ := strconv.ParseInt(s, 10, 64)
+	return v
+}
+
+// NewAria2Client 创建新的 Aria2 客户端
+// config.GetPassword() 作为 RPC 令牌使用
+func NewAria2Client(config downloader.DownloaderConfig, name string) (downloader.Downloader, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	client := &Aria2Client{
+		name:      name,
+		rpcURL:    rpcEndpoint(config.GetURL()),
+		secret:    config.GetPassword(),
+		autoStart: config.GetAutoStart(),
+		client:    downloader.NewRequestsHTTPDoer(config.GetURL(), downloader.RequestTimeout(config)),
+		healthy:   false,
+	}
+
+	if err := client.Authenticate(); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// GetType 获取下载器类型
+func (a *Aria2Client) GetType() downloader.DownloaderType {
+	return downloader.DownloaderAria2
+}
+
+// Capabilities 获取 Aria2 支持的功能
+// Aria2 没有分类和标签
+func (a *Aria2Client) Capabilities() downloader.Capabilities {
+	return downloader.Capabilities{
+		Magnet:               true,
+		Categories:           false,
+		Tags:                 false,
+		LabelsAsArray:        false,
+		ForceStart:           false,
+		SuperSeeding:         false,
+		SkipHashCheck:        false,
+		PerTorrentSpeedLimit: true,
+	}
+}
+
+// GetName 获取下载器实例名称
+func (a *Aria2Client) GetName() string {
+	return a.name
+}
+
+// IsHealthy 检查下载器是否健康可用
+func (a *Aria2Client) IsHealthy() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.healthy
+}
+
+// Close 关闭下载器连接
+func (a *Aria2Client) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.healthy = false
+	if closer, ok := a.client.(interface{ Close() error }); ok {
+		_ = closer.Close()
+	}
+	return nil
+}
+
+// Authenticate 通过 aria2.getVersion 验证 RPC 令牌
+func (a *Aria2Client) Authenticate() error {
+	_, err := a.call("aria2.getVersion")
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err != nil {
+		a.healthy = false
+		var rpcErr *rpcError
+		if errors.As(err, &rpcErr) && rpcErr.Code == errUnauthorized {
+			return fmt.Errorf("%w: invalid Aria2 RPC secret", downloader.ErrAuthenticationFailed)
+		}
+		return err
+	}
+
+	a.healthy = true
+	sLogger().Info("Successfully connected to Aria2")
+	return nil
+}
+
+func (a *Aria2Client) wrapConnectionError(err error) error {
+	errStr := err.Error()
+	switch {
+	case strings.Contains(errStr, "connection refused"):
+		return fmt.Errorf("连接被拒绝，请检查: 1) Aria2 是否以 --enable-rpc 启动 2) 端口是否正确(默认6800) (原始错误: %w)", err)
+	case strings.Contains(errStr, "no such host"):
+		return fmt.Errorf("无法解析主机名，请检查 URL 地址是否正确 (原始错误: %w)", err)
+	case strings.Contains(errStr, "timeout") || strings.Contains(errStr, "deadline exceeded"):
+		return fmt.Errorf("连接超时，请检查: 1) 网络是否可达 2) 防火墙设置 3) URL 地址是否正确 (原始错误: %w)", err)
+	default:
+		return fmt.Errorf("连接失败: %w", err)
+	}
+}
+
+// call 执行 RPC 调用，自动在参数前插入令牌
+func (a *Aria2Client) call(method string, params ...any) (json.RawMessage, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.client == nil {
+		return nil, fmt.Errorf("client is closed")
+	}
+
+	if a.secret != "" {
+		params = append([]any{"token:" + a.secret}, params...)
+	}
+	if params == nil {
+		params = []any{}
+	}
+
+	a.requestID++
+	body, err := json.Marshal(rpcRequest{
+		JSONRPC: "2.0",
+		ID:      strconv.Itoa(a.requestID),
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "POST", a.rpcURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, a.wrapConnectionError(err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	// Aria2 对 RPC 错误同样返回 JSON 正文（状态码可能为 400），优先解析错误信息
+	var rpcResp rpcResponse
+	if err := json.Unmarshal(respBody, &rpcResp); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(respBody))
+		}
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if rpcResp.Error != nil {
+		return nil, rpcResp.Error
+	}
+
+	a.lastActivity = time.Now()
+	return rpcResp.Result, nil
+}
+
+// callInto 执行 RPC 调用并将结果解析到 out
+func (a *Aria2Client) callInto(out any, method string, params ...any) error {
+	result, err := a.call(method, params...)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(result, out); err != nil {
+		return fmt.Errorf("failed to parse %s response: %w", method, err)
+	}
+	return nil
+}
+
+// Ping 检查下载器连接是否正常
+func (a *Aria2Client) Ping() (bool, error) {
+	_, err := a.call("aria2.getVersion")
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.healthy = err == nil
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetClientVersion 获取下载器版本
+func (a *Aria2Client) GetClientVersion() (string, error) {
+	var info struct {
+		Version string `json:"version"`
+	}
+	if err := a.callInto(&info, "aria2.getVersion"); err != nil {
+		return "", fmt.Errorf("failed to get version: %w", err)
+	}
+	return info.Version, nil
+}
+
+// GetClientStatus 获取下载器状态
+// aria2.getGlobalStat 只提供实时速度，不提供累计流量
+func (a *Aria2Client) GetClientStatus() (downloader.ClientStatus, error) {
+	var stat struct {
+		DownloadSpeed string `json:"downloadSpeed"`
+		UploadSpeed   string `json:"uploadSpeed"`
+	}
+	if err := a.callInto(&stat, "aria2.getGlobalStat"); err != nil {
+		return downloader.ClientStatus{}, fmt.Errorf("failed to get global stat: %w", err)
+	}
+	return downloader.ClientStatus{
+		UpSpeed: parseInt64(stat.UploadSpeed),
+		DlSpeed: parseInt64(stat.DownloadSpeed),
+	}, nil
+}
+
+// GetDiskSpace Aria2 的 RPC 不提供磁盘可用空间
+func (a *Aria2Client) GetDiskSpace(ctx context.Context) (int64, error) {
+	return 0, fmt.Errorf("failed to get free space: %w", errUnsupported)
+}
+
+// GetClientFreeSpace 获取下载器所在磁盘的可用空间
+func (a *Aria2Client) GetClientFreeSpace(ctx context.Context) (int64, error) {
+	return a.GetDiskSpace(ctx)
+}
+
+// CanAddTorrent 检查是否可以添加指定大小的种子
+func (a *Aria2Client) CanAddTorrent(ctx context.Context, fileSize int64) (bool, error) {
+	freeSpace, err := a.GetDiskSpace(ctx)
+	if err != nil {
+		return false, err
+	}
+	return fileSize <= freeSpace, nil
+}
+
+// listAll 拉取活动、等待（含暂停）与已停止的全部任务
+func (a *Aria2Client) listAll(keys []string) ([]aria2Status, error) {
+	var active, waiting, stopped []aria2Status
+	if err := a.callInto(&active, "aria2.tellActive", keys); err != nil {
+		return nil, fmt.Errorf("failed to list active downloads: %w", err)
+	}
+	if err := a.callInto(&waiting, "aria2.tellWaiting", 0, listPageSize, keys); err != nil {
+		return nil, fmt.Errorf("failed to list waiting downloads: %w", err)
+	}
+	if err := a.callInto(&stopped, "aria2.tellStopped", 0, listPageSize, keys); err != nil {
+		return nil, fmt.Errorf("failed to list stopped downloads: %w", err)
+	}
+
+	all := make([]aria2Status, 0, len(active)+len(waiting)+len(stopped))
+	all = append(all, active...)
+	all = append(all, waiting...)
+	all = append(all, stopped...)
+	return all, nil
+}
+
+// GetIncompletePendingBytes 聚合所有未完成任务还需下载的字节数
+// 计入 active（非做种）、waiting、paused 状态
+func (a *Aria2Client) GetIncompletePendingBytes(_ context.Context) (int64, error) {
+	statuses, err := a.listAll([]string{"status", "totalLength", "completedLength", "seeder"})
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for _, st := range statuses {
+		switch st.Status {
+		case "active", "waiting", "paused":
+		default:
+			continue
+		}
+		if st.Seeder == "true" {
+			continue
+		}
+		if left := parseInt64(st.TotalLength) - parseInt64(st.CompletedLength); left > 0 {
+			total += left
+		}
+	}
+	return total, nil
+}
+
+// GetAllTorrents 获取所有 BT 任务（忽略普通 HTTP/FTP 下载）
+func (a *Aria2Client) GetAllTorrents() ([]downloader.Torrent, error) {
+	statuses, err := a.listAll(statusKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	torrents := make([]downloader.Torrent, 0, len(statuses))
+	for _, st := range statuses {
+		if st.InfoHash == "" {
+			continue
+		}
+		torrents = append(torrents, a.mapAria2Torrent(st))
+	}
+	return torrents, nil
+}
+
+// mapAria2Torrent 将 Aria2 任务映射到通用 Torrent 结构
+func (a *Aria2Client) mapAria2Torrent(st aria2Status) downloader.Torrent {
+	total := parseInt64(st.TotalLength)
+	completed := parseInt64(st.CompletedLength)
+	uploaded := parseInt64(st.UploadLength)
+
+	progress := 0.0
+	if total > 0 {
+		progress = float64(completed) / float64(total)
+	}
+	ratio := 0.0
+	if completed > 0 {
+		ratio = float64(uploaded) / float64(completed)
+	}
+
+	name := ""
+	tracker := ""
+	if st.Bittorrent != nil {
+		name = st.Bittorrent.Info.Name
+		if len(st.Bittorrent.AnnounceList) > 0 && len(st.Bittorrent.AnnounceList[0]) > 0 {
+			tracker = st.Bittorrent.AnnounceList[0][0]
+		}
+	}
+	if name == "" && len(st.Files) > 0 {
+		name = filepath.Base(st.Files[0].Path)
+	}
+
+	amountLeft := total - completed
+	if amountLeft < 0 {
+		amountLeft = 0
+	}
+	downloadSpeed := parseInt64(st.DownloadSpeed)
+	eta := int64(-1)
+	if amountLeft == 0 {
+		eta = 0
+	} else if downloadSpeed > 0 {
+		eta = amountLeft / downloadSpeed
+	}
+
+	return downloader.Torrent{
+		ID:              st.GID,
+		InfoHash:        strings.ToLower(st.InfoHash),
+		Name:            name,
+		Progress:        progress,
+		IsCompleted:     total > 0 && completed >= total,
+		Ratio:           ratio,
+		SavePath:        st.Dir,
+		State:           mapAria2State(st),
+		TotalSize:       total,
+		AmountLeft:      amountLeft,
+		UploadSpeed:     parseInt64(st.UploadSpeed),
+		DownloadSpeed:   downloadSpeed,
+		ETA:             eta,
+		Tracker:         tracker,
+		NumSeeds:        int(parseInt64(st.NumSeeders)),
+		NumPeers:        int(parseInt64(st.Connections)),
+		ContentPath:     filepath.Join(st.Dir, name),
+		TotalUploaded:   uploaded,
+		TotalDownloaded: completed,
+		ClientID:        a.name,
+		Raw:             st,
+	}
+}
+
+// mapAria2State 将 Aria2 状态映射到通用状态
+func mapAria2State(st aria2Status) downloader.TorrentState {
+	switch st.Status {
+	case "active":
+		if st.Seeder == "true" {
+			return downloader.TorrentSeeding
+		}
+		return downloader.TorrentDownloading
+	case "waiting":
+		return downloader.TorrentQueued
+	case "paused":
+		return downloader.TorrentPaused
+	case "complete", "removed":
+		return downloader.TorrentStopped
+	case "error":
+		return downloader.TorrentError
+	default:
+		return downloader.TorrentUnknown
+	}
+}
+
+// GetTorrentsBy 根据过滤条件获取种子列表
+func (a *Aria2Client) GetTorrentsBy(filter downloader.TorrentFilter) ([]downloader.Torrent, error) {
+	allTorrents, err := a.GetAllTorrents()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(filter.IDs) == 0 && len(filter.Hashes) == 0 && filter.Complete == nil && filter.State == nil {
+		return allTorrents, nil
+	}
+
+	idSet := make(map[string]bool)
+	for _, id := range filter.IDs {
+		idSet[id] = true
+	}
+	hashSet := make(map[string]bool)
+	for _, hash := range filter.Hashes {
+		hashSet[strings.ToLower(hash)] = true
+	}
+
+	var filtered []downloader.Torrent
+	for _, torrent := range allTorrents {
+		if len(idSet) > 0 && !idSet[torrent.ID] {
+			continue
+		}
+		if len(hashSet) > 0 && !hashSet[torrent.InfoHash] {
+			continue
+		}
+		if filter.Complete != nil && torrent.IsCompleted != *filter.Complete {
+			continue
+		}
+		if filter.State != nil && torrent.State != *filter.State {
+			continue
+		}
+		filtered = append(filtered, torrent)
+	}
+
+	return filtered, nil
+}
+
+// GetTorrent 获取单个种子信息，id 可以是 GID 或 info hash
+func (a *Aria2Client) GetTorrent(id string) (downloader.Torrent, error) {
+	torrents, err := a.GetAllTorrents()
+	if err != nil {
+		return downloader.Torrent{}, err
+	}
+	for _, torrent := range torrents {
+		if torrent.ID == id || torrent.InfoHash == strings.ToLower(id) {
+			return torrent, nil
+		}
+	}
+	return downloader.Torrent{}, downloader.ErrTorrentNotFound
+}
+
+// CheckTorrentExists 比对所有任务的 info hash 判断种子是否存在
+func (a *Aria2Client) CheckTorrentExists(torrentHash string) (bool, error) {
+	statuses, err := a.listAll([]string{"gid", "infoHash"})
+	if err != nil {
+		return false, fmt.Errorf("failed to check torrent: %w", err)
+	}
+	for _, st := range statuses {
+		if st.InfoHash != "" && strings.EqualFold(st.InfoHash, torrentHash) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// buildAddOptions 将 AddTorrentOptions 转换为 Aria2 选项，Aria2 选项值均为字符串
+func buildAddOptions(opt downloader.AddTorrentOptions) map[string]any {
+	options := map[string]any{}
+	if opt.AddAtPaused {
+		options["pause"] = "true"
+	}
+	if opt.SavePath != "" {
+		options["dir"] = opt.SavePath
+	}
+	if upBytes := opt.EffectiveUploadLimitBytes(); upBytes > 0 {
+		options["max-upload-limit"] = strconv.FormatInt(upBytes, 10)
+	}
+	if dlBytes := opt.EffectiveDownloadLimitBytes(); dlBytes > 0 {
+		options["max-download-limit"] = strconv.FormatInt(dlBytes, 10)
+	}
+	for key, value := range opt.AdvanceOptions {
+		options[key] = fmt.Sprint(value)
+	}
+	return options
+}
+
+// isDuplicateError 判断是否为种子已存在的错误
+func isDuplicateError(err error) bool {
+	var rpcErr *rpcError
+	return errors.As(err, &rpcErr) && strings.Contains(rpcErr.Message, "already registered")
+}
+
+// AddTorrentEx 通过 URL 或磁力链接添加种子（新接口）
+func (a *Aria2Client) AddTorrentEx(torrentURL string, opt downloader.AddTorrentOptions) (downloader.AddTorrentResult, error) {
+	var gid string
+	if err := a.callInto(&gid, "aria2.addUri", []string{torrentURL}, buildAddOptions(opt)); err != nil {
+		if isDuplicateError(err) {
+			return downloader.AddTorrentResult{Success: true, Message: "Torrent already exists"}, nil
+		}
+		return downloader.AddTorrentResult{Success: false, Message: err.Error()}, err
+	}
+	return downloader.AddTorrentResult{Success: true, Message: "Torrent added successfully", ID: gid}, nil
+}
+
+// AddTorrentFileEx 通过 aria2.addTorrent 添加 base64 编码的种子文件（新接口）
+// Aria2 没有分类和标签，Category/Tags 会被忽略
+func (a *Aria2Client) AddTorrentFileEx(fileData []byte, opt downloader.AddTorrentOptions) (downloader.AddTorrentResult, error) {
+	hash, _ := qbit.ComputeTorrentHash(fileData)
+	metainfo := base64.StdEncoding.EncodeToString(fileData)
+
+	var gid string
+	if err := a.callInto(&gid, "aria2.addTorrent", metainfo, []string{}, buildAddOptions(opt)); err != nil {
+		if isDuplicateError(err) {
+			return downloader.AddTorrentResult{
+				Success: true,
+				Message: "Torrent already exists",
+				Hash:    hash,
+			}, nil
+		}
+		return downloader.AddTorrentResult{Success: false, Message: err.Error()}, err
+	}
+
+	if opt.Category != "" || opt.Tags != "" {
+		sLogger().Debugf("[Aria2] 不支持分类和标签，忽略: %s %s", opt.Category, opt.Tags)
+	}
+
+	return downloader.AddTorrentResult{
+		Success: true,
+		Message: "Torrent added successfully",
+		ID:      gid,
+		Hash:    hash,
+	}, nil
+}
+
+// AddTorrent 添加种子到 Aria2
+func (a *Aria2Client) AddTorrent(fileData []byte, category, tags string) error {
+	return a.AddTorrentWithPath(fileData, category, tags, "")
+}
+
+// AddTorrentWithPath 添加种子到 Aria2 并指定下载路径
+func (a *Aria2Client) AddTorrentWithPath(fileData []byte, category, tags, downloadPath string) error {
+	opt := downloader.AddTorrentOptions{
+		AddAtPaused: !a.autoStart,
+		SavePath:    downloadPath,
+		Category:    category,
+		Tags:        tags,
+	}
+	result, err := a.AddTorrentFileEx(fileData, opt)
+	if err != nil {
+		return fmt.Errorf("failed to add torrent: %w", err)
+	}
+	sLogger().Infof("[Aria2] %v: %s", result.Message, result.ID)
+	return nil
+}
+
+// PauseTorrent 暂停种子
+func (a *Aria2Client) PauseTorrent(id string) error {
+	return a.PauseTorrents([]string{id})
+}
+
+// ResumeTorrent 恢复种子
+func (a *Aria2Client) ResumeTorrent(id string) error {
+	return a.ResumeTorrents([]string{id})
+}
+
+// RemoveTorrent 删除种子
+func (a *Aria2Client) RemoveTorrent(id string, removeData bool) error {
+	return a.RemoveTorrents([]string{id}, removeData)
+}
+
+// forEachGID 对每个非空 GID 调用 method，遇到错误立即返回
+func (a *Aria2Client) forEachGID(ids []string, method string) error {
+	for _, id := range ids {
+		gid := strings.TrimSpace(id)
+		if gid == "" {
+			continue
+		}
+		if _, err := a.call(method, gid); err != nil {
+			return fmt.Errorf("%s %s: %w", method, gid, err)
+		}
+	}
+	return nil
+}
+
+// PauseTorrents 批量暂停种子
+func (a *Aria2Client) PauseTorrents(ids []string) error {
+	return a.forEachGID(ids, "aria2.pause")
+}
+
+// ResumeTorrents 批量恢复种子
+func (a *Aria2Client) ResumeTorrents(ids []string) error {
+	return a.forEachGID(ids, "aria2.unpause")
+}
+
+// RemoveTorrents 批量删除种子
+// 活动任务用 aria2.remove，已停止的任务用 aria2.removeDownloadResult 清除记录；
+// Aria2 不会删除已下载的文件
+func (a *Aria2Client) RemoveTorrents(ids []string, removeData bool) error {
+	if removeData {
+		sLogger().Warn("[Aria2] 不支持删除数据文件，仅移除任务")
+	}
+	for _, id := range ids {
+		gid := strings.TrimSpace(id)
+		if gid == "" {
+			continue
+		}
+		if _, err := a.call("aria2.remove", gid); err == nil {
+			continue
+		}
+		if _, err := a.call("aria2.removeDownloadResult", gid); err != nil {
+			return fmt.Errorf("failed to remove torrent %s: %w", gid, err)
+		}
+	}
+	return nil
+}
+
+// SetTorrentCategory Aria2 没有分类
+func (a *Aria2Client) SetTorrentCategory(id, category string) error {
+	return fmt.Errorf("set category: %w", errUnsupported)
+}
+
+// SetTorrentTags Aria2 没有标签
+func (a *Aria2Client) SetTorrentTags(id, tags string) error {
+	return fmt.Errorf("set tags: %w", errUnsupported)
+}
+
+// SetTorrentSavePath 修改任务保存目录，仅对等待或暂停中的任务生效
+func (a *Aria2Client) SetTorrentSavePath(id, path string) error {
+	if _, err := a.call("aria2.changeOption", id, map[string]string{"dir": path}); err != nil {
+		return fmt.Errorf("failed to set torrent save path: %w", err)
+	}
+	return nil
+}
+
+// RecheckTorrent Aria2 不支持对已有任务重新校验
+func (a *Aria2Client) RecheckTorrent(id string) error {
+	return fmt.Errorf("recheck: %w", errUnsupported)
+}
+
+// GetTorrentFiles 获取种子文件列表
+func (a *Aria2Client) GetTorrentFiles(id string) ([]downloader.TorrentFile, error) {
+	var files []aria2File
+	if err := a.callInto(&files, "aria2.getFiles", id); err != nil {
+		return nil, fmt.Errorf("failed to get torrent files: %w", err)
+	}
+
+	result := make([]downloader.TorrentFile, 0, len(files))
+	for _, file := range files {
+		length := parseInt64(file.Length)
+		progress := 0.0
+		if length > 0 {
+			progress = float64(parseInt64(file.CompletedLength)) / float64(length)
+		}
+		priority := 1
+		if file.Selected != "true" {
+			priority = 0
+		}
+		result = append(result, downloader.TorrentFile{
+			// Aria2 的文件索引从 1 开始
+			Index:    int(parseInt64(file.Index)) - 1,
+			Name:     file.Path,
+			Size:     length,
+			Progress: progress,
+			Priority: priority,
+		})
+	}
+	return result, nil
+}
+
+// GetTorrentTrackers 获取种子 Tracker 列表
+// Aria2 不报告 Tracker 状态，统一视为未联系
+func (a *Aria2Client) GetTorrentTrackers(id string) ([]downloader.TorrentTracker, error) {
+	var st aria2Status
+	if err := a.callInto(&st, "aria2.tellStatus", id, []string{"gid", "bittorrent"}); err != nil {
+		return nil, fmt.Errorf("failed to get torrent trackers: %w", err)
+	}
+	if st.Bittorrent == nil {
+		return nil, downloader.ErrTorrentNotFound
+	}
+
+	var trackers []downloader.TorrentTracker
+	for _, tier := range st.Bittorrent.AnnounceList {
+		for _, announce := range tier {
+			trackers = append(trackers, downloader.TorrentTracker{URL: announce, Status: 1})
+		}
+	}
+	return trackers, nil
+}
+
+// globalOptions 读取全局选项
+func (a *Aria2Client) globalOptions() (map[string]string, error) {
+	var options map[string]string
+	if err := a.callInto(&options, "aria2.getGlobalOption"); err != nil {
+		return nil, fmt.Errorf("failed to get global options: %w", err)
+	}
+	return options, nil
+}
+
+// GetDiskInfo 获取默认下载目录，Aria2 不提供可用空间
+func (a *Aria2Client) GetDiskInfo() (downloader.DiskInfo, error) {
+	options, err := a.globalOptions()
+	if err != nil {
+		return downloader.DiskInfo{}, err
+	}
+	return downloader.DiskInfo{Path: options["dir"]}, nil
+}
+
+// GetSpeedLimit 获取全局限速 (bytes/s)，0 表示不限
+func (a *Aria2Client) GetSpeedLimit() (downloader.SpeedLimit, error) {
+	options, err := a.globalOptions()
+	if err != nil {
+		return downloader.SpeedLimit{}, err
+	}
+	limit := downloader.SpeedLimit{
+		DownloadLimit: parseInt64(options["max-overall-download-limit"]),
+		UploadLimit:   parseInt64(options["max-overall-upload-limit"]),
+	}
+	limit.LimitEnabled = limit.DownloadLimit > 0 || limit.UploadLimit > 0
+	return limit, nil
+}
+
+// SetSpeedLimit 设置全局限速
+func (a *Aria2Client) SetSpeedLimit(limit downloader.SpeedLimit) error {
+	down, up := int64(0), int64(0)
+	if limit.LimitEnabled {
+		down, up = limit.DownloadLimit, limit.UploadLimit
+	}
+	options := map[string]string{
+		"max-overall-download-limit": strconv.FormatInt(down, 10),
+		"max-overall-upload-limit":   strconv.FormatInt(up, 10),
+	}
+	if _, err := a.call("aria2.changeGlobalOption", options); err != nil {
+		return fmt.Errorf("failed to set speed limits: %w", err)
+	}
+	return nil
+}
+
+// GetClientPaths 获取下载器配置的保存路径列表
+func (a *Aria2Client) GetClientPaths() ([]string, error) {
+	options, err := a.globalOptions()
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	if dir := options["dir"]; dir != "" {
+		paths = append(paths, dir)
+	}
+	return paths, nil
+}
+
+// GetClientLabels Aria2 没有标签，返回空列表
+func (a *Aria2Client) GetClientLabels() ([]string, error) {
+	return []string{}, nil
+}
+
+// ProcessSingleTorrentFile 处理单个种子文件
+func (a *Aria2Client) ProcessSingleTorrentFile(ctx context.Context, filePath, category, tags string) error {
+	sLogger().Info("Processing torrent file: ", filePath)
+
+	torrentData, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read torrent file: %w", err)
+	}
+
+	torrentHash, err := qbit.ComputeTorrentHash(torrentData)
+	if err != nil {
+		return fmt.Errorf("unable to compute torrent hash: %w", err)
+	}
+
+	exists, err := a.CheckTorrentExists(torrentHash)
+	if err != nil {
+		return fmt.Errorf("failed to check torrent: %w", err)
+	}
+
+	if exists {
+		if err = os.Remove(filePath); err != nil {
+			return fmt.Errorf("torrent exists but failed to delete local file: %w", err)
+		}
+		sLogger().Info("Torrent exists, local file deleted: ", filePath)
+		return nil
+	}
+
+	if err := a.AddTorrent(torrentData, category, tags); err != nil {
+		return fmt.Errorf("failed to add torrent: %w", err)
+	}
+
+	sLogger().Infof("Processed single torrent file: %s", filePath)
+	return nil
+}
@@ -0,0 +1,385 @@
+package aria2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+// TestAria2ClientImplementsDownloader 验证 Aria2Client 实现 Downloader 接口
+func TestAria2ClientImplementsDownloader(t *testing.T) {
+	var _ downloader.Downloader = (*Aria2Client)(nil)
+}
+
+// TestAria2ConfigImplementsDownloaderConfig 验证 Aria2Config 实现 DownloaderConfig 接口
+func TestAria2ConfigImplementsDownloaderConfig(t *testing.T) {
+	var _ downloader.DownloaderConfig = (*Aria2Config)(nil)
+}
+
+// TestAria2ConfigValidation 测试配置验证与 RPC 地址
+func TestAria2ConfigValidation(t *testing.T) {
+	assert.NoError(t, NewAria2Config("http://localhost:6800", "s").Validate())
+	assert.NoError(t, NewAria2Config("localhost:6800", "").Validate())
+	assert.Error(t, NewAria2Config("", "s").Validate())
+	assert.Error(t, NewAria2Config("ws://localhost:6800", "s").Validate())
+
+	config := NewAria2Config("localhost:6800/", "secret")
+	assert.Equal(t, "http://localhost:6800", config.GetURL())
+	assert.Equal(t, "secret", config.GetPassword())
+	assert.Equal(t, downloader.DownloaderAria2, config.GetType())
+
+	assert.Equal(t, "http://localhost:6800/jsonrpc", rpcEndpoint("http://localhost:6800"))
+	assert.Equal(t, "http://localhost:6800/jsonrpc", rpcEndpoint("http://localhost:6800/jsonrpc"))
+}
+
+// mockAria2Server 模拟 Aria2 JSON-RPC 接口
+type mockAria2Server struct {
+	*httptest.Server
+	secret string
+
+	mu      sync.Mutex
+	active  []map[string]any
+	waiting []map[string]any
+	stopped []map[string]any
+	calls   map[string][]any
+	options map[string]string
+}
+
+// createMockAria2Server 创建模拟的 Aria2 服务器
+func createMockAria2Server(secret string) *mockAria2Server {
+	m := &mockAria2Server{
+		secret: secret,
+		active: []map[string]any{{
+			"gid":             "gid-active",
+			"status":          "active",
+			"totalLength":     "2000",
+			"completedLength": "500",
+			"uploadLength":    "250",
+			"downloadSpeed":   "100",
+			"uploadSpeed":     "10",
+			"infoHash":        "EXISTING_HASH",
+			"numSeeders":      "3",
+			"connections":     "5",
+			"dir":             "/downloads",
+			"seeder":          "false",
+			"bittorrent": map[string]any{
+				"announceList": [][]string{{"https://tracker.example.com/announce"}},
+				"info":         map[string]any{"name": "existing-torrent"},
+			},
+		}},
+		waiting: []map[string]any{{
+			"gid":             "gid-paused",
+			"status":          "paused",
+			"totalLength":     "1000",
+			"completedLength": "0",
+			"infoHash":        "paused_hash",
+			"dir":             "/downloads",
+		}},
+		stopped: []map[string]any{{
+			"gid":    "gid-http",
+			"status": "complete",
+			"dir":    "/downloads",
+		}},
+		calls:   map[string][]any{},
+		options: map[string]string{"dir": "/downloads", "max-overall-download-limit": "0", "max-overall-upload-limit": "0"},
+	}
+	m.Server = httptest.NewServer(http.HandlerFunc(m.handle))
+	return m
+}
+
+func (m *mockAria2Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/jsonrpc" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	var req rpcRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	reply := func(result any) {
+		raw, _ := json.Marshal(result)
+		_ = json.NewEncoder(w).Encode(rpcResponse{ID: req.ID, Result: raw})
+	}
+	fail := func(code int, message string) {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(rpcResponse{ID: req.ID, Error: &rpcError{Code: code, Message: message}})
+	}
+
+	// 检查令牌
+	params := req.Params
+	if m.secret != "" {
+		if len(params) == 0 || params[0] != "token:"+m.secret {
+			fail(errUnauthorized, "Unauthorized")
+			return
+		}
+		params = params[1:]
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls[req.Method] = params
+
+	switch req.Method {
+	case "aria2.getVersion":
+		reply(map[string]any{"version": "1.37.0", "enabledFeatures": []string{"BitTorrent"}})
+	case "aria2.getGlobalStat":
+		reply(map[string]string{"downloadSpeed": "1024", "uploadSpeed": "512", "numActive": "1"})
+	case "aria2.tellActive":
+		reply(m.active)
+	case "aria2.tellWaiting":
+		reply(m.waiting)
+	case "aria2.tellStopped":
+		reply(m.stopped)
+	case "aria2.tellStatus":
+		reply(m.active[0])
+	case "aria2.addTorrent":
+		for _, st := range append(append([]map[string]any{}, m.active...), m.waiting...) {
+			if st["gid"] == "gid-new" {
+				fail(1, "InfoHash new_hash is already registered.")
+				return
+			}
+		}
+		m.waiting = append(m.waiting, map[string]any{"gid": "gid-new", "status": "paused", "infoHash": "new_hash"})
+		reply("gid-new")
+	case "aria2.addUri":
+		reply("gid-uri")
+	case "aria2.pause", "aria2.unpause":
+		reply(params[0])
+	case "aria2.remove":
+		for i, st := range m.active {
+			if st["gid"] == params[0] {
+				m.active = append(m.active[:i], m.active[i+1:]...)
+				reply(params[0])
+				return
+			}
+		}
+		fail(1, "Active Download not found")
+	case "aria2.removeDownloadResult":
+		for i, st := range m.stopped {
+			if st["gid"] == params[0] {
+				m.stopped = append(m.stopped[:i], m.stopped[i+1:]...)
+				reply("OK")
+				return
+			}
+		}
+		fail(1, "Download result not found")
+	case "aria2.getFiles":
+		reply([]map[string]string{{"index": "1", "path": "/downloads/a.mkv", "length": "2000", "completedLength": "500", "selected": "true"}})
+	case "aria2.getGlobalOption":
+		reply(m.options)
+	case "aria2.changeGlobalOption":
+		for key, value := range params[0].(map[string]any) {
+			m.options[key] = value.(string)
+		}
+		reply("OK")
+	case "aria2.changeOption":
+		reply("OK")
+	default:
+		fail(1, "No such method: "+req.Method)
+	}
+}
+
+func (m *mockAria2Server) params(method string) []any {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls[method]
+}
+
+func newTestAria2Client(t *testing.T, server *mockAria2Server) *Aria2Client {
+	t.Helper()
+	client, err := NewAria2Client(NewAria2Config(server.URL, server.secret), "test-aria2")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	return client.(*Aria2Client)
+}
+
+// TestNewAria2Client 测试创建 Aria2 客户端
+func TestNewAria2Client(t *testing.T) {
+	server := createMockAria2Server("secret")
+	defer server.Close()
+
+	client := newTestAria2Client(t, server)
+	assert.Equal(t, downloader.DownloaderAria2, client.GetType())
+	assert.Equal(t, "test-aria2", client.GetName())
+	assert.True(t, client.IsHealthy())
+
+	version, err := client.GetClientVersion()
+	require.NoError(t, err)
+	assert.Equal(t, "1.37.0", version)
+}
+
+// TestAria2AuthenticationFailure 测试令牌错误
+func TestAria2AuthenticationFailure(t *testing.T) {
+	server := createMockAria2Server("secret")
+	defer server.Close()
+
+	_, err := NewAria2Client(NewAria2Config(server.URL, "wrong"), "test")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, downloader.ErrAuthenticationFailed)
+
+	_, err = NewAria2Client(NewAria2Config(server.URL, ""), "test")
+	assert.ErrorIs(t, err, downloader.ErrAuthenticationFailed)
+
+	dm := downloader.NewDownloaderManager()
+	dm.RegisterFactory(downloader.DownloaderAria2, NewAria2Client)
+	err = dm.TestConnection(NewAria2Config(server.URL, "wrong"))
+	assert.ErrorIs(t, err, downloader.ErrConnectionFailed)
+	require.NoError(t, dm.TestConnection(NewAria2Config(server.URL, "secret")))
+}
+
+// TestAria2NoSecret 未配置令牌时不插入 token 参数
+func TestAria2NoSecret(t *testing.T) {
+	server := createMockAria2Server("")
+	defer server.Close()
+
+	client := newTestAria2Client(t, server)
+	require.NoError(t, client.PauseTorrent("gid-active"))
+	assert.Equal(t, []any{"gid-active"}, server.params("aria2.pause"))
+}
+
+// TestAria2GetTorrents 测试列表映射与存在性检查
+func TestAria2GetTorrents(t *testing.T) {
+	server := createMockAria2Server("secret")
+	defer server.Close()
+
+	client := newTestAria2Client(t, server)
+
+	torrents, err := client.GetAllTorrents()
+	require.NoError(t, err)
+	require.Len(t, torrents, 2, "non-BitTorrent downloads are skipped")
+
+	tr := torrents[0]
+	assert.Equal(t, "gid-active", tr.ID)
+	assert.Equal(t, "existing_hash", tr.InfoHash)
+	assert.Equal(t, "existing-torrent", tr.Name)
+	assert.Equal(t, downloader.TorrentDownloading, tr.State)
+	assert.InDelta(t, 0.25, tr.Progress, 0.001)
+	assert.InDelta(t, 0.5, tr.Ratio, 0.001)
+	assert.Equal(t, int64(15), tr.ETA)
+	assert.Equal(t, "https://tracker.example.com/announce", tr.Tracker)
+	assert.Equal(t, downloader.TorrentPaused, torrents[1].State)
+
+	exists, err := client.CheckTorrentExists("existing_hash")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = client.CheckTorrentExists("PAUSED_HASH")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = client.CheckTorrentExists("missing")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	tr, err = client.GetTorrent("paused_hash")
+	require.NoError(t, err)
+	assert.Equal(t, "gid-paused", tr.ID)
+	_, err = client.GetTorrent("missing")
+	assert.ErrorIs(t, err, downloader.ErrTorrentNotFound)
+
+	pending, err := client.GetIncompletePendingBytes(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1500+1000), pending)
+
+	files, err := client.GetTorrentFiles("gid-active")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, 0, files[0].Index)
+	assert.InDelta(t, 0.25, files[0].Progress, 0.001)
+
+	trackers, err := client.GetTorrentTrackers("gid-active")
+	require.NoError(t, err)
+	require.Len(t, trackers, 1)
+	assert.Equal(t, "https://tracker.example.com/announce", trackers[0].URL)
+}
+
+// TestAria2AddTorrentFileEx 测试 base64 添加种子与重复添加
+func TestAria2AddTorrentFileEx(t *testing.T) {
+	server := createMockAria2Server("secret")
+	defer server.Close()
+
+	client := newTestAria2Client(t, server)
+
+	opt := downloader.AddTorrentOptions{AddAtPaused: true, SavePath: "/data", UploadSpeedLimitKBs: 100}
+	result, err := client.AddTorrentFileEx([]byte("torrent"), opt)
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, "gid-new", result.ID)
+
+	params := server.params("aria2.addTorrent")
+	require.Len(t, params, 3)
+	assert.Equal(t, "dG9ycmVudA==", params[0])
+	options := params[2].(map[string]any)
+	assert.Equal(t, "true", options["pause"])
+	assert.Equal(t, "/data", options["dir"])
+	assert.Equal(t, "102400", options["max-upload-limit"])
+
+	result, err = client.AddTorrentFileEx([]byte("torrent"), opt)
+	require.NoError(t, err)
+	assert.Equal(t, "Torrent already exists", result.Message)
+
+	result, err = client.AddTorrentEx("magnet:?xt=urn:btih:abc", downloader.AddTorrentOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "gid-uri", result.ID)
+}
+
+// TestAria2TorrentOperations 测试暂停、恢复与删除
+func TestAria2TorrentOperations(t *testing.T) {
+	server := createMockAria2Server("secret")
+	defer server.Close()
+
+	client := newTestAria2Client(t, server)
+
+	require.NoError(t, client.PauseTorrent("gid-active"))
+	assert.Equal(t, []any{"gid-active"}, server.params("aria2.pause"))
+	require.NoError(t, client.ResumeTorrents([]string{"gid-active", " "}))
+	assert.Equal(t, []any{"gid-active"}, server.params("aria2.unpause"))
+
+	require.NoError(t, client.RemoveTorrent("gid-active", false))
+	require.NoError(t, client.RemoveTorrent("gid-http", true), "stopped downloads are cleared from results")
+	assert.Error(t, client.RemoveTorrent("gid-missing", false))
+
+	require.NoError(t, client.SetTorrentSavePath("gid-paused", "/new"))
+	assert.Equal(t, "/new", server.params("aria2.changeOption")[1].(map[string]any)["dir"])
+	assert.ErrorIs(t, client.SetTorrentCategory("gid-paused", "movies"), errUnsupported)
+	assert.ErrorIs(t, client.RecheckTorrent("gid-paused"), errUnsupported)
+}
+
+// TestAria2GlobalState 测试全局速度、限速与磁盘信息
+func TestAria2GlobalState(t *testing.T) {
+	server := createMockAria2Server("secret")
+	defer server.Close()
+
+	client := newTestAria2Client(t, server)
+
+	status, err := client.GetClientStatus()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1024), status.DlSpeed)
+	assert.Equal(t, int64(512), status.UpSpeed)
+
+	_, err = client.GetClientFreeSpace(context.Background())
+	assert.ErrorIs(t, err, errUnsupported)
+
+	info, err := client.GetDiskInfo()
+	require.NoError(t, err)
+	assert.Equal(t, "/downloads", info.Path)
+
+	require.NoError(t, client.SetSpeedLimit(downloader.SpeedLimit{DownloadLimit: 2048, UploadLimit: 1024, LimitEnabled: true}))
+	limit, err := client.GetSpeedLimit()
+	require.NoError(t, err)
+	assert.True(t, limit.LimitEnabled)
+	assert.Equal(t, int64(2048), limit.DownloadLimit)
+
+	paths, err := client.GetClientPaths()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/downloads"}, paths)
+}
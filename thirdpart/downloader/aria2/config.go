@@ -0,0 +1,102 @@
+package aria2
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+// Aria2Config Aria2 配置
+// Secret 为 --rpc-secret 设置的令牌，调用时以 "token:xxx" 作为每个请求的第一个参数
+type Aria2Config struct {
+	URL       string `json:"url"`
+	Secret    string `json:"secret"`
+	AutoStart bool   `json:"auto_start"`
+	// Timeout 单次 HTTP 请求超时，0 表示使用 downloader.DefaultRequestTimeout
+	Timeout time.Duration `json:"-"`
+}
+
+// GetType 获取下载器类型
+func (c *Aria2Config) GetType() downloader.DownloaderType {
+	return downloader.DownloaderAria2
+}
+
+// GetURL 获取下载器 URL（自动补全协议并去除尾斜杠）
+func (c *Aria2Config) GetURL() string {
+	value := strings.TrimSpace(c.URL)
+	if value != "" && !strings.Contains(value, "://") {
+		value = "http://" + value
+	}
+	return strings.TrimSuffix(value, "/")
+}
+
+// GetUsername Aria2 没有用户名
+func (c *Aria2Config) GetUsername() string {
+	return ""
+}
+
+// GetPassword 获取 RPC 令牌
+func (c *Aria2Config) GetPassword() string {
+	return c.Secret
+}
+
+// GetAutoStart 获取是否自动开始下载
+func (c *Aria2Config) GetAutoStart() bool {
+	return c.AutoStart
+}
+
+// GetDryRun Aria2 暂不支持演练模式，始终返回 false
+func (c *Aria2Config) GetDryRun() bool {
+	return false
+}
+
+// GetTimeout 获取单次请求超时
+func (c *Aria2Config) GetTimeout() time.Duration {
+	return c.Timeout
+}
+
+// Validate 验证配置是否有效
+func (c *Aria2Config) Validate() error {
+	if c.URL == "" {
+		return errors.New("Aria2 URL is required")
+	}
+	parsed, err := url.Parse(c.GetURL())
+	if err != nil || parsed.Scheme == "" || parsed.Hostname() == "" {
+		return errors.New("Aria2 URL is invalid")
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return errors.New("Aria2 URL must use http or https")
+	}
+	if parsed.User != nil {
+		return errors.New("Aria2 URL must not include username or password")
+	}
+	return nil
+}
+
+// rpcEndpoint 返回 JSON-RPC 地址，URL 未包含 /jsonrpc 时自动补全
+func rpcEndpoint(baseURL string) string {
+	if strings.HasSuffix(baseURL, "/jsonrpc") {
+		return baseURL
+	}
+	return baseURL + "/jsonrpc"
+}
+
+// NewAria2Config 创建 Aria2 配置
+func NewAria2Config(url, secret string) *Aria2Config {
+	return &Aria2Config{
+		URL:    url,
+		Secret: secret,
+	}
+}
+
+// NewAria2ConfigWithAutoStart 创建带 auto_start 的 Aria2 配置
+func NewAria2ConfigWithAutoStart(url, secret string, autoStart bool) *Aria2Config {
+	return &Aria2Config{
+		URL:       url,
+		Secret:    secret,
+		AutoStart: autoStart,
+	}
+}
@@ -0,0 +1,68 @@
+package downloader
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultTestConnectionTimeout TestConnection 的默认超时时间
+const DefaultTestConnectionTimeout = 10 * time.Second
+
+// TimeoutConfig 可选接口：配置可指定客户端单次 HTTP 请求的超时时间
+type TimeoutConfig interface {
+	GetTimeout() time.Duration
+}
+
+// RequestTimeout 返回配置指定的请求超时，未指定时为 DefaultRequestTimeout
+func RequestTimeout(config DownloaderConfig) time.Duration {
+	if tc, ok := config.(TimeoutConfig); ok && tc.GetTimeout() > 0 {
+		return tc.GetTimeout()
+	}
+	return DefaultRequestTimeout
+}
+
+// timeoutConfig 为任意配置附加请求超时，供连接测试使用
+type timeoutConfig struct {
+	DownloaderConfig
+	timeout time.Duration
+}
+
+func (c timeoutConfig) GetTimeout() time.Duration {
+	return c.timeout
+}
+
+// TestConnection 使用已注册的工厂按配置创建临时客户端并 Ping，随后关闭
+// 用于"测试连接"场景，每次请求的超时为 DefaultTestConnectionTimeout
+func (dm *DownloaderManager) TestConnection(config DownloaderConfig) error {
+	return dm.TestConnectionWithTimeout(config, DefaultTestConnectionTimeout)
+}
+
+// TestConnectionWithTimeout 同 TestConnection，可指定客户端每次请求的超时时间
+// 连接失败时返回包装了 ErrConnectionFailed 的描述性错误
+func (dm *DownloaderManager) TestConnectionWithTimeout(config DownloaderConfig, timeout time.Duration) error {
+	if config == nil {
+		return ErrInvalidConfig
+	}
+	if err := config.Validate(); err != nil {
+		return fmt.Errorf("配置无效: %w", err)
+	}
+	if !dm.HasFactory(config.GetType()) {
+		return fmt.Errorf("%w: 不支持的下载器类型 %s", ErrInvalidConfig, config.GetType())
+	}
+
+	dl, err := dm.CreateFromConfig(timeoutConfig{DownloaderConfig: config, timeout: timeout}, "connection-test")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrConnectionFailed, err)
+	}
+	defer dl.Close()
+
+	healthy, err := dl.Ping()
+	if err == nil && !healthy {
+		err = errors.New("下载器未响应 Ping")
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrConnectionFailed, err)
+	}
+	return nil
+}
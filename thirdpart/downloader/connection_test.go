@@ -0,0 +1,69 @@
+package downloader
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestConnection_UsesRegisteredFactory(t *testing.T) {
+	const healthyType DownloaderType = "conn-test-healthy"
+	const unhealthyType DownloaderType = "conn-test-unhealthy"
+	const failingType DownloaderType = "conn-test-failing"
+
+	dm := NewDownloaderManager()
+	dm.RegisterFactory(healthyType, func(config DownloaderConfig, name string) (Downloader, error) {
+		return &MockDownloader{name: name, dlType: healthyType, healthy: true}, nil
+	})
+	dm.RegisterFactory(unhealthyType, func(config DownloaderConfig, name string) (Downloader, error) {
+		return &MockDownloader{name: name, dlType: unhealthyType}, nil
+	})
+	dm.RegisterFactory(failingType, func(config DownloaderConfig, name string) (Downloader, error) {
+		return nil, errors.New("认证失败(401)，用户名或密码错误")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		require.NoError(t, dm.TestConnection(NewGenericConfig(healthyType, "http://localhost", "u", "p", true)))
+	})
+
+	t.Run("ping not healthy", func(t *testing.T) {
+		err := dm.TestConnection(NewGenericConfig(unhealthyType, "http://localhost", "u", "p", true))
+		require.ErrorIs(t, err, ErrConnectionFailed)
+	})
+
+	t.Run("factory error is wrapped", func(t *testing.T) {
+		err := dm.TestConnection(NewGenericConfig(failingType, "http://localhost", "u", "p", true))
+		require.ErrorIs(t, err, ErrConnectionFailed)
+		assert.Contains(t, err.Error(), "用户名或密码错误")
+	})
+}
+
+func TestTestConnection_InvalidConfig(t *testing.T) {
+	dm := NewDownloaderManager()
+	require.ErrorIs(t, dm.TestConnection(nil), ErrInvalidConfig)
+	require.ErrorIs(t, dm.TestConnection(NewGenericConfig(DownloaderQBittorrent, "", "", "", true)), ErrInvalidConfig)
+	require.ErrorIs(t, dm.TestConnection(NewGenericConfig(DownloaderQBittorrent, "http://localhost", "", "", true)), ErrInvalidConfig)
+}
+
+func TestTestConnectionWithTimeout_PassesTimeoutToFactory(t *testing.T) {
+	const timeoutType DownloaderType = "conn-test-timeout"
+	var got time.Duration
+	dm := NewDownloaderManager()
+	dm.RegisterFactory(timeoutType, func(config DownloaderConfig, name string) (Downloader, error) {
+		got = RequestTimeout(config)
+		return &MockDownloader{name: name, dlType: timeoutType, healthy: true}, nil
+	})
+
+	require.NoError(t, dm.TestConnectionWithTimeout(NewGenericConfig(timeoutType, "http://localhost", "", "", true), 50*time.Millisecond))
+	assert.Equal(t, 50*time.Millisecond, got)
+
+	require.NoError(t, dm.TestConnection(NewGenericConfig(timeoutType, "http://localhost", "", "", true)))
+	assert.Equal(t, DefaultTestConnectionTimeout, got)
+}
+
+func TestRequestTimeout_Default(t *testing.T) {
+	assert.Equal(t, DefaultRequestTimeout, RequestTimeout(NewGenericConfig(DownloaderQBittorrent, "http://localhost", "", "", true)))
+}
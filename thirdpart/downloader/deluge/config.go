@@ -0,0 +1,95 @@
+package deluge
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+// DelugeConfig Deluge 配置
+// Deluge Web UI 只需要密码，Username 仅为满足 DownloaderConfig 接口而保留
+type DelugeConfig struct {
+	URL       string `json:"url"`
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+	AutoStart bool   `json:"auto_start"`
+	// Timeout 单次 HTTP 请求超时，0 表示使用 downloader.DefaultRequestTimeout
+	Timeout time.Duration `json:"-"`
+}
+
+// GetType 获取下载器类型
+func (c *DelugeConfig) GetType() downloader.DownloaderType {
+	return downloader.DownloaderDeluge
+}
+
+// GetURL 获取下载器 URL（自动补全协议并去除尾斜杠）
+func (c *DelugeConfig) GetURL() string {
+	value := strings.TrimSpace(c.URL)
+	if value != "" && !strings.Contains(value, "://") {
+		value = "http://" + value
+	}
+	return strings.TrimSuffix(value, "/")
+}
+
+// GetUsername 获取用户名
+func (c *DelugeConfig) GetUsername() string {
+	return c.Username
+}
+
+// GetPassword 获取密码
+func (c *DelugeConfig) GetPassword() string {
+	return c.Password
+}
+
+// GetAutoStart 获取是否自动开始下载
+func (c *DelugeConfig) GetAutoStart() bool {
+	return c.AutoStart
+}
+
+// GetDryRun Deluge 暂不支持演练模式，始终返回 false
+func (c *DelugeConfig) GetDryRun() bool {
+	return false
+}
+
+// GetTimeout 获取单次请求超时
+func (c *DelugeConfig) GetTimeout() time.Duration {
+	return c.Timeout
+}
+
+// Validate 验证配置是否有效
+func (c *DelugeConfig) Validate() error {
+	if c.URL == "" {
+		return errors.New("Deluge URL is required")
+	}
+	parsed, err := url.Parse(c.GetURL())
+	if err != nil || parsed.Scheme == "" || parsed.Hostname() == "" {
+		return errors.New("Deluge URL is invalid")
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return errors.New("Deluge URL must use http or https")
+	}
+	if parsed.User != nil {
+		return errors.New("Deluge URL must not include username or password")
+	}
+	return nil
+}
+
+// NewDelugeConfig 创建 Deluge 配置
+func NewDelugeConfig(url, password string) *DelugeConfig {
+	return &DelugeConfig{
+		URL:      url,
+		Password: password,
+	}
+}
+
+// NewDelugeConfigWithAutoStart 创建带 auto_start 的 Deluge 配置
+func NewDelugeConfigWithAutoStart(url, password string, autoStart bool) *DelugeConfig {
+	return &DelugeConfig{
+		URL:       url,
+		Password:  password,
+		AutoStart: autoStart,
+	}
+}
@@ -0,0 +1,1080 @@
+package deluge
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+	"github.com/sunerpy/pt-tools/thirdpart/downloader/qbit"
+)
+
+// DelugeClient Deluge 客户端实现
+// 通过 Deluge Web UI 的 JSON-RPC 接口（/json）通信，会话基于 auth.login 下发的 Cookie
+type DelugeClient struct {
+	name         string
+	baseURL      string
+	password     string
+	autoStart    bool
+	client       downloader.HTTPDoer
+	cookies      []*http.Cookie
+	requestID    int
+	mu           sync.Mutex
+	healthy      bool
+	lastActivity time.Time
+}
+
+// 确保 DelugeClient 实现 Downloader 接口
+var _ downloader.Downloader = (*DelugeClient)(nil)
+
+// errNotAuthenticated Deluge 返回的未认证错误码
+const errNotAuthenticated = 1
+
+// Deluge JSON-RPC 请求/响应结构
+type rpcRequest struct {
+	Method string `json:"method"`
+	Params []any  `json:"params"`
+	ID     int    `json:"id"`
+}
+
+type rpcError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+}
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("RPC error %d: %s", e.Code, e.Message)
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+	ID     int             `json:"id"`
+}
+
+// torrentStatusKeys GetAllTorrents/GetTorrent 查询的字段
+var torrentStatusKeys = []string{
+	"hash", "name", "state", "progress", "ratio", "time_added", "save_path",
+	"download_location", "label", "total_size", "total_wanted", "total_done",
+	"upload_payload_rate", "download_payload_rate", "eta", "seeding_time",
+	"tracker", "completed_time", "num_seeds", "num_peers", "distributed_copies",
+	"total_uploaded", "all_time_download", "is_finished",
+}
+
+// delugeTorrent Deluge 种子状态
+// 速率、ETA、时间戳在不同版本中可能是浮点数，统一按 float64 解析
+type delugeTorrent struct {
+	Hash              string  `json:"hash"`
+	Name              string  `json:"name"`
+	State             string  `json:"state"`
+	Progress          float64 `json:"progress"` // 0-100
+	Ratio             float64 `json:"ratio"`
+	TimeAdded         float64 `json:"time_added"`
+	SavePath          string  `json:"save_path"`
+	DownloadLocation  string  `json:"download_location"`
+	Label             string  `json:"label"`
+	TotalSize         int64   `json:"total_size"`
+	TotalWanted       int64   `json:"total_wanted"`
+	TotalDone         int64   `json:"total_done"`
+	UploadRate        float64 `json:"upload_payload_rate"`
+	DownloadRate      float64 `json:"download_payload_rate"`
+	ETA               float64 `json:"eta"`
+	SeedingTime       float64 `json:"seeding_time"`
+	Tracker           string  `json:"tracker"`
+	CompletedTime     float64 `json:"completed_time"`
+	NumSeeds          int     `json:"num_seeds"`
+	NumPeers          int     `json:"num_peers"`
+	DistributedCopies float64 `json:"distributed_copies"`
+	TotalUploaded     int64   `json:"total_uploaded"`
+	AllTimeDownload   int64   `json:"all_time_download"`
+	IsFinished        bool    `json:"is_finished"`
+}
+
+type delugeFile struct {
+	Index int    `json:"index"`
+	Path  string `json:"path"`
+	Size  int64  `json:"size"`
+}
+
+type delugeTracker struct {
+	URL  string `json:"url"`
+	Tier int    `json:"tier"`
+}
+
+// NewDelugeClient 创建新的 Deluge 客户端
+func NewDelugeClient(config downloader.DownloaderConfig, name string) (downloader.Downloader, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	client := &DelugeClient{
+		name:      name,
+		baseURL:   config.GetURL(),
+		password:  config.GetPassword(),
+		autoStart: config.GetAutoStart(),
+		client:    downloader.NewRequestsHTTPDoer(config.GetURL(), downloader.RequestTimeout(config)),
+		healthy:   false,
+	}
+
+	if err := client.Authenticate(); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// GetType 获取下载器类型
+func (d *DelugeClient) GetType() downloader.DownloaderType {
+	return downloader.DownloaderDeluge
+}
+
+// Capabilities 获取 Deluge 支持的功能
+// 分类依赖 Label 插件；Deluge 没有标签概念
+func (d *DelugeClient) Capabilities() downloader.Capabilities {
+	return downloader.Capabilities{
+		Magnet:               true,
+		Categories:           true,
+		Tags:                 false,
+		LabelsAsArray:        false,
+		ForceStart:           false,
+		SuperSeeding:         true,
+		SkipHashCheck:        false,
+		PerTorrentSpeedLimit: true,
+	}
+}
+
+// GetName 获取下载器实例名称
+func (d *DelugeClient) GetName() string {
+	return d.name
+}
+
+// IsHealthy 检查下载器是否健康可用
+func (d *DelugeClient) IsHealthy() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.healthy
+}
+
+// Close 关闭下载器连接
+func (d *DelugeClient) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.healthy = false
+	d.cookies = nil
+	if closer, ok := d.client.(interface{ Close() error }); ok {
+		_ = closer.Close()
+	}
+	return nil
+}
+
+// Authenticate 认证连接到 Deluge
+// 先通过 auth.login 登录 Web UI，再确保 Web UI 已连接到 deluged 守护进程
+func (d *DelugeClient) Authenticate() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := d.loginLocked(); err != nil {
+		d.healthy = false
+		return err
+	}
+	if err := d.connectDaemonLocked(); err != nil {
+		d.healthy = false
+		return err
+	}
+
+	d.healthy = true
+	d.lastActivity = time.Now()
+	sLogger().Info("Successfully connected to Deluge")
+	return nil
+}
+
+// loginLocked 调用 auth.login 并保存会话 Cookie，调用方需持有锁
+func (d *DelugeClient) loginLocked() error {
+	resp, cookies, err := d.sendLocked("auth.login", []any{d.password})
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%w: %s", downloader.ErrAuthenticationFailed, resp.Error.Message)
+	}
+
+	var ok bool
+	if err := json.Unmarshal(resp.Result, &ok); err != nil {
+		return fmt.Errorf("failed to parse login response: %w", err)
+	}
+	if !ok {
+		return fmt.Errorf("%w: invalid Deluge password", downloader.ErrAuthenticationFailed)
+	}
+
+	if len(cookies) > 0 {
+		d.cookies = cookies
+	}
+	return nil
+}
+
+// connectDaemonLocked Web UI 未连接守护进程时，自动连接第一个已配置的主机
+func (d *DelugeClient) connectDaemonLocked() error {
+	result, err := d.callLocked("web.connected")
+	if err != nil {
+		return fmt.Errorf("failed to check daemon connection: %w", err)
+	}
+	var connected bool
+	if err := json.Unmarshal(result, &connected); err != nil {
+		return fmt.Errorf("failed to parse daemon connection: %w", err)
+	}
+	if connected {
+		return nil
+	}
+
+	result, err = d.callLocked("web.get_hosts")
+	if err != nil {
+		return fmt.Errorf("failed to get daemon hosts: %w", err)
+	}
+	var hosts [][]any
+	if err := json.Unmarshal(result, &hosts); err != nil {
+		return fmt.Errorf("failed to parse daemon hosts: %w", err)
+	}
+	if len(hosts) == 0 || len(hosts[0]) == 0 {
+		return fmt.Errorf("Deluge Web UI 未配置任何守护进程主机，请先在 Connection Manager 中添加")
+	}
+
+	hostID, _ := hosts[0][0].(string)
+	if _, err := d.callLocked("web.connect", hostID); err != nil {
+		return fmt.Errorf("failed to connect to daemon %s: %w", hostID, err)
+	}
+	return nil
+}
+
+func (d *DelugeClient) wrapConnectionError(err error) error {
+	errStr := err.Error()
+	switch {
+	case strings.Contains(errStr, "connection refused"):
+		return fmt.Errorf("连接被拒绝，请检查: 1) Deluge Web UI 是否正在运行 2) 端口是否正确(默认8112) (原始错误: %w)", err)
+	case strings.Contains(errStr, "no such host"):
+		return fmt.Errorf("无法解析主机名，请检查 URL 地址是否正确 (原始错误: %w)", err)
+	case strings.Contains(errStr, "timeout") || strings.Contains(errStr, "deadline exceeded"):
+		return fmt.Errorf("连接超时，请检查: 1) 网络是否可达 2) 防火墙设置 3) URL 地址是否正确 (原始错误: %w)", err)
+	default:
+		return fmt.Errorf("连接失败: %w", err)
+	}
+}
+
+// sendLocked 发送一次 JSON-RPC 请求，返回响应与服务端下发的 Cookie，调用方需持有锁
+func (d *DelugeClient) sendLocked(method string, params []any) (*rpcResponse, []*http.Cookie, error) {
+	if d.client == nil {
+		return nil, nil, fmt.Errorf("client is closed")
+	}
+	if params == nil {
+		params = []any{}
+	}
+
+	d.requestID++
+	body, err := json.Marshal(rpcRequest{Method: method, Params: params, ID: d.requestID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "POST", d.baseURL+"/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	for _, cookie := range d.cookies {
+		req.AddCookie(cookie)
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, nil, d.wrapConnectionError(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, nil, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var rpcResp rpcResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &rpcResp, resp.Cookies(), nil
+}
+
+// callLocked 执行 RPC 调用，会话过期时重新登录并重试一次，调用方需持有锁
+func (d *DelugeClient) callLocked(method string, params ...any) (json.RawMessage, error) {
+	resp, _, err := d.sendLocked(method, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Error != nil && resp.Error.Code == errNotAuthenticated && method != "auth.login" {
+		if err := d.loginLocked(); err != nil {
+			return nil, err
+		}
+		resp, _, err = d.sendLocked(method, params)
+		if err != nil {
+			return nil, fmt.Errorf("retry request failed: %w", err)
+		}
+	}
+
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+
+	d.lastActivity = time.Now()
+	return resp.Result, nil
+}
+
+// call 执行 RPC 调用
+func (d *DelugeClient) call(method string, params ...any) (json.RawMessage, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.callLocked(method, params...)
+}
+
+// callInto 执行 RPC 调用并将结果解析到 out
+func (d *DelugeClient) callInto(out any, method string, params ...any) error {
+	result, err := d.call(method, params...)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(result, out); err != nil {
+		return fmt.Errorf("failed to parse %s response: %w", method, err)
+	}
+	return nil
+}
+
+// Ping 检查下载器连接是否正常
+func (d *DelugeClient) Ping() (bool, error) {
+	var connected bool
+	err := d.callInto(&connected, "web.connected")
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err != nil {
+		d.healthy = false
+		return false, err
+	}
+	d.healthy = connected
+	return connected, nil
+}
+
+// GetClientVersion 获取下载器版本
+func (d *DelugeClient) GetClientVersion() (string, error) {
+	var version string
+	if err := d.callInto(&version, "daemon.info"); err != nil {
+		return "", fmt.Errorf("failed to get daemon info: %w", err)
+	}
+	return version, nil
+}
+
+// GetClientStatus 获取下载器状态
+// Deluge 只提供本次会话的累计流量，UpData/DlData 与 Session* 取相同值
+func (d *DelugeClient) GetClientStatus() (downloader.ClientStatus, error) {
+	var stats struct {
+		UploadRate    float64 `json:"upload_rate"`
+		DownloadRate  float64 `json:"download_rate"`
+		TotalUpload   int64   `json:"total_upload"`
+		TotalDownload int64   `json:"total_download"`
+	}
+	keys := []string{"upload_rate", "download_rate", "total_upload", "total_download"}
+	if err := d.callInto(&stats, "core.get_session_status", keys); err != nil {
+		return downloader.ClientStatus{}, fmt.Errorf("failed to get session status: %w", err)
+	}
+	return downloader.ClientStatus{
+		UpSpeed:       int64(stats.UploadRate),
+		DlSpeed:       int64(stats.DownloadRate),
+		UpData:        stats.TotalUpload,
+		DlData:        stats.TotalDownload,
+		SessionUpData: stats.TotalUpload,
+		SessionDlData: stats.TotalDownload,
+	}, nil
+}
+
+// GetDiskSpace 获取默认下载目录的可用磁盘空间
+func (d *DelugeClient) GetDiskSpace(ctx context.Context) (int64, error) {
+	var freeSpace int64
+	if err := d.callInto(&freeSpace, "core.get_free_space"); err != nil {
+		return 0, fmt.Errorf("failed to get free space: %w", err)
+	}
+	if freeSpace <= 0 {
+		return 0, fmt.Errorf("free space returned %d bytes", freeSpace)
+	}
+	return freeSpace, nil
+}
+
+// GetClientFreeSpace 获取下载器所在磁盘的可用空间
+func (d *DelugeClient) GetClientFreeSpace(ctx context.Context) (int64, error) {
+	return d.GetDiskSpace(ctx)
+}
+
+// CanAddTorrent 检查是否可以添加指定大小的种子
+func (d *DelugeClient) CanAddTorrent(ctx context.Context, fileSize int64) (bool, error) {
+	freeSpace, err := d.GetDiskSpace(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if fileSize > freeSpace {
+		availableSize := float64(freeSpace) / (1024 * 1024 * 1024)
+		needSize := float64(fileSize) / (1024 * 1024 * 1024)
+		sLogger().Errorf("Insufficient space, need: %.2fGB, available: %.2fGB", needSize, availableSize)
+		return false, nil
+	}
+	return true, nil
+}
+
+// GetIncompletePendingBytes 聚合所有未完成种子还需要下载的字节数（total_wanted - total_done）
+// 与其他实现保持一致：暂停、排队、校验中的未完成种子同样计入
+func (d *DelugeClient) GetIncompletePendingBytes(_ context.Context) (int64, error) {
+	var statuses map[string]delugeTorrent
+	keys := []string{"total_wanted", "total_done", "is_finished"}
+	if err := d.callInto(&statuses, "core.get_torrents_status", map[string]any{}, keys); err != nil {
+		return 0, fmt.Errorf("core.get_torrents_status 失败: %w", err)
+	}
+	var total int64
+	for _, st := range statuses {
+		if st.IsFinished {
+			continue
+		}
+		if left := st.TotalWanted - st.TotalDone; left > 0 {
+			total += left
+		}
+	}
+	return total, nil
+}
+
+// GetAllTorrents 获取所有种子列表，按添加时间排序
+func (d *DelugeClient) GetAllTorrents() ([]downloader.Torrent, error) {
+	var statuses map[string]delugeTorrent
+	if err := d.callInto(&statuses, "core.get_torrents_status", map[string]any{}, torrentStatusKeys); err != nil {
+		return nil, fmt.Errorf("failed to get torrents: %w", err)
+	}
+
+	torrents := make([]downloader.Torrent, 0, len(statuses))
+	for hash, st := range statuses {
+		if st.Hash == "" {
+			st.Hash = hash
+		}
+		torrents = append(torrents, d.mapDelugeTorrent(st))
+	}
+	sort.Slice(torrents, func(i, j int) bool {
+		if torrents[i].DateAdded != torrents[j].DateAdded {
+			return torrents[i].DateAdded < torrents[j].DateAdded
+		}
+		return torrents[i].InfoHash < torrents[j].InfoHash
+	})
+
+	return torrents, nil
+}
+
+// mapDelugeTorrent 将 Deluge 种子信息映射到通用 Torrent 结构
+func (d *DelugeClient) mapDelugeTorrent(st delugeTorrent) downloader.Torrent {
+	savePath := st.DownloadLocation
+	if savePath == "" {
+		savePath = st.SavePath
+	}
+	amountLeft := st.TotalWanted - st.TotalDone
+	if amountLeft < 0 || st.IsFinished {
+		amountLeft = 0
+	}
+
+	return downloader.Torrent{
+		ID:              st.Hash,
+		InfoHash:        st.Hash,
+		Name:            st.Name,
+		Progress:        st.Progress / 100,
+		IsCompleted:     st.IsFinished || st.Progress >= 100,
+		Ratio:           st.Ratio,
+		DateAdded:       int64(st.TimeAdded),
+		SavePath:        savePath,
+		Label:           st.Label,
+		Category:        st.Label,
+		State:           mapDelugeState(st.State),
+		TotalSize:       st.TotalSize,
+		AmountLeft:      amountLeft,
+		UploadSpeed:     int64(st.UploadRate),
+		DownloadSpeed:   int64(st.DownloadRate),
+		ETA:             int64(st.ETA),
+		SeedingTime:     int64(st.SeedingTime),
+		Tracker:         st.Tracker,
+		CompletionOn:    int64(st.CompletedTime),
+		NumSeeds:        st.NumSeeds,
+		NumPeers:        st.NumPeers,
+		Availability:    st.DistributedCopies,
+		ContentPath:     filepath.Join(savePath, st.Name),
+		TotalUploaded:   st.TotalUploaded,
+		TotalDownloaded: st.AllTimeDownload,
+		ClientID:        d.name,
+		Raw:             st,
+	}
+}
+
+// mapDelugeState 将 Deluge 状态映射到通用状态
+func mapDelugeState(state string) downloader.TorrentState {
+	switch state {
+	case "Downloading":
+		return downloader.TorrentDownloading
+	case "Seeding":
+		return downloader.TorrentSeeding
+	case "Paused":
+		return downloader.TorrentPaused
+	case "Queued":
+		return downloader.TorrentQueued
+	case "Checking", "Allocating":
+		return downloader.TorrentChecking
+	case "Error":
+		return downloader.TorrentError
+	default:
+		return downloader.TorrentUnknown
+	}
+}
+
+// GetTorrentsBy 根据过滤条件获取种子列表
+// Deluge 的种子 ID 即 info hash，IDs 与 Hashes 按同一集合匹配
+func (d *DelugeClient) GetTorrentsBy(filter downloader.TorrentFilter) ([]downloader.Torrent, error) {
+	allTorrents, err := d.GetAllTorrents()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(filter.IDs) == 0 && len(filter.Hashes) == 0 && filter.Complete == nil && filter.State == nil {
+		return allTorrents, nil
+	}
+
+	hashSet := make(map[string]bool)
+	for _, id := range filter.IDs {
+		hashSet[strings.ToLower(id)] = true
+	}
+	for _, hash := range filter.Hashes {
+		hashSet[strings.ToLower(hash)] = true
+	}
+
+	var filtered []downloader.Torrent
+	for _, torrent := range allTorrents {
+		if len(hashSet) > 0 && !hashSet[strings.ToLower(torrent.InfoHash)] {
+			continue
+		}
+		if filter.Complete != nil && torrent.IsCompleted != *filter.Complete {
+			continue
+		}
+		if filter.State != nil && torrent.State != *filter.State {
+			continue
+		}
+		filtered = append(filtered, torrent)
+	}
+
+	return filtered, nil
+}
+
+// GetTorrent 获取单个种子信息
+func (d *DelugeClient) GetTorrent(id string) (downloader.Torrent, error) {
+	var st delugeTorrent
+	if err := d.callInto(&st, "core.get_torrent_status", strings.ToLower(id), torrentStatusKeys); err != nil {
+		return downloader.Torrent{}, fmt.Errorf("failed to get torrent: %w", err)
+	}
+	if st.Hash == "" {
+		return downloader.Torrent{}, downloader.ErrTorrentNotFound
+	}
+	return d.mapDelugeTorrent(st), nil
+}
+
+// CheckTorrentExists 检查种子是否存在
+func (d *DelugeClient) CheckTorrentExists(torrentHash string) (bool, error) {
+	var st struct {
+		Hash string `json:"hash"`
+	}
+	if err := d.callInto(&st, "core.get_torrent_status", strings.ToLower(torrentHash), []string{"hash"}); err != nil {
+		return false, fmt.Errorf("failed to get torrent: %w", err)
+	}
+	return st.Hash != "", nil
+}
+
+// buildAddOptions 将 AddTorrentOptions 转换为 Deluge 的 torrent options
+// Deluge 的限速单位为 KiB/s
+func buildAddOptions(opt downloader.AddTorrentOptions) map[string]any {
+	options := map[string]any{
+		"add_paused": opt.AddAtPaused,
+	}
+	if opt.SavePath != "" {
+		options["download_location"] = opt.SavePath
+	}
+	if upBytes := opt.EffectiveUploadLimitBytes(); upBytes > 0 {
+		options["max_upload_speed"] = float64(upBytes) / 1024
+	}
+	if dlBytes := opt.EffectiveDownloadLimitBytes(); dlBytes > 0 {
+		options["max_download_speed"] = float64(dlBytes) / 1024
+	}
+	for key, value := range opt.AdvanceOptions {
+		options[key] = value
+	}
+	return options
+}
+
+// isDuplicateError 判断是否为种子已存在的错误
+func isDuplicateError(err error) bool {
+	var rpcErr *rpcError
+	return errors.As(err, &rpcErr) && strings.Contains(rpcErr.Message, "already in session")
+}
+
+// AddTorrentEx 通过 URL 或磁力链接添加种子（新接口）
+func (d *DelugeClient) AddTorrentEx(torrentURL string, opt downloader.AddTorrentOptions) (downloader.AddTorrentResult, error) {
+	method := "core.add_torrent_url"
+	params := []any{torrentURL, buildAddOptions(opt)}
+	if strings.HasPrefix(torrentURL, "magnet:") {
+		method = "core.add_torrent_magnet"
+	}
+
+	var hash string
+	result, err := d.call(method, params...)
+	if err != nil {
+		if isDuplicateError(err) {
+			return downloader.AddTorrentResult{Success: true, Message: "Torrent already exists"}, nil
+		}
+		return downloader.AddTorrentResult{Success: false, Message: err.Error()}, err
+	}
+	_ = json.Unmarshal(result, &hash)
+
+	d.applyLabel(hash, opt.Category)
+	return downloader.AddTorrentResult{Success: true, Message: "Torrent added successfully", ID: hash, Hash: hash}, nil
+}
+
+// AddTorrentFileEx 添加种子文件到下载器（新接口）
+func (d *DelugeClient) AddTorrentFileEx(fileData []byte, opt downloader.AddTorrentOptions) (downloader.AddTorrentResult, error) {
+	hash, _ := qbit.ComputeTorrentHash(fileData)
+	filename := hash + ".torrent"
+	metainfo := base64.StdEncoding.EncodeToString(fileData)
+
+	result, err := d.call("core.add_torrent_file", filename, metainfo, buildAddOptions(opt))
+	if err != nil {
+		if isDuplicateError(err) {
+			return downloader.AddTorrentResult{
+				Success: true,
+				Message: "Torrent already exists",
+				ID:      hash,
+				Hash:    hash,
+			}, nil
+		}
+		return downloader.AddTorrentResult{Success: false, Message: err.Error()}, err
+	}
+
+	var added string
+	if err := json.Unmarshal(result, &added); err == nil && added != "" {
+		hash = added
+	}
+
+	d.applyLabel(hash, opt.Category)
+	if opt.Tags != "" {
+		sLogger().Debugf("[Deluge] 不支持标签，忽略: %s", opt.Tags)
+	}
+
+	return downloader.AddTorrentResult{
+		Success: true,
+		Message: "Torrent added successfully",
+		ID:      hash,
+		Hash:    hash,
+	}, nil
+}
+
+// applyLabel 添加种子后设置分类，Label 插件未启用时仅记录警告
+func (d *DelugeClient) applyLabel(hash, category string) {
+	if hash == "" || category == "" {
+		return
+	}
+	if err := d.SetTorrentCategory(hash, category); err != nil {
+		sLogger().Warnf("[Deluge] 设置分类失败 %s: %v", hash, err)
+	}
+}
+
+// AddTorrent 添加种子到 Deluge
+func (d *DelugeClient) AddTorrent(fileData []byte, category, tags string) error {
+	return d.AddTorrentWithPath(fileData, category, tags, "")
+}
+
+// AddTorrentWithPath 添加种子到 Deluge 并指定下载路径
+func (d *DelugeClient) AddTorrentWithPath(fileData []byte, category, tags, downloadPath string) error {
+	opt := downloader.AddTorrentOptions{
+		AddAtPaused: !d.autoStart,
+		SavePath:    downloadPath,
+		Category:    category,
+		Tags:        tags,
+	}
+	result, err := d.AddTorrentFileEx(fileData, opt)
+	if err != nil {
+		return fmt.Errorf("failed to add torrent: %w", err)
+	}
+	sLogger().Infof("[Deluge] %v: %s", result.Message, result.Hash)
+	return nil
+}
+
+// PauseTorrent 暂停种子
+func (d *DelugeClient) PauseTorrent(id string) error {
+	return d.PauseTorrents([]string{id})
+}
+
+// ResumeTorrent 恢复种子
+func (d *DelugeClient) ResumeTorrent(id string) error {
+	return d.ResumeTorrents([]string{id})
+}
+
+// RemoveTorrent 删除种子
+func (d *DelugeClient) RemoveTorrent(id string, removeData bool) error {
+	return d.RemoveTorrents([]string{id}, removeData)
+}
+
+func normalizeDelugeIDs(ids []string) []string {
+	normalized := make([]string, 0, len(ids))
+	for _, id := range ids {
+		trimmed := strings.ToLower(strings.TrimSpace(id))
+		if trimmed != "" {
+			normalized = append(normalized, trimmed)
+		}
+	}
+	return normalized
+}
+
+// PauseTorrents 批量暂停种子
+func (d *DelugeClient) PauseTorrents(ids []string) error {
+	normalizedIDs := normalizeDelugeIDs(ids)
+	if len(normalizedIDs) == 0 {
+		return nil
+	}
+	if _, err := d.call("core.pause_torrents", normalizedIDs); err != nil {
+		return fmt.Errorf("failed to pause torrents: %w", err)
+	}
+	return nil
+}
+
+// ResumeTorrents 批量恢复种子
+func (d *DelugeClient) ResumeTorrents(ids []string) error {
+	normalizedIDs := normalizeDelugeIDs(ids)
+	if len(normalizedIDs) == 0 {
+		return nil
+	}
+	if _, err := d.call("core.resume_torrents", normalizedIDs); err != nil {
+		return fmt.Errorf("failed to resume torrents: %w", err)
+	}
+	return nil
+}
+
+// RemoveTorrents 批量删除种子
+// core.remove_torrents 返回删除失败的 [id, 错误信息] 列表
+func (d *DelugeClient) RemoveTorrents(ids []string, removeData bool) error {
+	normalizedIDs := normalizeDelugeIDs(ids)
+	if len(normalizedIDs) == 0 {
+		return nil
+	}
+
+	var failures [][]any
+	if err := d.callInto(&failures, "core.remove_torrents", normalizedIDs, removeData); err != nil {
+		return fmt.Errorf("failed to remove torrents: %w", err)
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to remove torrents: %v", failures)
+	}
+	return nil
+}
+
+// SetTorrentCategory 通过 Label 插件设置种子分类
+// Deluge 的 label 只允许小写字母、数字、下划线和连字符
+func (d *DelugeClient) SetTorrentCategory(id, category string) error {
+	label := strings.ToLower(strings.TrimSpace(category))
+	if label != "" {
+		// 标签已存在时 label.add 会报错，忽略即可
+		_, _ = d.call("label.add", label)
+	}
+	if _, err := d.call("label.set_torrent", strings.ToLower(id), label); err != nil {
+		return fmt.Errorf("failed to set torrent label: %w", err)
+	}
+	return nil
+}
+
+// SetTorrentTags Deluge 没有标签概念
+func (d *DelugeClient) SetTorrentTags(id, tags string) error {
+	return fmt.Errorf("Deluge 不支持标签")
+}
+
+// SetTorrentSavePath 移动种子数据到新的保存路径
+func (d *DelugeClient) SetTorrentSavePath(id, path string) error {
+	ids := normalizeDelugeIDs([]string{id})
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := d.call("core.move_storage", ids, path); err != nil {
+		return fmt.Errorf("failed to set torrent save path: %w", err)
+	}
+	return nil
+}
+
+// RecheckTorrent 重新校验种子
+func (d *DelugeClient) RecheckTorrent(id string) error {
+	ids := normalizeDelugeIDs([]string{id})
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := d.call("core.force_recheck", ids); err != nil {
+		return fmt.Errorf("failed to recheck torrent: %w", err)
+	}
+	return nil
+}
+
+// mapDelugePriority 将 Deluge 文件优先级 (0=跳过, 1=低, 4=普通, 7=高) 映射到通用优先级
+func mapDelugePriority(priority int) int {
+	switch {
+	case priority <= 0:
+		return 0
+	case priority >= 7:
+		return 7
+	case priority >= 5:
+		return 6
+	default:
+		return 1
+	}
+}
+
+// GetTorrentFiles 获取种子文件列表
+func (d *DelugeClient) GetTorrentFiles(id string) ([]downloader.TorrentFile, error) {
+	var st struct {
+		Files          []delugeFile `json:"files"`
+		FileProgress   []float64    `json:"file_progress"`
+		FilePriorities []int        `json:"file_priorities"`
+	}
+	keys := []string{"files", "file_progress", "file_priorities"}
+	if err := d.callInto(&st, "core.get_torrent_status", strings.ToLower(id), keys); err != nil {
+		return nil, fmt.Errorf("failed to get torrent files: %w", err)
+	}
+	if st.Files == nil {
+		return nil, downloader.ErrTorrentNotFound
+	}
+
+	files := make([]downloader.TorrentFile, 0, len(st.Files))
+	for i, file := range st.Files {
+		progress := 0.0
+		if i < len(st.FileProgress) {
+			progress = st.FileProgress[i]
+		}
+		priority := 1
+		if i < len(st.FilePriorities) {
+			priority = mapDelugePriority(st.FilePriorities[i])
+		}
+		files = append(files, downloader.TorrentFile{
+			Index:    file.Index,
+			Name:     file.Path,
+			Size:     file.Size,
+			Progress: progress,
+			Priority: priority,
+		})
+	}
+	return files, nil
+}
+
+// GetTorrentTrackers 获取种子 Tracker 列表
+// Deluge 只报告当前 Tracker 的状态，其余 Tracker 视为未联系
+func (d *DelugeClient) GetTorrentTrackers(id string) ([]downloader.TorrentTracker, error) {
+	var st struct {
+		Trackers      []delugeTracker `json:"trackers"`
+		Tracker       string          `json:"tracker"`
+		TrackerStatus string          `json:"tracker_status"`
+		NumSeeds      int             `json:"num_seeds"`
+		NumPeers      int             `json:"num_peers"`
+	}
+	keys := []string{"trackers", "tracker", "tracker_status", "num_seeds", "num_peers"}
+	if err := d.callInto(&st, "core.get_torrent_status", strings.ToLower(id), keys); err != nil {
+		return nil, fmt.Errorf("failed to get torrent trackers: %w", err)
+	}
+	if st.Trackers == nil {
+		return nil, downloader.ErrTorrentNotFound
+	}
+
+	trackers := make([]downloader.TorrentTracker, 0, len(st.Trackers))
+	for _, tr := range st.Trackers {
+		tracker := downloader.TorrentTracker{URL: tr.URL, Status: 1}
+		if tr.URL == st.Tracker {
+			tracker.Message = st.TrackerStatus
+			tracker.Seeds = st.NumSeeds
+			tracker.Peers = st.NumPeers
+			switch {
+			case strings.Contains(st.TrackerStatus, "Error"):
+				tracker.Status = 4
+			case strings.Contains(st.TrackerStatus, "OK"):
+				tracker.Status = 2
+			default:
+				tracker.Status = 3
+			}
+		}
+		trackers = append(trackers, tracker)
+	}
+	return trackers, nil
+}
+
+// GetDiskInfo 获取默认下载目录的磁盘信息
+func (d *DelugeClient) GetDiskInfo() (downloader.DiskInfo, error) {
+	var path string
+	if err := d.callInto(&path, "core.get_config_value", "download_location"); err != nil {
+		return downloader.DiskInfo{}, fmt.Errorf("failed to get download location: %w", err)
+	}
+
+	var freeSpace int64
+	if err := d.callInto(&freeSpace, "core.get_free_space", path); err != nil {
+		return downloader.DiskInfo{}, fmt.Errorf("failed to get free space: %w", err)
+	}
+
+	return downloader.DiskInfo{
+		Path:      path,
+		FreeSpace: freeSpace,
+		TotalSize: 0,
+	}, nil
+}
+
+// GetSpeedLimit 获取全局限速
+// Deluge 以 KiB/s 表示限速，-1 表示不限
+func (d *DelugeClient) GetSpeedLimit() (downloader.SpeedLimit, error) {
+	var cfg struct {
+		MaxDownloadSpeed float64 `json:"max_download_speed"`
+		MaxUploadSpeed   float64 `json:"max_upload_speed"`
+	}
+	keys := []string{"max_download_speed", "max_upload_speed"}
+	if err := d.callInto(&cfg, "core.get_config_values", keys); err != nil {
+		return downloader.SpeedLimit{}, fmt.Errorf("failed to get speed limits: %w", err)
+	}
+
+	limit := downloader.SpeedLimit{}
+	if cfg.MaxDownloadSpeed > 0 {
+		limit.DownloadLimit = int64(cfg.MaxDownloadSpeed * 1024)
+	}
+	if cfg.MaxUploadSpeed > 0 {
+		limit.UploadLimit = int64(cfg.MaxUploadSpeed * 1024)
+	}
+	limit.LimitEnabled = limit.DownloadLimit > 0 || limit.UploadLimit > 0
+	return limit, nil
+}
+
+// SetSpeedLimit 设置全局限速
+func (d *DelugeClient) SetSpeedLimit(limit downloader.SpeedLimit) error {
+	toKiB := func(bytesPerSec int64) float64 {
+		if !limit.LimitEnabled || bytesPerSec <= 0 {
+			return -1
+		}
+		return float64(bytesPerSec) / 1024
+	}
+	cfg := map[string]any{
+		"max_download_speed": toKiB(limit.DownloadLimit),
+		"max_upload_speed":   toKiB(limit.UploadLimit),
+	}
+	if _, err := d.call("core.set_config", cfg); err != nil {
+		return fmt.Errorf("failed to set speed limits: %w", err)
+	}
+	return nil
+}
+
+// GetClientPaths 获取下载器配置的保存路径列表
+func (d *DelugeClient) GetClientPaths() ([]string, error) {
+	var cfg struct {
+		DownloadLocation  string `json:"download_location"`
+		MoveCompleted     bool   `json:"move_completed"`
+		MoveCompletedPath string `json:"move_completed_path"`
+	}
+	keys := []string{"download_location", "move_completed", "move_completed_path"}
+	if err := d.callInto(&cfg, "core.get_config_values", keys); err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	var paths []string
+	if cfg.DownloadLocation != "" {
+		paths = append(paths, cfg.DownloadLocation)
+	}
+	if cfg.MoveCompleted && cfg.MoveCompletedPath != "" && cfg.MoveCompletedPath != cfg.DownloadLocation {
+		paths = append(paths, cfg.MoveCompletedPath)
+	}
+	return paths, nil
+}
+
+// GetClientLabels 获取 Label 插件中的标签列表
+// 插件未启用时返回空列表
+func (d *DelugeClient) GetClientLabels() ([]string, error) {
+	var labels []string
+	if err := d.callInto(&labels, "label.get_labels"); err != nil {
+		sLogger().Debugf("[Deluge] 获取标签失败（Label 插件可能未启用）: %v", err)
+		return []string{}, nil
+	}
+	if labels == nil {
+		labels = []string{}
+	}
+	return labels, nil
+}
+
+// ProcessSingleTorrentFile 处理单个种子文件
+func (d *DelugeClient) ProcessSingleTorrentFile(ctx context.Context, filePath, category, tags string) error {
+	freeSpace, err := d.GetDiskSpace(ctx)
+	if err != nil {
+		sLogger().Warnf("Failed to check disk space: %v, continuing anyway", err)
+	} else {
+		sLogger().Info("Available disk space: ", float64(freeSpace)/(1024*1024*1024))
+	}
+
+	if err := d.processTorrentFile(ctx, filePath, category, tags); err != nil {
+		return fmt.Errorf("failed to process torrent file: %w", err)
+	}
+
+	sLogger().Infof("Processed single torrent file: %s", filePath)
+	return nil
+}
+
+func (d *DelugeClient) processTorrentFile(ctx context.Context, filePath, category, tags string) error {
+	sLogger().Info("Processing torrent file: ", filePath)
+
+	torrentData, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read torrent file: %w", err)
+	}
+
+	torrentHash, err := qbit.ComputeTorrentHash(torrentData)
+	if err != nil {
+		return fmt.Errorf("unable to compute torrent hash: %w", err)
+	}
+
+	exists, err := d.CheckTorrentExists(torrentHash)
+	if err != nil {
+		return fmt.Errorf("failed to check torrent: %w", err)
+	}
+
+	if exists {
+		if err = os.Remove(filePath); err != nil {
+			return fmt.Errorf("torrent exists but failed to delete local file: %w", err)
+		}
+		sLogger().Info("Torrent exists, local file deleted: ", filePath)
+		return nil
+	}
+
+	canAdd, err := d.CanAddTorrent(ctx, int64(len(torrentData)))
+	if err != nil {
+		sLogger().Warnf("Unable to check disk space: %v, attempting to add anyway", err)
+		canAdd = true
+	}
+
+	if !canAdd {
+		sLogger().Error("Insufficient disk space, skipping torrent: ", filePath)
+		return nil
+	}
+
+	if err := d.AddTorrent(torrentData, category, tags); err != nil {
+		return fmt.Errorf("failed to add torrent: %w", err)
+	}
+
+	sLogger().Info("Torrent added successfully: ", filePath)
+	return nil
+}
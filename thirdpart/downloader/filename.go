@@ -0,0 +1,102 @@
+package downloader
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// FilenameOptions 种子文件名规范化选项
+type FilenameOptions struct {
+	// Prefix 文件名前缀（如站点 ID），计入 MaxLength
+	Prefix string
+	// Replacements 额外的字符串替换（old -> new），在非法字符替换之后执行
+	Replacements map[string]string
+	// SpaceReplacement 非空时将连续空白替换为该字符串
+	SpaceReplacement string
+	// MaxLength 不含扩展名的最大长度（按字符计），0 表示不限制
+	MaxLength int
+	// Extension 强制的扩展名（如 ".torrent"），为空表示不处理
+	Extension string
+}
+
+// DefaultFilenameOptions 返回下载种子落盘时使用的默认选项
+func DefaultFilenameOptions() FilenameOptions {
+	return FilenameOptions{
+		MaxLength: 200,
+		Extension: ".torrent",
+	}
+}
+
+// invalidFilenameReplacer 将文件系统非法字符替换为下划线
+var invalidFilenameReplacer = strings.NewReplacer(
+	"/", "_", "\\", "_", ":", "_", "*", "_", "?", "_",
+	"\"", "_", "<", "_", ">", "_", "|", "_",
+)
+
+// SanitizeFilename 按选项规范化文件名：替换非法字符、应用自定义替换、
+// 添加前缀、截断长度并确保扩展名。结果为空时使用 "unnamed"。
+func SanitizeFilename(name string, opts FilenameOptions) string {
+	ext := opts.Extension
+	if ext != "" && !strings.HasPrefix(ext, ".") {
+		ext = "." + ext
+	}
+
+	result := strings.TrimSpace(name)
+	if ext != "" && len(result) >= len(ext) && strings.EqualFold(result[len(result)-len(ext):], ext) {
+		result = result[:len(result)-len(ext)]
+	}
+
+	result = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return '_'
+		}
+		return r
+	}, result)
+	result = invalidFilenameReplacer.Replace(result)
+
+	if len(opts.Replacements) > 0 {
+		olds := make([]string, 0, len(opts.Replacements))
+		for old := range opts.Replacements {
+			if old != "" {
+				olds = append(olds, old)
+			}
+		}
+		// 长的匹配优先，保证结果确定
+		sort.Slice(olds, func(i, j int) bool {
+			if len(olds[i]) != len(olds[j]) {
+				return len(olds[i]) > len(olds[j])
+			}
+			return olds[i] < olds[j]
+		})
+		pairs := make([]string, 0, len(olds)*2)
+		for _, old := range olds {
+			pairs = append(pairs, old, opts.Replacements[old])
+		}
+		result = strings.NewReplacer(pairs...).Replace(result)
+	}
+
+	if opts.SpaceReplacement != "" {
+		result = strings.Join(strings.Fields(result), opts.SpaceReplacement)
+	}
+
+	result = opts.Prefix + trimFilename(result)
+
+	if opts.MaxLength > 0 {
+		if runes := []rune(result); len(runes) > opts.MaxLength {
+			result = string(runes[:opts.MaxLength])
+		}
+	}
+
+	result = trimFilename(result)
+	if result == "" {
+		result = "unnamed"
+	}
+
+	return result + ext
+}
+
+// trimFilename 去除首尾的空格和点
+func trimFilename(s string) string {
+	return strings.Trim(s, " .")
+}
@@ -0,0 +1,66 @@
+package downloader
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeFilename_InvalidCharacters(t *testing.T) {
+	opts := FilenameOptions{MaxLength: 200}
+	assert.Equal(t, "normal_name", SanitizeFilename("normal_name", opts))
+	assert.Equal(t, "a_b_c_d", SanitizeFilename("a/b\\c:d", opts))
+	assert.Equal(t, "no_stars_", SanitizeFilename("no*stars?", opts))
+	assert.Equal(t, "line_break", SanitizeFilename("line\nbreak", opts))
+	assert.Equal(t, "unnamed", SanitizeFilename("   ", opts))
+	assert.Equal(t, "unnamed", SanitizeFilename("...", opts))
+	assert.Equal(t, "trimmed", SanitizeFilename(" .trimmed. ", opts))
+}
+
+func TestSanitizeFilename_SpaceReplacement(t *testing.T) {
+	opts := FilenameOptions{SpaceReplacement: "."}
+	assert.Equal(t, "The.Movie.2025.1080p", SanitizeFilename(" The Movie  2025 1080p ", opts))
+	assert.Equal(t, "a b", SanitizeFilename("a b", FilenameOptions{}))
+}
+
+func TestSanitizeFilename_Truncation(t *testing.T) {
+	long := strings.Repeat("x", 300)
+	got := SanitizeFilename(long, FilenameOptions{MaxLength: 200, Extension: ".torrent"})
+	assert.Equal(t, strings.Repeat("x", 200)+".torrent", got)
+
+	// Truncation counts characters, never splitting multi-byte runes
+	cn := strings.Repeat("电影", 10)
+	got = SanitizeFilename(cn, FilenameOptions{MaxLength: 5})
+	assert.Equal(t, "电影电影电", got)
+
+	// The prefix counts towards the limit
+	got = SanitizeFilename("abcdef", FilenameOptions{Prefix: "[hdsky]", MaxLength: 10})
+	assert.Equal(t, "[hdsky]abc", got)
+}
+
+func TestSanitizeFilename_Extension(t *testing.T) {
+	opts := FilenameOptions{Extension: ".torrent"}
+	assert.Equal(t, "movie.torrent", SanitizeFilename("movie", opts))
+	assert.Equal(t, "movie.torrent", SanitizeFilename("movie.torrent", opts))
+	assert.Equal(t, "movie.torrent", SanitizeFilename("movie.TORRENT", opts))
+	assert.Equal(t, "movie.torrent", SanitizeFilename("movie", FilenameOptions{Extension: "torrent"}))
+	assert.Equal(t, "unnamed.torrent", SanitizeFilename("", opts))
+	assert.Equal(t, "movie.torrent", SanitizeFilename("movie.torrent", FilenameOptions{}))
+}
+
+func TestSanitizeFilename_ReplacementsAndPrefix(t *testing.T) {
+	opts := FilenameOptions{
+		Prefix:       "hdsky_",
+		Replacements: map[string]string{"[": "", "]": "", "[FREE]": "free"},
+		Extension:    ".torrent",
+	}
+	assert.Equal(t, "hdsky_free Movie.torrent", SanitizeFilename("[FREE] Movie", opts))
+	assert.Equal(t, "hdsky_Show S01.torrent", SanitizeFilename("[Show] S01", opts))
+}
+
+func TestDefaultFilenameOptions(t *testing.T) {
+	opts := DefaultFilenameOptions()
+	assert.Equal(t, 200, opts.MaxLength)
+	assert.Equal(t, ".torrent", opts.Extension)
+}
@@ -11,6 +11,10 @@ import (
 	"github.com/sunerpy/pt-tools/utils/httpclient"
 )
 
+// DefaultRequestTimeout is the per-request timeout of downloader clients
+// whose config does not set one (see TimeoutConfig).
+const DefaultRequestTimeout = 30 * time.Second
+
 // HTTPDoer defines the minimal contract used by downloader clients.
 type HTTPDoer interface {
 	Do(req *http.Request) (*http.Response, error)
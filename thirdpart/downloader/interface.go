@@ -3,6 +3,8 @@ package downloader
 import (
 	"context"
 	"errors"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -12,8 +14,16 @@ type DownloaderType string
 const (
 	DownloaderQBittorrent  DownloaderType = "qbittorrent"
 	DownloaderTransmission DownloaderType = "transmission"
+	DownloaderDeluge       DownloaderType = "deluge"
+	DownloaderAria2        DownloaderType = "aria2"
 )
 
+// SupportsDryRun 判断该类型的客户端是否实现了演练模式
+// Deluge 与 Aria2 暂不支持，开启后仍会实际添加种子
+func SupportsDryRun(dlType DownloaderType) bool {
+	return dlType == DownloaderQBittorrent || dlType == DownloaderTransmission
+}
+
 // TorrentState 种子状态
 type TorrentState string
 
@@ -100,6 +110,23 @@ type TorrentTracker struct {
 	Message string // 状态消息
 }
 
+// TrackerMatchesHost 判断 Tracker URL 是否属于指定主机（忽略端口与大小写，子域名也视为匹配）
+func TrackerMatchesHost(trackerURL, host string) bool {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if trackerURL == "" || host == "" {
+		return false
+	}
+	u, err := url.Parse(strings.TrimSpace(trackerURL))
+	if err != nil {
+		return false
+	}
+	trackerHost := strings.ToLower(u.Hostname())
+	if trackerHost == "" {
+		return false
+	}
+	return trackerHost == host || strings.HasSuffix(trackerHost, "."+host)
+}
+
 // SpeedLimit 速度限制
 type SpeedLimit struct {
 	DownloadLimit int64 // 下载限速 (bytes/s), 0=不限
@@ -114,6 +141,40 @@ type DiskInfo struct {
 	TotalSize int64  // 总空间 (bytes), 0 表示未知
 }
 
+// TorrentProperties 单个种子的详细属性
+type TorrentProperties struct {
+	SavePath     string // 保存路径
+	Comment      string // 种子注释
+	CreatedBy    string // 制作工具
+	CreationDate int64  // 种子创建时间 (Unix timestamp), -1=未知
+	AdditionDate int64  // 添加时间 (Unix timestamp)
+	SeedingTime  int64  // 做种时间(秒)
+	PieceSize    int64  // 分块大小 (bytes)
+	TotalWasted  int64  // 浪费的数据量 (bytes)
+}
+
+// AggregateStats 一组种子的汇总统计
+type AggregateStats struct {
+	Count           int                  // 种子数量
+	TotalSize       int64                // 总大小 (bytes)
+	TotalUploaded   int64                // 总上传量 (bytes)
+	TotalDownloaded int64                // 总下载量 (bytes)
+	ByState         map[TorrentState]int // 各状态的种子数量
+}
+
+// Capabilities 下载器支持的功能
+// 调用方据此分支，避免调用下载器不支持的功能
+type Capabilities struct {
+	Magnet               bool // 支持通过磁力链接添加
+	Categories           bool // 原生支持分类（否则以标签模拟）
+	Tags                 bool // 支持标签
+	LabelsAsArray        bool // 标签以数组形式传递，而非逗号分隔字符串
+	ForceStart           bool // 支持强制开始（忽略队列）
+	SuperSeeding         bool // 支持超级做种
+	SkipHashCheck        bool // 支持添加时跳过哈希校验
+	PerTorrentSpeedLimit bool // 支持单种限速
+}
+
 // AddTorrentOptions 添加种子的选项
 // 用于统一控制种子添加行为，替代原有的分散参数
 type AddTorrentOptions struct {
@@ -147,6 +208,14 @@ type AddTorrentOptions struct {
 	// 0 表示不限制
 	DownloadSpeedLimitKBs int
 
+	// AddToTopOfQueue 启用排队时将种子添加到队列顶部
+	// false 时保持下载器默认行为（添加到队列底部）
+	AddToTopOfQueue bool
+
+	// SkipHashCheck 添加时跳过哈希校验（仅支持 SkipHashCheck 能力的下载器生效）
+	// 适用于文件已完整存在的场景，默认 false 以保证数据安全
+	SkipHashCheck bool
+
 	// AdvanceOptions 高级选项（可选）
 	// 用于传递客户端特定的高级配置
 	AdvanceOptions map[string]any
@@ -203,6 +272,8 @@ type DownloaderConfig interface {
 	// Deprecated: 请使用 AddTorrentOptions.AddAtPaused 替代
 	// autoStart=true 等价于 AddAtPaused=false
 	GetAutoStart() bool
+	// GetDryRun 是否为演练模式：添加种子时只记录日志不实际提交
+	GetDryRun() bool
 	// Validate 验证配置是否有效
 	Validate() error
 }
@@ -219,7 +290,7 @@ func ToAddTorrentOptions(config DownloaderConfig, category, tags, savePath strin
 }
 
 // Downloader 下载器核心接口
-// 所有下载器实现（qBittorrent、Transmission 等）都必须实现此接口
+// 所有下载器实现（qBittorrent、Transmission、Deluge 等）都必须实现此接口
 type Downloader interface {
 	// Authenticate 认证连接到下载器
 	// 返回错误如果认证失败
@@ -305,6 +376,9 @@ type Downloader interface {
 	// GetClientLabels 获取下载器配置的标签列表
 	GetClientLabels() ([]string, error)
 
+	// Capabilities 获取下载器支持的功能
+	Capabilities() Capabilities
+
 	// GetType 获取下载器类型
 	GetType() DownloaderType
 
@@ -359,6 +433,8 @@ type GenericConfig struct {
 	Username  string         `json:"username"`
 	Password  string         `json:"password"`
 	AutoStart bool           `json:"auto_start"`
+	// DryRun 演练模式：添加种子时只记录摘要，不实际提交（仅 qBittorrent/Transmission 生效）
+	DryRun bool `json:"dry_run"`
 }
 
 // GetType 获取下载器类型
@@ -386,6 +462,11 @@ func (c *GenericConfig) GetAutoStart() bool {
 	return c.AutoStart
 }
 
+// GetDryRun 获取是否为演练模式
+func (c *GenericConfig) GetDryRun() bool {
+	return c.DryRun
+}
+
 // Validate 验证配置是否有效
 func (c *GenericConfig) Validate() error {
 	if c.URL == "" {
@@ -24,6 +24,7 @@ func (c *MockConfig) GetURL() string          { return c.URL }
 func (c *MockConfig) GetUsername() string     { return c.Username }
 func (c *MockConfig) GetPassword() string     { return c.Password }
 func (c *MockConfig) GetAutoStart() bool      { return c.AutoStart }
+func (c *MockConfig) GetDryRun() bool         { return false }
 func (c *MockConfig) Validate() error {
 	if c.URL == "" {
 		return ErrInvalidConfig
@@ -168,7 +169,11 @@ func TestGenericConfigGetters(t *testing.T) {
 	assert.Equal(t, "user", c.GetUsername())
 	assert.Equal(t, "pass", c.GetPassword())
 	assert.True(t, c.GetAutoStart())
+	assert.False(t, c.GetDryRun())
 	assert.NoError(t, c.Validate())
+
+	c.DryRun = true
+	assert.True(t, c.GetDryRun())
 }
 
 func TestGenericConfigValidate(t *testing.T) {
@@ -176,3 +181,30 @@ func TestGenericConfigValidate(t *testing.T) {
 	assert.ErrorIs(t, (&GenericConfig{URL: "http://x"}).Validate(), ErrInvalidConfig)
 	assert.NoError(t, (&GenericConfig{Type: DownloaderQBittorrent, URL: "http://x"}).Validate())
 }
+
+func TestTrackerMatchesHost(t *testing.T) {
+	tests := []struct {
+		tracker string
+		host    string
+		want    bool
+	}{
+		{"https://site-a.com/announce.php?passkey=x", "site-a.com", true},
+		{"https://tracker.site-a.com:8443/announce", "site-a.com", true},
+		{"https://SITE-A.com/announce", "Site-A.com", true},
+		{"https://notsite-a.com/announce", "site-a.com", false},
+		{"** [DHT] **", "site-a.com", false},
+		{"", "site-a.com", false},
+		{"https://site-a.com/announce", "", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, TrackerMatchesHost(tt.tracker, tt.host), "%s vs %s", tt.tracker, tt.host)
+	}
+}
+
+func TestSupportsDryRun(t *testing.T) {
+	assert.True(t, SupportsDryRun(DownloaderQBittorrent))
+	assert.True(t, SupportsDryRun(DownloaderTransmission))
+	assert.False(t, SupportsDryRun(DownloaderDeluge))
+	assert.False(t, SupportsDryRun(DownloaderAria2))
+}
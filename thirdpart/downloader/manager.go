@@ -410,6 +410,7 @@ type DownloaderDBRecord struct {
 	IsDefault bool
 	Enabled   bool
 	AutoStart bool
+	DryRun    bool
 }
 
 // SyncFromDB 从数据库记录同步下载器配置
@@ -465,6 +466,7 @@ func (dm *DownloaderManager) SyncFromDB(records []DownloaderDBRecord) {
 			continue
 		}
 		config := NewGenericConfig(r.Type, r.URL, r.Username, r.Password, r.AutoStart)
+		config.DryRun = r.DryRun
 		dm.configs[name] = config
 	}
 
@@ -495,5 +497,8 @@ func (dm *DownloaderManager) configChanged(oldConfig DownloaderConfig, newRecord
 	if oldConfig.GetAutoStart() != newRecord.AutoStart {
 		return true
 	}
+	if oldConfig.GetDryRun() != newRecord.DryRun {
+		return true
+	}
 	return false
 }
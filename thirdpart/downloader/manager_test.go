@@ -62,6 +62,7 @@ func (m *MockDownloader) SetSpeedLimit(limit SpeedLimit) error { return nil }
 func (m *MockDownloader) GetClientPaths() ([]string, error)    { return nil, nil }
 
 func (m *MockDownloader) GetClientLabels() ([]string, error)                      { return nil, nil }
+func (m *MockDownloader) Capabilities() Capabilities                              { return Capabilities{} }
 func (m *MockDownloader) AddTorrent(fileData []byte, category, tags string) error { return nil }
 func (m *MockDownloader) AddTorrentWithPath(fileData []byte, category, tags, downloadPath string) error {
 	return nil
@@ -425,6 +426,7 @@ func (m *StatefulMockDownloader) GetSpeedLimit() (SpeedLimit, error)   { return
 func (m *StatefulMockDownloader) SetSpeedLimit(limit SpeedLimit) error { return nil }
 func (m *StatefulMockDownloader) GetClientPaths() ([]string, error)    { return nil, nil }
 func (m *StatefulMockDownloader) GetClientLabels() ([]string, error)   { return nil, nil }
+func (m *StatefulMockDownloader) Capabilities() Capabilities           { return Capabilities{} }
 func (m *StatefulMockDownloader) AddTorrent(fileData []byte, category, tags string) error {
 	hash := string(fileData) // 简化：使用数据作为hash
 	m.torrentMap[hash] = true
@@ -1118,6 +1120,19 @@ func TestSyncFromDBClearsSiteMappingForRemoved(t *testing.T) {
 	require.Error(t, err, "site mapping to a removed downloader must be cleared")
 }
 
+func TestSyncFromDBPassesDryRun(t *testing.T) {
+	dm := NewDownloaderManager()
+	dm.RegisterFactory(DownloaderQBittorrent, MockDownloaderFactory)
+
+	dm.SyncFromDB([]DownloaderDBRecord{
+		{Name: "qbit-1", Type: DownloaderQBittorrent, URL: "http://x:8080", DryRun: true, Enabled: true, IsDefault: true},
+	})
+
+	cfg, ok := dm.configs["qbit-1"]
+	require.True(t, ok)
+	assert.True(t, cfg.GetDryRun())
+}
+
 func TestSyncFromDBConfigUnchangedKeepsInstance(t *testing.T) {
 	dm := NewDownloaderManager()
 	dm.RegisterFactory(DownloaderQBittorrent, MockDownloaderFactory)
@@ -1146,6 +1161,7 @@ func TestConfigChangedDetectsEachField(t *testing.T) {
 	assert.True(t, dm.configChanged(base, withRec(rec, func(r *DownloaderDBRecord) { r.Username = "u2" })))
 	assert.True(t, dm.configChanged(base, withRec(rec, func(r *DownloaderDBRecord) { r.Password = "p2" })))
 	assert.True(t, dm.configChanged(base, withRec(rec, func(r *DownloaderDBRecord) { r.AutoStart = false })))
+	assert.True(t, dm.configChanged(base, withRec(rec, func(r *DownloaderDBRecord) { r.DryRun = true })))
 }
 
 func withRec(r DownloaderDBRecord, mut func(*DownloaderDBRecord)) DownloaderDBRecord {
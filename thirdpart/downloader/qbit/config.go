@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/sunerpy/pt-tools/thirdpart/downloader"
 )
@@ -14,6 +15,12 @@ type QBitConfig struct {
 	Username  string `json:"username"`
 	Password  string `json:"password"`
 	AutoStart bool   `json:"auto_start"`
+	// SkipChecking 添加种子时跳过哈希校验（用于重新添加已完成的文件），默认关闭
+	SkipChecking bool `json:"skip_checking"`
+	// DryRun 演练模式：添加种子时只记录摘要，不向 qBittorrent 提交
+	DryRun bool `json:"dry_run"`
+	// Timeout 单次 HTTP 请求超时，0 表示使用 downloader.DefaultRequestTimeout
+	Timeout time.Duration `json:"-"`
 }
 
 // GetType 获取下载器类型
@@ -45,6 +52,16 @@ func (c *QBitConfig) GetAutoStart() bool {
 	return c.AutoStart
 }
 
+// GetDryRun 获取是否为演练模式
+func (c *QBitConfig) GetDryRun() bool {
+	return c.DryRun
+}
+
+// GetTimeout 获取单次请求超时
+func (c *QBitConfig) GetTimeout() time.Duration {
+	return c.Timeout
+}
+
 // Validate 验证配置是否有效
 func (c *QBitConfig) Validate() error {
 	if c.URL == "" {
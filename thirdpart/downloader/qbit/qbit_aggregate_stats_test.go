@@ -0,0 +1,66 @@
+package qbit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+func TestQbitGetAggregateStatsByTag(t *testing.T) {
+	var tagQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tagQuery = r.URL.Query().Get("tag")
+		// 服务端忽略 tag 参数，未带标签的种子须被本地过滤
+		_ = json.NewEncoder(w).Encode([]map[string]any{
+			{"hash": "a", "tags": "pt-tools", "state": "uploading", "size": 1000, "uploaded": 3000, "downloaded": 1000},
+			{"hash": "b", "tags": "free, pt-tools", "state": "stalledUP", "size": 2000, "uploaded": 500, "downloaded": 2000},
+			{"hash": "c", "tags": "pt-tools", "state": "downloading", "size": 4000, "uploaded": 10, "downloaded": 100},
+			{"hash": "d", "tags": "manual", "state": "uploading", "size": 8000, "uploaded": 9000, "downloaded": 8000},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	c := coverageTestClient(srv.URL, false)
+
+	stats, err := c.GetAggregateStatsByTag("pt-tools")
+	require.NoError(t, err)
+
+	assert.Equal(t, "pt-tools", tagQuery)
+	assert.Equal(t, 3, stats.Count)
+	assert.Equal(t, int64(7000), stats.TotalSize)
+	assert.Equal(t, int64(3510), stats.TotalUploaded)
+	assert.Equal(t, int64(3100), stats.TotalDownloaded)
+	assert.Equal(t, map[downloader.TorrentState]int{
+		downloader.TorrentSeeding:     2,
+		downloader.TorrentDownloading: 1,
+	}, stats.ByState)
+}
+
+func TestQbitGetAggregateStatsByTag_NoMatches(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]any{{"hash": "d", "tags": "manual", "size": 8000}})
+	}))
+	t.Cleanup(srv.Close)
+	c := coverageTestClient(srv.URL, false)
+
+	stats, err := c.GetAggregateStatsByTag("pt-tools")
+	require.NoError(t, err)
+	assert.Zero(t, stats.Count)
+	assert.Zero(t, stats.TotalSize)
+	assert.Empty(t, stats.ByState)
+}
+
+func TestQbitGetAggregateStatsByTag_Errors(t *testing.T) {
+	srv := failStatusServer(t, http.StatusInternalServerError)
+	c := coverageTestClient(srv.URL, false)
+
+	_, err := c.GetAggregateStatsByTag("")
+	assert.ErrorIs(t, err, downloader.ErrInvalidConfig)
+	_, err = c.GetAggregateStatsByTag("pt-tools")
+	assert.Error(t, err)
+}
@@ -306,3 +306,18 @@ func TestQbitGetClientLabels(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+// TestQbitClientCapabilities 测试 qBittorrent 功能声明
+func TestQbitClientCapabilities(t *testing.T) {
+	var d downloader.Downloader = coverageTestClient("http://127.0.0.1:1", false)
+	caps := d.Capabilities()
+
+	assert.True(t, caps.Magnet)
+	assert.True(t, caps.Categories)
+	assert.True(t, caps.Tags)
+	assert.False(t, caps.LabelsAsArray)
+	assert.True(t, caps.ForceStart)
+	assert.True(t, caps.SuperSeeding)
+	assert.True(t, caps.SkipHashCheck)
+	assert.True(t, caps.PerTorrentSpeedLimit)
+}
@@ -0,0 +1,77 @@
+package qbit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+func newConnectionTestServer(t *testing.T, user, pass string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/auth/login":
+			_ = r.ParseForm()
+			if r.FormValue("username") != user || r.FormValue("password") != pass {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte("Fails."))
+				return
+			}
+			_, _ = w.Write([]byte("Ok."))
+		case "/api/v2/app/version":
+			_, _ = w.Write([]byte("v4.6.7"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newConnectionTestManager() *downloader.DownloaderManager {
+	dm := downloader.NewDownloaderManager()
+	dm.RegisterFactory(downloader.DownloaderQBittorrent, NewQbitClient)
+	return dm
+}
+
+func TestQbitTestConnection_Success(t *testing.T) {
+	srv := newConnectionTestServer(t, "admin", "secret")
+	defer srv.Close()
+
+	require.NoError(t, newConnectionTestManager().TestConnection(NewQBitConfig(srv.URL, "admin", "secret")))
+}
+
+func TestQbitTestConnection_AuthFailure(t *testing.T) {
+	srv := newConnectionTestServer(t, "admin", "secret")
+	defer srv.Close()
+
+	err := newConnectionTestManager().TestConnection(NewQBitConfig(srv.URL, "admin", "wrong"))
+	require.ErrorIs(t, err, downloader.ErrConnectionFailed)
+	assert.Contains(t, err.Error(), "401")
+}
+
+func TestQbitTestConnection_GenericConfig(t *testing.T) {
+	srv := newConnectionTestServer(t, "admin", "secret")
+	defer srv.Close()
+
+	config := downloader.NewGenericConfig(downloader.DownloaderQBittorrent, srv.URL, "admin", "secret", true)
+	require.NoError(t, newConnectionTestManager().TestConnection(config))
+}
+
+func TestQbitTestConnection_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	start := time.Now()
+	err := newConnectionTestManager().TestConnectionWithTimeout(NewQBitConfig(srv.URL, "admin", "secret"), 100*time.Millisecond)
+	require.ErrorIs(t, err, downloader.ErrConnectionFailed)
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
@@ -0,0 +1,41 @@
+package qbit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+// TestQbitDryRun_SkipsAddRequest 验证演练模式下不发送 /torrents/add，其他查询照常执行
+func TestQbitDryRun_SkipsAddRequest(t *testing.T) {
+	srv, mu, captured := newCapturingQbitServer(t)
+	defer srv.Close()
+	config := NewQBitConfig(srv.URL, "admin", "pwd")
+	config.DryRun = true
+	cli, err := NewQbitClient(config, "test-qbit")
+	require.NoError(t, err)
+	defer cli.Close()
+	qc := cli.(*QbitClient)
+
+	wantHash, err := ComputeTorrentHash(fixtureTorrentBytes())
+	require.NoError(t, err)
+
+	exists, err := qc.CheckTorrentExists(wantHash)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, qc.AddTorrentWithPath(fixtureTorrentBytes(), "movies", "pt", "/downloads"))
+
+	result, err := qc.AddTorrentFileEx(fixtureTorrentBytes(), downloader.AddTorrentOptions{Category: "movies", SavePath: "/downloads"})
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, "dry-run", result.Message)
+	assert.Equal(t, wantHash, result.Hash)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Empty(t, captured.Fields, "dry-run must not send /torrents/add")
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha1"
+	"encoding/base32"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -32,6 +33,8 @@ type QbitClient struct {
 	username     string
 	password     string
 	autoStart    bool
+	skipChecking bool
+	dryRun       bool
 	client       requestDoer
 	mu           sync.Mutex
 	healthy      bool
@@ -47,7 +50,14 @@ type requestDoer interface {
 
 // QbitTorrentProperties qBittorrent 种子属性
 type QbitTorrentProperties struct {
-	SavePath string `json:"save_path"`
+	SavePath     string `json:"save_path"`
+	Comment      string `json:"comment"`
+	CreatedBy    string `json:"created_by"`
+	CreationDate int64  `json:"creation_date"`
+	AdditionDate int64  `json:"addition_date"`
+	SeedingTime  int64  `json:"seeding_time"`
+	PieceSize    int64  `json:"piece_size"`
+	TotalWasted  int64  `json:"total_wasted"`
 }
 
 // 确保 QbitClient 实现 Downloader 接口
@@ -123,9 +133,13 @@ func NewQbitClient(config downloader.DownloaderConfig, name string) (downloader.
 		username:  config.GetUsername(),
 		password:  config.GetPassword(),
 		autoStart: config.GetAutoStart(),
-		client:    downloader.NewRequestsHTTPDoer(config.GetURL(), 30*time.Second),
+		dryRun:    config.GetDryRun(),
+		client:    downloader.NewRequestsHTTPDoer(config.GetURL(), downloader.RequestTimeout(config)),
 		healthy:   false,
 	}
+	if qbitConfig, ok := config.(*QBitConfig); ok {
+		client.skipChecking = qbitConfig.SkipChecking
+	}
 
 	if err := client.Authenticate(); err != nil {
 		return nil, err
@@ -139,6 +153,20 @@ func (q *QbitClient) GetType() downloader.DownloaderType {
 	return downloader.DownloaderQBittorrent
 }
 
+// Capabilities 获取 qBittorrent 支持的功能
+func (q *QbitClient) Capabilities() downloader.Capabilities {
+	return downloader.Capabilities{
+		Magnet:               true,
+		Categories:           true,
+		Tags:                 true,
+		LabelsAsArray:        false,
+		ForceStart:           true,
+		SuperSeeding:         true,
+		SkipHashCheck:        true,
+		PerTorrentSpeedLimit: true,
+	}
+}
+
 // GetName 获取下载器实例名称
 func (q *QbitClient) GetName() string {
 	return q.name
@@ -427,13 +455,22 @@ func (q *QbitClient) AddTorrent(fileData []byte, category, tags string) error {
 
 // AddTorrentWithPath 添加种子到 qBittorrent 并指定下载路径
 func (q *QbitClient) AddTorrentWithPath(fileData []byte, category, tags, downloadPath string) error {
-	skipChecking := false
+	skipChecking := q.skipChecking
 	paused := !q.autoStart // autoStart=true 时 paused=false，autoStart=false 时 paused=true
 
 	// Debug logging for troubleshooting
 	sLogger().Infof("[qBittorrent] AddTorrentWithPath called: category=%s, tags=%s, downloadPath=%s, autoStart=%v, paused=%v",
 		category, tags, downloadPath, q.autoStart, paused)
 
+	if q.dryRun {
+		hash, err := ComputeTorrentHash(fileData)
+		if err != nil {
+			sLogger().Warnf("[DryRun] %s 计算种子哈希失败: %v", q.name, err)
+		}
+		q.logDryRunAdd(hash, category, tags, downloadPath, paused)
+		return nil
+	}
+
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
@@ -509,34 +546,60 @@ func (q *QbitClient) CheckTorrentExists(torrentHash string) (bool, error) {
 
 // CheckTorrentExistsWithContext 带 context 检查种子是否存在
 func (q *QbitClient) CheckTorrentExistsWithContext(ctx context.Context, torrentHash string) (bool, error) {
-	propertiesURL := fmt.Sprintf("%s/api/v2/torrents/properties?hash=%s", q.baseURL, torrentHash)
+	props, err := q.getTorrentProperties(ctx, torrentHash)
+	if errors.Is(err, downloader.ErrTorrentNotFound) {
+		sLogger().Infof("Torrent %s not in qBittorrent, preparing to add...", torrentHash)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	sLogger().Info("Torrent save path: ", props.SavePath)
+	return true, nil
+}
+
+// GetTorrentProperties 获取单个种子的详细属性，种子不存在时返回 ErrTorrentNotFound
+func (q *QbitClient) GetTorrentProperties(hash string) (downloader.TorrentProperties, error) {
+	return q.getTorrentProperties(context.Background(), hash)
+}
+
+func (q *QbitClient) getTorrentProperties(ctx context.Context, hash string) (downloader.TorrentProperties, error) {
+	propertiesURL := fmt.Sprintf("%s/api/v2/torrents/properties?hash=%s", q.baseURL, url.QueryEscape(hash))
 	req, err := http.NewRequestWithContext(ctx, "GET", propertiesURL, nil)
 	if err != nil {
-		return false, fmt.Errorf("failed to create check request: %w", err)
+		return downloader.TorrentProperties{}, fmt.Errorf("failed to create check request: %w", err)
 	}
 
 	resp, err := q.doRequestWithRetry(req)
 	if err != nil {
-		return false, fmt.Errorf("check request failed: %w", err)
+		return downloader.TorrentProperties{}, fmt.Errorf("check request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
-		sLogger().Infof("Torrent %s not in qBittorrent, preparing to add...", torrentHash)
-		return false, nil
+		return downloader.TorrentProperties{}, fmt.Errorf("torrent %s: %w", hash, downloader.ErrTorrentNotFound)
 	}
 
 	if !q.isSuccessStatus(resp.StatusCode) {
-		return false, fmt.Errorf("check failed with status code: %d", resp.StatusCode)
+		return downloader.TorrentProperties{}, fmt.Errorf("check failed with status code: %d", resp.StatusCode)
 	}
 
 	var props QbitTorrentProperties
 	if err := json.NewDecoder(resp.Body).Decode(&props); err != nil {
-		return false, fmt.Errorf("failed to parse torrent info: %w", err)
-	}
-
-	sLogger().Info("Torrent save path: ", props.SavePath)
-	return true, nil
+		return downloader.TorrentProperties{}, fmt.Errorf("failed to parse torrent info: %w", err)
+	}
+
+	return downloader.TorrentProperties{
+		SavePath:     props.SavePath,
+		Comment:      props.Comment,
+		CreatedBy:    props.CreatedBy,
+		CreationDate: props.CreationDate,
+		AdditionDate: props.AdditionDate,
+		SeedingTime:  props.SeedingTime,
+		PieceSize:    props.PieceSize,
+		TotalWasted:  props.TotalWasted,
+	}, nil
 }
 
 // ProcessSingleTorrentFile 处理单个种子文件
@@ -557,6 +620,13 @@ func (q *QbitClient) ProcessSingleTorrentFile(ctx context.Context, filePath, cat
 }
 
 func (q *QbitClient) processTorrentFile(ctx context.Context, filePath, category, tags string) error {
+	return q.processTorrentFileWithCheck(ctx, filePath, category, tags, q.CanAddTorrent)
+}
+
+// processTorrentFileWithCheck 处理单个种子文件，canAdd 用于判断剩余空间是否足够
+func (q *QbitClient) processTorrentFileWithCheck(ctx context.Context, filePath, category, tags string,
+	canAdd func(ctx context.Context, fileSize int64) (bool, error),
+) error {
 	sLogger().Info("Processing torrent file: ", filePath)
 
 	torrentData, err := os.ReadFile(filePath)
@@ -582,12 +652,12 @@ func (q *QbitClient) processTorrentFile(ctx context.Context, filePath, category,
 		return nil
 	}
 
-	canAdd, err := q.CanAddTorrent(ctx, int64(len(torrentData)))
+	ok, err := canAdd(ctx, int64(len(torrentData)))
 	if err != nil {
 		return fmt.Errorf("unable to determine if torrent can be added: %w", err)
 	}
 
-	if !canAdd {
+	if !ok {
 		sLogger().Error("Insufficient disk space, skipping torrent: ", filePath)
 		return nil
 	}
@@ -600,6 +670,12 @@ func (q *QbitClient) processTorrentFile(ctx context.Context, filePath, category,
 	return nil
 }
 
+// logDryRunAdd 记录演练模式下本应提交的 /torrents/add 摘要，hash 由调用方计算
+func (q *QbitClient) logDryRunAdd(hash, category, tags, savePath string, paused bool) {
+	sLogger().Infof("[DryRun] %s 跳过添加种子: hash=%s, category=%s, tags=%s, savePath=%s, paused=%v",
+		q.name, hash, category, tags, savePath, paused)
+}
+
 // ComputeTorrentHash 计算种子的 SHA1 哈希值
 func ComputeTorrentHash(data []byte) (string, error) {
 	reader := bytes.NewReader(data)
@@ -661,6 +737,42 @@ func ComputeTorrentHashWithPath(filePath string) (string, error) {
 	return ComputeTorrentHash(data)
 }
 
+// HashFromMagnet 从磁力链接的 xt=urn:btih: 参数提取 info-hash
+// 支持 40 位十六进制与 32 位 base32 两种编码，统一返回小写 40 位十六进制，
+// 便于仅有磁力链接的种子也能通过 CheckTorrentExists 去重
+func HashFromMagnet(magnet string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(magnet))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse magnet: %w", err)
+	}
+	if !strings.EqualFold(u.Scheme, "magnet") {
+		return "", fmt.Errorf("not a magnet link: %q", magnet)
+	}
+
+	for _, xt := range u.Query()["xt"] {
+		if len(xt) < len("urn:btih:") || !strings.EqualFold(xt[:len("urn:btih:")], "urn:btih:") {
+			continue
+		}
+		btih := xt[len("urn:btih:"):]
+		switch len(btih) {
+		case 40:
+			if _, err := hex.DecodeString(btih); err != nil {
+				return "", fmt.Errorf("invalid hex btih %q: %w", btih, err)
+			}
+			return strings.ToLower(btih), nil
+		case 32:
+			decoded, err := base32.StdEncoding.DecodeString(strings.ToUpper(btih))
+			if err != nil {
+				return "", fmt.Errorf("invalid base32 btih %q: %w", btih, err)
+			}
+			return hex.EncodeToString(decoded), nil
+		default:
+			return "", fmt.Errorf("invalid btih length %d", len(btih))
+		}
+	}
+	return "", fmt.Errorf("btih not found in magnet link")
+}
+
 // GetTorrentFilesPath 获取目录中所有种子文件
 func GetTorrentFilesPath(directory string) ([]string, error) {
 	files, err := os.ReadDir(directory)
@@ -718,6 +830,73 @@ func (q *QbitClient) ProcessTorrentDirectory(ctx context.Context, directory, cat
 	return nil
 }
 
+// ProcessTorrentDirectoryConcurrent 并发处理目录中的所有种子文件，最多同时处理
+// maxConcurrent 个（<=0 时按 1 处理）。磁盘空间只在开始前检查一次，之后按已接受的
+// 种子扣减剩余空间；各文件的错误汇总后返回。
+func (q *QbitClient) ProcessTorrentDirectoryConcurrent(ctx context.Context, directory, category, tags string, maxConcurrent int) error {
+	freeSpace, err := q.GetDiskSpace(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check disk space: %w", err)
+	}
+	sLogger().Info("Available disk space: ", float64(freeSpace)/(1024*1024*1024))
+
+	torrentFiles, err := GetTorrentFilesPath(directory)
+	if err != nil {
+		return fmt.Errorf("unable to read directory: %w", err)
+	}
+
+	if maxConcurrent <= 0 {
+		maxConcurrent = 1
+	}
+
+	var (
+		spaceMu   sync.Mutex
+		remaining = freeSpace
+	)
+	reserveSpace := func(_ context.Context, fileSize int64) (bool, error) {
+		spaceMu.Lock()
+		defer spaceMu.Unlock()
+		if fileSize > remaining {
+			sLogger().Errorf("Insufficient space, need: %.2fGB, available: %.2fGB",
+				float64(fileSize)/(1024*1024*1024), float64(remaining)/(1024*1024*1024))
+			return false, nil
+		}
+		remaining -= fileSize
+		return true, nil
+	}
+
+	var (
+		wg     sync.WaitGroup
+		errsMu sync.Mutex
+		errs   []error
+	)
+	sem := make(chan struct{}, maxConcurrent)
+	for _, file := range torrentFiles {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			errs = append(errs, ctx.Err())
+			return errors.Join(errs...)
+		}
+
+		wg.Add(1)
+		go func(file string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := q.processTorrentFileWithCheck(ctx, file, category, tags, reserveSpace); err != nil {
+				sLogger().Error("Failed to process torrent file: ", file, err)
+				errsMu.Lock()
+				errs = append(errs, fmt.Errorf("%s: %w", filepath.Base(file), err))
+				errsMu.Unlock()
+			}
+		}(file)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
 // EnsureTorrentStarted 确保种子已启动（如果配置了自动启动）
 func (q *QbitClient) EnsureTorrentStarted(torrentHash string) error {
 	// 如果没有配置自动启动，直接返回
@@ -884,6 +1063,11 @@ func (q *QbitClient) GetClientStatus() (downloader.ClientStatus, error) {
 		return downloader.ClientStatus{}, fmt.Errorf("unable to get server_state info")
 	}
 
+	return clientStatusFromServerState(serverState), nil
+}
+
+// clientStatusFromServerState 将 maindata 的 server_state 转换为 ClientStatus
+func clientStatusFromServerState(serverState map[string]any) downloader.ClientStatus {
 	status := downloader.ClientStatus{}
 	if upSpeed, ok := serverState["up_info_speed"].(float64); ok {
 		status.UpSpeed = int64(upSpeed)
@@ -904,7 +1088,101 @@ func (q *QbitClient) GetClientStatus() (downloader.ClientStatus, error) {
 		status.SessionDlData = int64(sessionDl)
 	}
 
-	return status, nil
+	return status
+}
+
+// mainDataPollInterval 是 SubscribeMainData 两次增量请求之间的间隔
+var mainDataPollInterval = 2 * time.Second
+
+// mainDataResponse 是 /api/v2/sync/maindata 响应中订阅关心的部分
+type mainDataResponse struct {
+	Rid         int64          `json:"rid"`
+	FullUpdate  bool           `json:"full_update"`
+	ServerState map[string]any `json:"server_state"`
+}
+
+// SubscribeMainData 通过 sync/maindata 的 rid 游标增量轮询服务器状态，
+// 每次更新后在通道上推送合并后的 ClientStatus 快照，直到 ctx 取消后关闭通道。
+// 首次请求失败时直接返回错误；之后的请求失败会从 rid=0 重新全量同步。
+func (q *QbitClient) SubscribeMainData(ctx context.Context) (<-chan downloader.ClientStatus, error) {
+	first, err := q.fetchMainData(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan downloader.ClientStatus, 1)
+	go func() {
+		defer close(ch)
+
+		state := make(map[string]any)
+		rid := int64(0)
+		apply := func(data mainDataResponse) downloader.ClientStatus {
+			if data.FullUpdate {
+				state = make(map[string]any)
+			}
+			for k, v := range data.ServerState {
+				state[k] = v
+			}
+			rid = data.Rid
+			return clientStatusFromServerState(state)
+		}
+
+		status := apply(first)
+		for {
+			select {
+			case ch <- status:
+			case <-ctx.Done():
+				return
+			}
+
+			for {
+				select {
+				case <-time.After(mainDataPollInterval):
+				case <-ctx.Done():
+					return
+				}
+				data, err := q.fetchMainData(ctx, rid)
+				if err == nil {
+					status = apply(data)
+					break
+				}
+				if ctx.Err() != nil {
+					return
+				}
+				sLogger().Warnf("qBit maindata 增量同步失败，将重新全量同步: %v", err)
+				rid = 0
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// fetchMainData 请求 rid 之后的 maindata 增量
+func (q *QbitClient) fetchMainData(ctx context.Context, rid int64) (mainDataResponse, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	mainDataURL := fmt.Sprintf("%s/api/v2/sync/maindata?rid=%d", q.baseURL, rid)
+	req, err := http.NewRequestWithContext(reqCtx, "GET", mainDataURL, nil)
+	if err != nil {
+		return mainDataResponse{}, fmt.Errorf("failed to create maindata request: %w", err)
+	}
+
+	resp, err := q.doRequestWithRetry(req)
+	if err != nil {
+		return mainDataResponse{}, fmt.Errorf("maindata request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if !q.isSuccessStatus(resp.StatusCode) {
+		return mainDataResponse{}, fmt.Errorf("maindata request failed with status code: %d", resp.StatusCode)
+	}
+
+	var data mainDataResponse
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return mainDataResponse{}, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return data, nil
 }
 
 // GetClientFreeSpace 获取下载器所在磁盘的可用空间
@@ -1232,6 +1510,51 @@ func (q *QbitClient) GetTorrentsBy(filter downloader.TorrentFilter) ([]downloade
 	return filtered, nil
 }
 
+// GetTorrentsByTracker 获取指定 Tracker 主机的所有种子
+// 主 Tracker 不匹配且种子有多个 Tracker（或主 Tracker 为空）时，逐个查询其 Tracker 列表
+func (q *QbitClient) GetTorrentsByTracker(host string) ([]downloader.Torrent, error) {
+	allTorrents, err := q.GetAllTorrents()
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []downloader.Torrent
+	for _, t := range allTorrents {
+		if downloader.TrackerMatchesHost(t.Tracker, host) {
+			matched = append(matched, t)
+			continue
+		}
+		if !q.hasOtherTrackers(t) {
+			continue
+		}
+		trackers, err := q.GetTorrentTrackers(t.ID)
+		if err != nil {
+			continue
+		}
+		for _, tr := range trackers {
+			if downloader.TrackerMatchesHost(tr.URL, host) {
+				matched = append(matched, t)
+				break
+			}
+		}
+	}
+
+	return matched, nil
+}
+
+// hasOtherTrackers 判断种子是否可能还有主 Tracker 以外的 Tracker
+func (q *QbitClient) hasOtherTrackers(t downloader.Torrent) bool {
+	if t.Tracker == "" {
+		return true
+	}
+	raw, ok := t.Raw.(map[string]any)
+	if !ok {
+		return false
+	}
+	count, ok := raw["trackers_count"].(float64)
+	return ok && count > 1
+}
+
 // GetTorrent 获取单个种子信息
 func (q *QbitClient) GetTorrent(id string) (downloader.Torrent, error) {
 	filter := downloader.TorrentFilter{
@@ -1340,6 +1663,11 @@ func (q *QbitClient) AddTorrentFileEx(fileData []byte, opt downloader.AddTorrent
 		return downloader.AddTorrentResult{Success: false, Message: err.Error()}, err
 	}
 
+	if q.dryRun {
+		q.logDryRunAdd(torrentHash, opt.Category, opt.Tags, opt.SavePath, opt.AddAtPaused)
+		return downloader.AddTorrentResult{Success: true, Message: "dry-run", Hash: torrentHash}, nil
+	}
+
 	uploadURL := fmt.Sprintf("%s/api/v2/torrents/add", q.baseURL)
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
@@ -1471,6 +1799,13 @@ func (q *QbitClient) writeAddTorrentOptions(writer *multipart.Writer, opt downlo
 		}
 	}
 
+	// 添加到队列顶部（仅在启用排队时生效）
+	if opt.AddToTopOfQueue {
+		if err := writer.WriteField("addToTopOfQueue", "true"); err != nil {
+			return fmt.Errorf("failed to write addToTopOfQueue: %w", err)
+		}
+	}
+
 	// 设置高级选项
 	for key, value := range opt.AdvanceOptions {
 		if boolVal, ok := value.(bool); ok && boolVal {
@@ -1484,8 +1819,9 @@ func (q *QbitClient) writeAddTorrentOptions(writer *multipart.Writer, opt downlo
 		}
 	}
 
-	// 默认不跳过校验
-	if err := writer.WriteField("skip_checking", "false"); err != nil {
+	// 默认不跳过校验，选项或配置开启时跳过
+	skipChecking := opt.SkipHashCheck || q.skipChecking
+	if err := writer.WriteField("skip_checking", fmt.Sprintf("%t", skipChecking)); err != nil {
 		return fmt.Errorf("failed to write skip_checking: %w", err)
 	}
 
@@ -1523,6 +1859,89 @@ func (q *QbitClient) ResumeTorrents(ids []string) error {
 	return q.callPauseResumeEndpoints(ids, "/api/v2/torrents/start", "/api/v2/torrents/resume")
 }
 
+// PauseManaged 批量暂停带有指定来源标签的种子，不影响其他种子
+func (q *QbitClient) PauseManaged(sourceTag string) error {
+	ids, err := q.managedTorrentIDs(sourceTag)
+	if err != nil {
+		return err
+	}
+	return q.PauseTorrents(ids)
+}
+
+// ResumeManaged 批量恢复带有指定来源标签的种子，不影响其他种子
+func (q *QbitClient) ResumeManaged(sourceTag string) error {
+	ids, err := q.managedTorrentIDs(sourceTag)
+	if err != nil {
+		return err
+	}
+	return q.ResumeTorrents(ids)
+}
+
+// managedTorrentIDs 返回带有来源标签的种子哈希
+func (q *QbitClient) managedTorrentIDs(sourceTag string) ([]string, error) {
+	torrents, err := q.torrentsWithTag(sourceTag)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, t := range torrents {
+		ids = append(ids, t.InfoHash)
+	}
+	return ids, nil
+}
+
+// torrentsWithTag 返回带有指定标签的种子
+// 服务端按 tag 过滤后仍在本地校验标签，避免旧版本忽略 tag 参数时误操作全部种子
+func (q *QbitClient) torrentsWithTag(tag string) ([]downloader.Torrent, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return nil, fmt.Errorf("tag is required: %w", downloader.ErrInvalidConfig)
+	}
+
+	var qbitTorrents []map[string]any
+	if err := q.getJSON("/api/v2/torrents/info?tag="+url.QueryEscape(tag), &qbitTorrents); err != nil {
+		return nil, err
+	}
+
+	var torrents []downloader.Torrent
+	for _, qt := range qbitTorrents {
+		t := q.mapQbitTorrent(qt)
+		if hasTag(t.Tags, tag) {
+			torrents = append(torrents, t)
+		}
+	}
+	return torrents, nil
+}
+
+// GetAggregateStatsByTag 汇总带有指定标签的种子的大小、上传/下载量及各状态数量
+func (q *QbitClient) GetAggregateStatsByTag(tag string) (downloader.AggregateStats, error) {
+	torrents, err := q.torrentsWithTag(tag)
+	if err != nil {
+		return downloader.AggregateStats{}, err
+	}
+
+	stats := downloader.AggregateStats{ByState: make(map[downloader.TorrentState]int)}
+	for _, t := range torrents {
+		stats.Count++
+		stats.TotalSize += t.TotalSize
+		stats.TotalUploaded += t.TotalUploaded
+		stats.TotalDownloaded += t.TotalDownloaded
+		stats.ByState[t.State]++
+	}
+	return stats, nil
+}
+
+// hasTag 判断 qBittorrent 逗号分隔的标签列表中是否包含指定标签
+func hasTag(tags, tag string) bool {
+	for _, t := range strings.Split(tags, ",") {
+		if strings.TrimSpace(t) == tag {
+			return true
+		}
+	}
+	return false
+}
+
 // RemoveTorrents 批量删除种子
 func (q *QbitClient) RemoveTorrents(ids []string, removeData bool) error {
 	hashes := strings.Join(ids, "|")
@@ -1582,6 +2001,37 @@ func (q *QbitClient) RecheckTorrent(id string) error {
 	return q.postForm("/api/v2/torrents/recheck", data)
 }
 
+// ReannounceTorrents 批量向 Tracker 重新汇报
+func (q *QbitClient) ReannounceTorrents(ids []string) error {
+	hashes := strings.Join(ids, "|")
+	if hashes == "" {
+		return nil
+	}
+
+	data := url.Values{}
+	data.Set("hashes", hashes)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.postForm("/api/v2/torrents/reannounce", data)
+}
+
+// SetSuperSeeding 批量开启或关闭超级做种
+func (q *QbitClient) SetSuperSeeding(ids []string, enable bool) error {
+	hashes := strings.Join(ids, "|")
+	if hashes == "" {
+		return nil
+	}
+
+	data := url.Values{}
+	data.Set("hashes", hashes)
+	data.Set("value", fmt.Sprintf("%t", enable))
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.postForm("/api/v2/torrents/setSuperSeeding", data)
+}
+
 // GetTorrentFiles 获取种子文件列表
 func (q *QbitClient) GetTorrentFiles(id string) ([]downloader.TorrentFile, error) {
 	var qFiles []map[string]any
@@ -0,0 +1,51 @@
+package qbit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashFromMagnet(t *testing.T) {
+	const want = "c12fe1c06bba254a9dc9f519b335aa7c1367a88a"
+
+	tests := []struct {
+		name   string
+		magnet string
+	}{
+		{name: "hex", magnet: "magnet:?xt=urn:btih:c12fe1c06bba254a9dc9f519b335aa7c1367a88a&dn=Movie"},
+		{name: "uppercase hex", magnet: "magnet:?xt=urn:btih:C12FE1C06BBA254A9DC9F519B335AA7C1367A88A"},
+		{name: "base32", magnet: "magnet:?xt=urn:btih:YEX6DQDLXISUVHOJ6UM3GNNKPQJWPKEK&tr=http%3A%2F%2Ftracker.example.com%2Fannounce"},
+		{name: "lowercase base32", magnet: "magnet:?xt=urn:btih:yex6dqdlxisuvhoj6um3gnnkpqjwpkek"},
+		{name: "btih after btmh", magnet: "magnet:?xt=urn:btmh:1220abcd&xt=urn:btih:c12fe1c06bba254a9dc9f519b335aa7c1367a88a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HashFromMagnet(tt.magnet)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestHashFromMagnet_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		magnet string
+	}{
+		{name: "not a magnet", magnet: "https://example.com/download.php?id=1"},
+		{name: "missing btih", magnet: "magnet:?dn=Movie"},
+		{name: "bad length", magnet: "magnet:?xt=urn:btih:abc123"},
+		{name: "bad hex", magnet: "magnet:?xt=urn:btih:zz2fe1c06bba254a9dc9f519b335aa7c1367a88a"},
+		{name: "bad base32", magnet: "magnet:?xt=urn:btih:1111111111111111111111111111111!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := HashFromMagnet(tt.magnet)
+			assert.Error(t, err)
+		})
+	}
+}
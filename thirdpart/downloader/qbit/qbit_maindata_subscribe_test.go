@@ -0,0 +1,99 @@
+package qbit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+// TestQbitClientSubscribeMainData 测试 rid 增量同步与 full_update 重置
+func TestQbitClientSubscribeMainData(t *testing.T) {
+	prev := mainDataPollInterval
+	mainDataPollInterval = 5 * time.Millisecond
+	defer func() { mainDataPollInterval = prev }()
+
+	rids := make(chan string, 16)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/auth/login":
+			w.Write([]byte("Ok."))
+		case "/api/v2/sync/maindata":
+			rid := r.URL.Query().Get("rid")
+			select {
+			case rids <- rid:
+			default:
+			}
+			switch rid {
+			case "0":
+				w.Write([]byte(`{"rid":1,"full_update":true,"server_state":{"up_info_speed":100,"dl_info_speed":50,"alltime_ul":1000,"alltime_dl":500}}`))
+			case "1":
+				w.Write([]byte(`{"rid":2,"server_state":{"up_info_speed":200}}`))
+			case "2":
+				w.Write([]byte(`{"rid":3,"server_state":{"dl_info_speed":300,"alltime_dl":800}}`))
+			default:
+				// 服务器丢弃了游标，返回全量数据
+				w.Write([]byte(`{"rid":4,"full_update":true,"server_state":{"up_info_speed":7}}`))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewQbitClient(NewQBitConfig(server.URL, "admin", "password"), "test-qbit")
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := client.(*QbitClient).SubscribeMainData(ctx)
+	require.NoError(t, err)
+
+	next := func() downloader.ClientStatus {
+		select {
+		case s, ok := <-ch:
+			require.True(t, ok, "channel closed early")
+			return s
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for status")
+		}
+		return downloader.ClientStatus{}
+	}
+
+	assert.Equal(t, downloader.ClientStatus{UpSpeed: 100, DlSpeed: 50, UpData: 1000, DlData: 500}, next())
+	assert.Equal(t, downloader.ClientStatus{UpSpeed: 200, DlSpeed: 50, UpData: 1000, DlData: 500}, next())
+	assert.Equal(t, downloader.ClientStatus{UpSpeed: 200, DlSpeed: 300, UpData: 1000, DlData: 800}, next())
+	assert.Equal(t, downloader.ClientStatus{UpSpeed: 7}, next(), "full_update discards accumulated state")
+
+	assert.Equal(t, []string{"0", "1", "2", "3"}, []string{<-rids, <-rids, <-rids, <-rids})
+
+	cancel()
+	for range ch {
+	}
+}
+
+// TestQbitClientSubscribeMainDataInitialError 测试首次请求失败时直接返回错误
+func TestQbitClientSubscribeMainDataInitialError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/auth/login" {
+			w.Write([]byte("Ok."))
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, err := NewQbitClient(NewQBitConfig(server.URL, "admin", "password"), "test-qbit")
+	require.NoError(t, err)
+	defer client.Close()
+
+	_, err = client.(*QbitClient).SubscribeMainData(context.Background())
+	assert.Error(t, err)
+}
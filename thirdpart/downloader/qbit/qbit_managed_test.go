@@ -0,0 +1,95 @@
+package qbit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+// managedServer 返回带不同标签的种子，并记录暂停/恢复请求的表单
+// 服务端故意忽略 tag 参数，以验证客户端的本地标签校验
+func managedServer(t *testing.T, forms map[string]url.Values, tagQuery *string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/torrents/info":
+			*tagQuery = r.URL.Query().Get("tag")
+			_ = json.NewEncoder(w).Encode([]map[string]any{
+				{"hash": "m1", "tags": "pt-tools"},
+				{"hash": "m2", "tags": "free, pt-tools"},
+				{"hash": "o1", "tags": "manual"},
+				{"hash": "o2", "tags": "pt-tools-old"},
+				{"hash": "o3", "tags": ""},
+			})
+		default:
+			require.NoError(t, r.ParseForm())
+			forms[r.URL.Path] = r.PostForm
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestQbitPauseManaged(t *testing.T) {
+	forms := map[string]url.Values{}
+	var tagQuery string
+	srv := managedServer(t, forms, &tagQuery)
+	c := coverageTestClient(srv.URL, false)
+
+	require.NoError(t, c.PauseManaged("pt-tools"))
+
+	assert.Equal(t, "pt-tools", tagQuery)
+	form, ok := forms["/api/v2/torrents/stop"]
+	require.True(t, ok)
+	assert.Equal(t, "m1|m2", form.Get("hashes"))
+	assert.NotContains(t, forms, "/api/v2/torrents/start")
+}
+
+func TestQbitResumeManaged(t *testing.T) {
+	forms := map[string]url.Values{}
+	var tagQuery string
+	srv := managedServer(t, forms, &tagQuery)
+	c := coverageTestClient(srv.URL, false)
+
+	require.NoError(t, c.ResumeManaged("pt-tools"))
+
+	form, ok := forms["/api/v2/torrents/start"]
+	require.True(t, ok)
+	assert.Equal(t, "m1|m2", form.Get("hashes"))
+}
+
+func TestQbitPauseManaged_NoMatches(t *testing.T) {
+	forms := map[string]url.Values{}
+	var tagQuery string
+	srv := managedServer(t, forms, &tagQuery)
+	c := coverageTestClient(srv.URL, false)
+
+	require.NoError(t, c.PauseManaged("unused-tag"))
+	assert.Empty(t, forms)
+}
+
+func TestQbitPauseManaged_EmptyTag(t *testing.T) {
+	forms := map[string]url.Values{}
+	var tagQuery string
+	srv := managedServer(t, forms, &tagQuery)
+	c := coverageTestClient(srv.URL, false)
+
+	assert.ErrorIs(t, c.PauseManaged(" "), downloader.ErrInvalidConfig)
+	assert.ErrorIs(t, c.ResumeManaged(""), downloader.ErrInvalidConfig)
+	assert.Empty(t, forms)
+}
+
+func TestQbitPauseManaged_ListError(t *testing.T) {
+	srv := failStatusServer(t, http.StatusInternalServerError)
+	c := coverageTestClient(srv.URL, false)
+
+	assert.Error(t, c.PauseManaged("pt-tools"))
+}
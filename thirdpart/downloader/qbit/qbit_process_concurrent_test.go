@@ -0,0 +1,126 @@
+package qbit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// concurrentProcessServer serves the endpoints used when processing torrent files
+// and records call counts and the peak number of concurrent existence checks.
+type concurrentProcessServer struct {
+	freeSpace    int64
+	failAdd      bool
+	diskChecks   atomic.Int32
+	adds         atomic.Int32
+	inFlight     atomic.Int32
+	peakInFlight atomic.Int32
+}
+
+func (s *concurrentProcessServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/api/v2/sync/maindata":
+		s.diskChecks.Add(1)
+		_, _ = fmt.Fprintf(w, `{"server_state":{"free_space_on_disk":%d}}`, s.freeSpace)
+	case "/api/v2/torrents/properties":
+		n := s.inFlight.Add(1)
+		for {
+			peak := s.peakInFlight.Load()
+			if n <= peak || s.peakInFlight.CompareAndSwap(peak, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		s.inFlight.Add(-1)
+		w.WriteHeader(http.StatusNotFound)
+	case "/api/v2/torrents/add":
+		s.adds.Add(1)
+		if s.failAdd {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte("Ok."))
+	default:
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func writeTorrentFiles(t *testing.T, n int) string {
+	t.Helper()
+	dir := t.TempDir()
+	for i := range n {
+		data := makeSingleFileTorrent(t, int64(1024+i))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, fmt.Sprintf("t%d.torrent", i)), data, 0o644))
+	}
+	return dir
+}
+
+func TestQbitProcessTorrentDirectoryConcurrent(t *testing.T) {
+	state := &concurrentProcessServer{freeSpace: 1 << 40}
+	srv := httptest.NewServer(state)
+	defer srv.Close()
+
+	dir := writeTorrentFiles(t, 6)
+	c := coverageTestClient(srv.URL, false)
+	require.NoError(t, c.ProcessTorrentDirectoryConcurrent(context.Background(), dir, "cat", "tag", 3))
+
+	assert.Equal(t, int32(1), state.diskChecks.Load(), "free space is checked once up front")
+	assert.Equal(t, int32(6), state.adds.Load())
+	assert.Greater(t, state.peakInFlight.Load(), int32(1))
+	assert.LessOrEqual(t, state.peakInFlight.Load(), int32(3))
+}
+
+func TestQbitProcessTorrentDirectoryConcurrent_AggregatesErrors(t *testing.T) {
+	state := &concurrentProcessServer{freeSpace: 1 << 40, failAdd: true}
+	srv := httptest.NewServer(state)
+	defer srv.Close()
+
+	dir := writeTorrentFiles(t, 3)
+	c := coverageTestClient(srv.URL, false)
+	err := c.ProcessTorrentDirectoryConcurrent(context.Background(), dir, "", "", 2)
+	require.Error(t, err)
+	for i := range 3 {
+		assert.Contains(t, err.Error(), fmt.Sprintf("t%d.torrent", i))
+	}
+}
+
+func TestQbitProcessTorrentDirectoryConcurrent_SharesSpaceBudget(t *testing.T) {
+	dir := writeTorrentFiles(t, 4)
+	first, err := os.ReadFile(filepath.Join(dir, "t0.torrent"))
+	require.NoError(t, err)
+
+	// Room for exactly one torrent file: the rest are skipped, not failed
+	state := &concurrentProcessServer{freeSpace: int64(len(first)) + 1}
+	srv := httptest.NewServer(state)
+	defer srv.Close()
+
+	c := coverageTestClient(srv.URL, false)
+	require.NoError(t, c.ProcessTorrentDirectoryConcurrent(context.Background(), dir, "", "", 4))
+	assert.Equal(t, int32(1), state.adds.Load())
+	assert.Equal(t, int32(1), state.diskChecks.Load())
+}
+
+func TestQbitProcessTorrentDirectoryConcurrent_Errors(t *testing.T) {
+	c := coverageTestClient(failStatusServer(t, http.StatusInternalServerError).URL, false)
+	require.Error(t, c.ProcessTorrentDirectoryConcurrent(context.Background(), t.TempDir(), "", "", 2))
+
+	state := &concurrentProcessServer{freeSpace: 1 << 40}
+	srv := httptest.NewServer(state)
+	defer srv.Close()
+	c = coverageTestClient(srv.URL, false)
+	require.Error(t, c.ProcessTorrentDirectoryConcurrent(context.Background(), "/no/such/dir-xyz", "", "", 2))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := c.ProcessTorrentDirectoryConcurrent(ctx, writeTorrentFiles(t, 2), "", "", 1)
+	assert.Error(t, err)
+}
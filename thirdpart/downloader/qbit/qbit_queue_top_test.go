@@ -0,0 +1,59 @@
+package qbit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+func TestQbitAddTorrentFileEx_AddToTopOfQueue(t *testing.T) {
+	srv, mu, captured := newCapturingQbitServer(t)
+	defer srv.Close()
+	cli := newQbitTestClient(t, srv.URL)
+	defer cli.Close()
+
+	_, err := cli.AddTorrentFileEx(fixtureTorrentBytes(), downloader.AddTorrentOptions{
+		AddToTopOfQueue: true,
+	})
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, "true", captured.Fields["addToTopOfQueue"])
+}
+
+func TestQbitAddTorrentEx_AddToTopOfQueue(t *testing.T) {
+	srv, mu, captured := newCapturingQbitServer(t)
+	defer srv.Close()
+	cli := newQbitTestClient(t, srv.URL)
+	defer cli.Close()
+
+	_, err := cli.AddTorrentEx("https://example.com/download.php?id=1", downloader.AddTorrentOptions{
+		AddToTopOfQueue: true,
+	})
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, "true", captured.Fields["addToTopOfQueue"])
+	assert.Equal(t, "https://example.com/download.php?id=1", captured.Fields["urls"])
+}
+
+// 未设置时不发送该字段，保持下载器默认的队列行为
+func TestQbitAddTorrentFileEx_DefaultQueuePosition(t *testing.T) {
+	srv, mu, captured := newCapturingQbitServer(t)
+	defer srv.Close()
+	cli := newQbitTestClient(t, srv.URL)
+	defer cli.Close()
+
+	_, err := cli.AddTorrentFileEx(fixtureTorrentBytes(), downloader.AddTorrentOptions{})
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	_, ok := captured.Fields["addToTopOfQueue"]
+	assert.False(t, ok)
+}
@@ -0,0 +1,79 @@
+package qbit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// captureFormServer 记录每个请求的路径和表单参数
+func captureFormServer(t *testing.T, forms map[string]url.Values) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		forms[r.URL.Path] = r.PostForm
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestQbitReannounceTorrents(t *testing.T) {
+	forms := map[string]url.Values{}
+	srv := captureFormServer(t, forms)
+	c := coverageTestClient(srv.URL, false)
+
+	require.NoError(t, c.ReannounceTorrents([]string{"h1", "h2"}))
+
+	form, ok := forms["/api/v2/torrents/reannounce"]
+	require.True(t, ok)
+	assert.Equal(t, "h1|h2", form.Get("hashes"))
+}
+
+func TestQbitReannounceTorrents_Empty(t *testing.T) {
+	forms := map[string]url.Values{}
+	srv := captureFormServer(t, forms)
+	c := coverageTestClient(srv.URL, false)
+
+	require.NoError(t, c.ReannounceTorrents(nil))
+	assert.Empty(t, forms)
+}
+
+func TestQbitSetSuperSeeding(t *testing.T) {
+	tests := []struct {
+		name   string
+		enable bool
+		want   string
+	}{
+		{name: "enable", enable: true, want: "true"},
+		{name: "disable", enable: false, want: "false"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forms := map[string]url.Values{}
+			srv := captureFormServer(t, forms)
+			c := coverageTestClient(srv.URL, false)
+
+			require.NoError(t, c.SetSuperSeeding([]string{"h1", "h3"}, tt.enable))
+
+			form, ok := forms["/api/v2/torrents/setSuperSeeding"]
+			require.True(t, ok)
+			assert.Equal(t, "h1|h3", form.Get("hashes"))
+			assert.Equal(t, tt.want, form.Get("value"))
+		})
+	}
+}
+
+func TestQbitSetSuperSeeding_Error(t *testing.T) {
+	srv := failStatusServer(t, http.StatusInternalServerError)
+	c := coverageTestClient(srv.URL, false)
+
+	err := c.SetSuperSeeding([]string{"h1"}, true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "setSuperSeeding")
+}
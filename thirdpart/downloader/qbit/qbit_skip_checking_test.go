@@ -0,0 +1,50 @@
+package qbit
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+// TestQbitAddTorrentWithPath_SkipChecking 验证 skip_checking 字段与配置一致
+func TestQbitAddTorrentWithPath_SkipChecking(t *testing.T) {
+	for _, skip := range []bool{false, true} {
+		srv, mu, captured := newCapturingQbitServer(t)
+		config := NewQBitConfig(srv.URL, "admin", "pwd")
+		config.SkipChecking = skip
+		cli, err := NewQbitClient(config, "test-qbit")
+		require.NoError(t, err)
+
+		require.NoError(t, cli.(*QbitClient).AddTorrentWithPath(fixtureTorrentBytes(), "", "", "/downloads"))
+
+		mu.Lock()
+		assert.Equal(t, strconv.FormatBool(skip), captured.Fields["skip_checking"])
+		mu.Unlock()
+		_ = cli.Close()
+		srv.Close()
+	}
+}
+
+// TestQbitAddTorrentFileEx_SkipHashCheck 验证 AddTorrentOptions.SkipHashCheck 写入 skip_checking
+func TestQbitAddTorrentFileEx_SkipHashCheck(t *testing.T) {
+	srv, mu, captured := newCapturingQbitServer(t)
+	defer srv.Close()
+	cli := newQbitTestClient(t, srv.URL)
+	defer cli.Close()
+
+	_, err := cli.AddTorrentFileEx(fixtureTorrentBytes(), downloader.AddTorrentOptions{})
+	require.NoError(t, err)
+	mu.Lock()
+	assert.Equal(t, "false", captured.Fields["skip_checking"], "default must keep hash checking")
+	mu.Unlock()
+
+	_, err = cli.AddTorrentFileEx(fixtureTorrentBytes(), downloader.AddTorrentOptions{SkipHashCheck: true})
+	require.NoError(t, err)
+	mu.Lock()
+	assert.Equal(t, "true", captured.Fields["skip_checking"])
+	mu.Unlock()
+}
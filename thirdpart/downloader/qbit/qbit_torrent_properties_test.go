@@ -0,0 +1,75 @@
+package qbit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+func propertiesServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/torrents/properties" || r.URL.Query().Get("hash") != "abc" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"save_path":     "/downloads/movies",
+			"comment":       "https://pt.example.com/details.php?id=1",
+			"created_by":    "mktorrent 1.1",
+			"creation_date": 1700000000,
+			"addition_date": 1700003600,
+			"seeding_time":  86400,
+			"piece_size":    4194304,
+			"total_wasted":  1024,
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestQbitGetTorrentProperties(t *testing.T) {
+	c := coverageTestClient(propertiesServer(t).URL, false)
+
+	props, err := c.GetTorrentProperties("abc")
+	require.NoError(t, err)
+	assert.Equal(t, downloader.TorrentProperties{
+		SavePath:     "/downloads/movies",
+		Comment:      "https://pt.example.com/details.php?id=1",
+		CreatedBy:    "mktorrent 1.1",
+		CreationDate: 1700000000,
+		AdditionDate: 1700003600,
+		SeedingTime:  86400,
+		PieceSize:    4194304,
+		TotalWasted:  1024,
+	}, props)
+}
+
+func TestQbitGetTorrentProperties_NotFound(t *testing.T) {
+	c := coverageTestClient(propertiesServer(t).URL, false)
+
+	_, err := c.GetTorrentProperties("missing")
+	require.ErrorIs(t, err, downloader.ErrTorrentNotFound)
+
+	exists, err := c.CheckTorrentExists("missing")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	exists, err = c.CheckTorrentExists("abc")
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestQbitGetTorrentProperties_ServerError(t *testing.T) {
+	c := coverageTestClient(failStatusServer(t, http.StatusInternalServerError).URL, false)
+
+	_, err := c.GetTorrentProperties("abc")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, downloader.ErrTorrentNotFound)
+}
@@ -0,0 +1,76 @@
+package qbit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// trackerFilterServer 返回分布在不同 Tracker 上的种子
+func trackerFilterServer(t *testing.T, trackerCalls *[]string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/torrents/info":
+			_ = json.NewEncoder(w).Encode([]map[string]any{
+				{"hash": "a1", "name": "site-a", "tracker": "https://tracker.site-a.com:443/announce.php?passkey=x", "trackers_count": 1},
+				{"hash": "b1", "name": "site-b", "tracker": "https://site-b.org/announce", "trackers_count": 1},
+				{"hash": "m1", "name": "multi", "tracker": "https://site-b.org/announce", "trackers_count": 2},
+				{"hash": "e1", "name": "no-working-tracker", "tracker": ""},
+			})
+		case "/api/v2/torrents/trackers":
+			hash := r.URL.Query().Get("hash")
+			*trackerCalls = append(*trackerCalls, hash)
+			trackers := map[string][]map[string]any{
+				"m1": {{"url": "** [DHT] **"}, {"url": "https://site-b.org/announce"}, {"url": "https://SITE-A.com/announce"}},
+				"e1": {{"url": "https://other.net/announce"}},
+			}
+			_ = json.NewEncoder(w).Encode(trackers[hash])
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestQbitGetTorrentsByTracker(t *testing.T) {
+	var calls []string
+	srv := trackerFilterServer(t, &calls)
+	c := coverageTestClient(srv.URL, false)
+
+	torrents, err := c.GetTorrentsByTracker("site-a.com")
+	require.NoError(t, err)
+
+	var hashes []string
+	for _, tr := range torrents {
+		hashes = append(hashes, tr.InfoHash)
+	}
+	assert.Equal(t, []string{"a1", "m1"}, hashes)
+	assert.ElementsMatch(t, []string{"m1", "e1"}, calls, "only multi-tracker or trackerless torrents are queried")
+}
+
+func TestQbitGetTorrentsByTracker_PrimaryOnly(t *testing.T) {
+	var calls []string
+	srv := trackerFilterServer(t, &calls)
+	c := coverageTestClient(srv.URL, false)
+
+	torrents, err := c.GetTorrentsByTracker("site-b.org")
+	require.NoError(t, err)
+	require.Len(t, torrents, 2)
+	assert.Equal(t, "b1", torrents[0].InfoHash)
+	assert.Equal(t, "m1", torrents[1].InfoHash)
+	assert.Equal(t, []string{"e1"}, calls)
+}
+
+func TestQbitGetTorrentsByTracker_ListError(t *testing.T) {
+	srv := failStatusServer(t, http.StatusInternalServerError)
+	c := coverageTestClient(srv.URL, false)
+
+	_, err := c.GetTorrentsByTracker("site-a.com")
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/sunerpy/pt-tools/thirdpart/downloader"
 )
@@ -15,6 +16,10 @@ type TransmissionConfig struct {
 	Password  string `json:"password"`
 	HTTPS     bool   `json:"https"`
 	AutoStart bool   `json:"auto_start"`
+	// DryRun 演练模式：添加种子时只记录摘要，不向 Transmission 提交
+	DryRun bool `json:"dry_run"`
+	// Timeout 单次 HTTP 请求超时，0 表示使用 downloader.DefaultRequestTimeout
+	Timeout time.Duration `json:"-"`
 }
 
 // GetType 获取下载器类型
@@ -46,6 +51,16 @@ func (c *TransmissionConfig) GetAutoStart() bool {
 	return c.AutoStart
 }
 
+// GetDryRun 获取是否为演练模式
+func (c *TransmissionConfig) GetDryRun() bool {
+	return c.DryRun
+}
+
+// GetTimeout 获取单次请求超时
+func (c *TransmissionConfig) GetTimeout() time.Duration {
+	return c.Timeout
+}
+
 // Validate 验证配置是否有效
 func (c *TransmissionConfig) Validate() error {
 	if c.URL == "" {
@@ -283,3 +283,18 @@ func TestTrGetClientLabels(t *testing.T) {
 	require.NoError(t, err)
 	assert.Empty(t, labels)
 }
+
+// TestTransmissionClientCapabilities 测试 Transmission 功能声明
+func TestTransmissionClientCapabilities(t *testing.T) {
+	var d downloader.Downloader = &TransmissionClient{name: "test"}
+	caps := d.Capabilities()
+
+	assert.True(t, caps.Magnet)
+	assert.False(t, caps.Categories)
+	assert.True(t, caps.Tags)
+	assert.True(t, caps.LabelsAsArray)
+	assert.False(t, caps.ForceStart)
+	assert.False(t, caps.SuperSeeding)
+	assert.False(t, caps.SkipHashCheck)
+	assert.True(t, caps.PerTorrentSpeedLimit)
+}
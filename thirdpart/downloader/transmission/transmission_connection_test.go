@@ -0,0 +1,32 @@
+package transmission
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+)
+
+func newConnectionTestManager() *downloader.DownloaderManager {
+	dm := downloader.NewDownloaderManager()
+	dm.RegisterFactory(downloader.DownloaderTransmission, NewTransmissionClient)
+	return dm
+}
+
+func TestTransmissionTestConnection_Success(t *testing.T) {
+	srv := createMockTransmissionServer(true, "admin", "secret")
+	defer srv.Close()
+
+	require.NoError(t, newConnectionTestManager().TestConnection(NewTransmissionConfig(srv.URL, "admin", "secret")))
+}
+
+func TestTransmissionTestConnection_AuthFailure(t *testing.T) {
+	srv := createMockTransmissionServer(true, "admin", "secret")
+	defer srv.Close()
+
+	err := newConnectionTestManager().TestConnection(NewTransmissionConfig(srv.URL, "admin", "wrong"))
+	require.ErrorIs(t, err, downloader.ErrConnectionFailed)
+	assert.Contains(t, err.Error(), "invalid username or password")
+}
@@ -0,0 +1,42 @@
+package transmission
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunerpy/pt-tools/thirdpart/downloader"
+	"github.com/sunerpy/pt-tools/thirdpart/downloader/qbit"
+)
+
+// TestTransmissionDryRun_SkipsTorrentAdd 验证演练模式下不发送 torrent-add，其他查询照常执行
+func TestTransmissionDryRun_SkipsTorrentAdd(t *testing.T) {
+	srv, mu, calls := newRecordingTransmissionServer(t)
+	defer srv.Close()
+	cfg := NewTransmissionConfig(srv.URL, "", "")
+	cfg.DryRun = true
+	cli, err := NewTransmissionClient(cfg, "test-tr")
+	require.NoError(t, err)
+	tc := cli.(*TransmissionClient)
+
+	data := []byte("d8:announce35:http://tracker.example.com/announce4:infod6:lengthi1024e4:name8:test.txt12:piece lengthi16384e6:pieces20:01234567890123456789ee")
+	wantHash, err := qbit.ComputeTorrentHash(data)
+	require.NoError(t, err)
+
+	_, err = tc.CheckTorrentExists(wantHash)
+	require.NoError(t, err)
+
+	require.NoError(t, tc.AddTorrentWithPath(data, "movies", "pt", "/downloads"))
+
+	result, err := tc.AddTorrentFileEx(data, downloader.AddTorrentOptions{Category: "movies", SavePath: "/downloads"})
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, "dry-run", result.Message)
+	assert.Equal(t, wantHash, result.Hash)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Empty(t, rpcCallsByMethod(*calls, "torrent-add"), "dry-run must not send torrent-add")
+	assert.NotEmpty(t, rpcCallsByMethod(*calls, "torrent-get"), "existence checks still run")
+}
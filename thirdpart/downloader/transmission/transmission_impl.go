@@ -26,6 +26,7 @@ type TransmissionClient struct {
 	username     string
 	password     string
 	autoStart    bool
+	dryRun       bool
 	client       downloader.HTTPDoer
 	sessionID    string
 	mu           sync.Mutex
@@ -112,7 +113,8 @@ func NewTransmissionClient(config downloader.DownloaderConfig, name string) (dow
 		username:  config.GetUsername(),
 		password:  config.GetPassword(),
 		autoStart: config.GetAutoStart(),
-		client:    downloader.NewRequestsHTTPDoer(config.GetURL(), 30*time.Second),
+		dryRun:    config.GetDryRun(),
+		client:    downloader.NewRequestsHTTPDoer(config.GetURL(), downloader.RequestTimeout(config)),
 		healthy:   false,
 	}
 
@@ -128,6 +130,21 @@ func (t *TransmissionClient) GetType() downloader.DownloaderType {
 	return downloader.DownloaderTransmission
 }
 
+// Capabilities 获取 Transmission 支持的功能
+// Transmission 没有原生分类，分类和标签都以 labels 数组模拟
+func (t *TransmissionClient) Capabilities() downloader.Capabilities {
+	return downloader.Capabilities{
+		Magnet:               true,
+		Categories:           false,
+		Tags:                 true,
+		LabelsAsArray:        true,
+		ForceStart:           false,
+		SuperSeeding:         false,
+		SkipHashCheck:        false,
+		PerTorrentSpeedLimit: true,
+	}
+}
+
 // GetName 获取下载器实例名称
 func (t *TransmissionClient) GetName() string {
 	return t.name
@@ -402,6 +419,11 @@ func (t *TransmissionClient) AddTorrent(fileData []byte, category, tags string)
 
 // AddTorrentWithPath 添加种子到 Transmission 并指定下载路径
 func (t *TransmissionClient) AddTorrentWithPath(fileData []byte, category, tags, downloadPath string) error {
+	if t.dryRun {
+		t.logDryRunAdd(fileData, category, tags, downloadPath, !t.autoStart)
+		return nil
+	}
+
 	// Transmission 使用 base64 编码的种子文件
 	metainfo := base64.StdEncoding.EncodeToString(fileData)
 
@@ -458,6 +480,17 @@ func (t *TransmissionClient) AddTorrentWithPath(fileData []byte, category, tags,
 	return nil
 }
 
+// logDryRunAdd 记录演练模式下本应提交的 torrent-add 摘要，返回计算出的种子哈希
+func (t *TransmissionClient) logDryRunAdd(fileData []byte, category, tags, savePath string, paused bool) string {
+	hash, err := qbit.ComputeTorrentHash(fileData)
+	if err != nil {
+		sLogger().Warnf("[DryRun] %s 计算种子哈希失败: %v", t.name, err)
+	}
+	sLogger().Infof("[DryRun] %s 跳过添加种子: hash=%s, category=%s, tags=%s, savePath=%s, paused=%v",
+		t.name, hash, category, tags, savePath, paused)
+	return hash
+}
+
 // CheckTorrentExists 检查种子是否存在
 func (t *TransmissionClient) CheckTorrentExists(torrentHash string) (bool, error) {
 	args := torrentGetArgs{
@@ -791,10 +824,11 @@ func (t *TransmissionClient) mapTransmissionTorrent(tt torrentFullInfo) download
 
 // mapTransmissionState 将 Transmission 状态映射到通用状态
 // Transmission 状态: 0=stopped, 1=check wait, 2=check, 3=download wait, 4=download, 5=seed wait, 6=seed
+// stopped 即 torrent-stop 后的暂停状态，与 qBittorrent 的 paused 对应
 func (t *TransmissionClient) mapTransmissionState(status int) downloader.TorrentState {
 	switch status {
 	case 0:
-		return downloader.TorrentStopped
+		return downloader.TorrentPaused
 	case 1, 2:
 		return downloader.TorrentChecking
 	case 3:
@@ -928,6 +962,11 @@ func (t *TransmissionClient) AddTorrentEx(torrentURL string, opt downloader.AddT
 
 // AddTorrentFileEx 添加种子文件到下载器（新接口）
 func (t *TransmissionClient) AddTorrentFileEx(fileData []byte, opt downloader.AddTorrentOptions) (downloader.AddTorrentResult, error) {
+	if t.dryRun {
+		hash := t.logDryRunAdd(fileData, opt.Category, opt.Tags, opt.SavePath, opt.AddAtPaused)
+		return downloader.AddTorrentResult{Success: true, Message: "dry-run", Hash: hash}, nil
+	}
+
 	// Transmission 使用 base64 编码的种子文件
 	metainfo := base64.StdEncoding.EncodeToString(fileData)
 
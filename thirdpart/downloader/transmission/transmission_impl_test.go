@@ -1806,7 +1806,7 @@ func TestTrGetAllTorrentsAndMapping(t *testing.T) {
 func TestTrMapTransmissionState(t *testing.T) {
 	c := covClient("http://x")
 	cases := map[int]downloader.TorrentState{
-		0:  downloader.TorrentStopped,
+		0:  downloader.TorrentPaused,
 		1:  downloader.TorrentChecking,
 		2:  downloader.TorrentChecking,
 		3:  downloader.TorrentQueued,
@@ -1824,6 +1824,7 @@ func TestTrGetTorrentsByAndGetTorrent(t *testing.T) {
 	body := map[string]any{"torrents": []map[string]any{
 		{"id": 1, "name": "a", "hashString": "h1", "status": 6, "percentDone": 1.0},
 		{"id": 2, "name": "b", "hashString": "h2", "status": 4, "percentDone": 0.3},
+		{"id": 3, "name": "c", "hashString": "h3", "status": 0, "percentDone": 0.7},
 	}}
 	srv := rpcServer(t, map[string]any{"torrent-get": body})
 	defer srv.Close()
@@ -1831,7 +1832,7 @@ func TestTrGetTorrentsByAndGetTorrent(t *testing.T) {
 
 	all, err := c.GetTorrentsBy(downloader.TorrentFilter{})
 	require.NoError(t, err)
-	assert.Len(t, all, 2)
+	assert.Len(t, all, 3)
 
 	byHash, err := c.GetTorrentsBy(downloader.TorrentFilter{Hashes: []string{"h2"}})
 	require.NoError(t, err)
@@ -1852,6 +1853,12 @@ func TestTrGetTorrentsByAndGetTorrent(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, byState, 1)
 
+	paused := downloader.TorrentPaused
+	byPaused, err := c.GetTorrentsBy(downloader.TorrentFilter{State: &paused})
+	require.NoError(t, err)
+	require.Len(t, byPaused, 1)
+	assert.Equal(t, "h3", byPaused[0].InfoHash, "stopped (0) torrents map to paused")
+
 	tor, err := c.GetTorrent("h1")
 	require.NoError(t, err)
 	assert.Equal(t, "h1", tor.InfoHash)
@@ -60,6 +60,8 @@ func newRecordingTransmissionServer(t *testing.T) (*httptest.Server, *sync.Mutex
 				TorrentAdded: &torrentInfo{ID: 42, Name: "test", HashString: "hash42"},
 			}
 			resp.Arguments, _ = json.Marshal(a)
+		case "torrent-get":
+			resp.Arguments = json.RawMessage(`{"torrents":[]}`)
 		case "torrent-set", "torrent-start":
 			// no arguments needed in response
 		}
@@ -37,6 +37,7 @@ func (s *Server) syncDownloaderManager() {
 			IsDefault: ds.IsDefault,
 			Enabled:   ds.Enabled,
 			AutoStart: ds.AutoStart,
+			DryRun:    ds.DryRun,
 		})
 	}
 
@@ -46,13 +47,14 @@ func (s *Server) syncDownloaderManager() {
 // DownloaderRequest 下载器请求结构
 type DownloaderRequest struct {
 	Name        string `json:"name"`
-	Type        string `json:"type"` // qbittorrent, transmission
+	Type        string `json:"type"` // qbittorrent, transmission, deluge, aria2
 	URL         string `json:"url"`
 	Username    string `json:"username"`
 	Password    string `json:"password"`
 	IsDefault   bool   `json:"is_default"`
 	Enabled     bool   `json:"enabled"`
 	AutoStart   bool   `json:"auto_start"` // 推送种子后自动开始下载
+	DryRun      bool   `json:"dry_run"`    // 演练模式：只记录将要添加的种子，不实际提交
 	ExtraConfig string `json:"extra_config,omitempty"`
 }
 
@@ -66,6 +68,7 @@ type DownloaderResponse struct {
 	IsDefault   bool   `json:"is_default"`
 	Enabled     bool   `json:"enabled"`
 	AutoStart   bool   `json:"auto_start"` // 推送种子后自动开始下载
+	DryRun      bool   `json:"dry_run"`    // 演练模式：只记录将要添加的种子，不实际提交
 	ExtraConfig string `json:"extra_config,omitempty"`
 }
 
@@ -151,6 +154,7 @@ func (s *Server) listDownloaders(w http.ResponseWriter, r *http.Request) {
 			IsDefault:   dl.IsDefault,
 			Enabled:     dl.Enabled,
 			AutoStart:   dl.AutoStart,
+			DryRun:      dl.DryRun,
 			ExtraConfig: dl.ExtraConfig,
 		}
 	}
@@ -175,7 +179,7 @@ func (s *Server) createDownloader(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "类型不能为空", http.StatusBadRequest)
 		return
 	}
-	if req.Type != "qbittorrent" && req.Type != "transmission" {
+	if req.Type != "qbittorrent" && req.Type != "transmission" && req.Type != "deluge" && req.Type != "aria2" {
 		http.Error(w, "不支持的下载器类型", http.StatusBadRequest)
 		return
 	}
@@ -211,6 +215,11 @@ func (s *Server) createDownloader(w http.ResponseWriter, r *http.Request) {
 		db.Model(&models.DownloaderSetting{}).Where("is_default = ?", true).Update("is_default", false)
 	}
 
+	if req.DryRun && !supportsDryRun(req.Type) {
+		http.Error(w, "该下载器类型不支持演练模式", http.StatusBadRequest)
+		return
+	}
+
 	downloader := models.DownloaderSetting{
 		Name:        req.Name,
 		Type:        req.Type,
@@ -220,6 +229,7 @@ func (s *Server) createDownloader(w http.ResponseWriter, r *http.Request) {
 		IsDefault:   req.IsDefault,
 		Enabled:     req.Enabled,
 		AutoStart:   req.AutoStart,
+		DryRun:      req.DryRun,
 		ExtraConfig: req.ExtraConfig,
 	}
 
@@ -241,6 +251,7 @@ func (s *Server) createDownloader(w http.ResponseWriter, r *http.Request) {
 		IsDefault:   downloader.IsDefault,
 		Enabled:     downloader.Enabled,
 		AutoStart:   downloader.AutoStart,
+		DryRun:      downloader.DryRun,
 		ExtraConfig: downloader.ExtraConfig,
 	})
 }
@@ -263,6 +274,7 @@ func (s *Server) getDownloader(w http.ResponseWriter, r *http.Request, id uint)
 		IsDefault:   downloader.IsDefault,
 		Enabled:     downloader.Enabled,
 		AutoStart:   downloader.AutoStart,
+		DryRun:      downloader.DryRun,
 		ExtraConfig: downloader.ExtraConfig,
 	})
 }
@@ -324,6 +336,11 @@ func (s *Server) updateDownloader(w http.ResponseWriter, r *http.Request, id uin
 	downloader.IsDefault = req.IsDefault
 	downloader.Enabled = req.Enabled
 	downloader.AutoStart = req.AutoStart
+	if req.DryRun && !supportsDryRun(downloader.Type) {
+		http.Error(w, "该下载器类型不支持演练模式", http.StatusBadRequest)
+		return
+	}
+	downloader.DryRun = req.DryRun
 	downloader.ExtraConfig = req.ExtraConfig
 
 	if err := db.Save(&downloader).Error; err != nil {
@@ -344,10 +361,16 @@ func (s *Server) updateDownloader(w http.ResponseWriter, r *http.Request, id uin
 		IsDefault:   downloader.IsDefault,
 		Enabled:     downloader.Enabled,
 		AutoStart:   downloader.AutoStart,
+		DryRun:      downloader.DryRun,
 		ExtraConfig: downloader.ExtraConfig,
 	})
 }
 
+// supportsDryRun 判断下载器类型是否支持演练模式，不支持时拒绝开启以免误以为不会实际添加
+func supportsDryRun(dlType string) bool {
+	return downloader.SupportsDryRun(downloader.DownloaderType(dlType))
+}
+
 func normalizeDownloaderURL(rawURL string) (string, error) {
 	value := strings.TrimSpace(rawURL)
 	if value == "" {
@@ -446,6 +469,7 @@ func (s *Server) downloaderHealthCheck(w http.ResponseWriter, r *http.Request, i
 	}
 
 	config := downloader.NewGenericConfig(dlType, dlSetting.URL, dlSetting.Username, dlSetting.Password, dlSetting.AutoStart)
+	config.DryRun = dlSetting.DryRun
 	dl, err := dlMgr.CreateFromConfig(config, dlSetting.Name)
 	if err != nil {
 		global.GetSlogger().Errorf("[Downloader] 健康检查失败: name=%s, type=%s, url=%s, error=%v", dlSetting.Name, dlSetting.Type, dlSetting.URL, err)
@@ -516,6 +540,7 @@ func (s *Server) setDefaultDownloader(w http.ResponseWriter, r *http.Request, id
 		IsDefault:   downloader.IsDefault,
 		Enabled:     downloader.Enabled,
 		AutoStart:   downloader.AutoStart,
+		DryRun:      downloader.DryRun,
 		ExtraConfig: downloader.ExtraConfig,
 	})
 }
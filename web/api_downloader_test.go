@@ -61,7 +61,7 @@ func TestCreateDownloader_Branches(t *testing.T) {
 	})
 
 	t.Run("bad type", func(t *testing.T) {
-		body, _ := json.Marshal(DownloaderRequest{Name: "n", Type: "aria2", URL: "http://x"})
+		body, _ := json.Marshal(DownloaderRequest{Name: "n", Type: "utorrent", URL: "http://x"})
 		w := httptest.NewRecorder()
 		req := httptest.NewRequest(http.MethodPost, "/api/downloaders", bytes.NewReader(body))
 		server.createDownloader(w, req)
@@ -1764,3 +1764,68 @@ func TestDownloaderAutoStartDefault(t *testing.T) {
 		t.Error("expected auto_start in DB to default to false")
 	}
 }
+
+func TestDownloaderDryRun(t *testing.T) {
+	server, db := setupTestServer(t)
+
+	body, _ := json.Marshal(DownloaderRequest{
+		Name: "dry-run-dl", Type: "qbittorrent", URL: "http://localhost:8080",
+		IsDefault: true, Enabled: true, DryRun: true,
+	})
+	w := httptest.NewRecorder()
+	server.createDownloader(w, httptest.NewRequest(http.MethodPost, "/api/downloaders", bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var resp DownloaderResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.True(t, resp.DryRun)
+
+	var dl models.DownloaderSetting
+	require.NoError(t, db.Where("name = ?", "dry-run-dl").First(&dl).Error)
+	assert.True(t, dl.DryRun)
+
+	body, _ = json.Marshal(DownloaderRequest{
+		Name: "dry-run-dl", Type: "qbittorrent", URL: "http://localhost:8080",
+		IsDefault: true, Enabled: true, DryRun: false,
+	})
+	w = httptest.NewRecorder()
+	server.updateDownloader(w, httptest.NewRequest(http.MethodPut, "/api/downloaders/1", bytes.NewReader(body)), dl.ID)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	require.NoError(t, db.First(&dl, dl.ID).Error)
+	assert.False(t, dl.DryRun)
+}
+
+func TestDownloaderDryRun_UnsupportedType(t *testing.T) {
+	server, db := setupTestServer(t)
+
+	for _, dlType := range []string{"deluge", "aria2"} {
+		body, _ := json.Marshal(DownloaderRequest{
+			Name: "dry-run-" + dlType, Type: dlType, URL: "http://localhost:8112",
+			Enabled: true, DryRun: true,
+		})
+		w := httptest.NewRecorder()
+		server.createDownloader(w, httptest.NewRequest(http.MethodPost, "/api/downloaders", bytes.NewReader(body)))
+		assert.Equal(t, http.StatusBadRequest, w.Code, dlType)
+	}
+
+	body, _ := json.Marshal(DownloaderRequest{
+		Name: "plain-deluge", Type: "deluge", URL: "http://localhost:8112", Enabled: true,
+	})
+	w := httptest.NewRecorder()
+	server.createDownloader(w, httptest.NewRequest(http.MethodPost, "/api/downloaders", bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var dl models.DownloaderSetting
+	require.NoError(t, db.Where("name = ?", "plain-deluge").First(&dl).Error)
+
+	body, _ = json.Marshal(DownloaderRequest{
+		Name: "plain-deluge", URL: "http://localhost:8112", IsDefault: dl.IsDefault, Enabled: true, DryRun: true,
+	})
+	w = httptest.NewRecorder()
+	server.updateDownloader(w, httptest.NewRequest(http.MethodPut, "/api/downloaders/1", bytes.NewReader(body)), dl.ID)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	require.NoError(t, db.First(&dl, dl.ID).Error)
+	assert.False(t, dl.DryRun)
+}
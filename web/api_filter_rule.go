@@ -20,52 +20,60 @@ import (
 
 // FilterRuleRequest 过滤规则请求结构
 type FilterRuleRequest struct {
-	Name        string `json:"name"`
-	Pattern     string `json:"pattern"`
-	PatternType string `json:"pattern_type"` // keyword, wildcard, regex
-	MatchField  string `json:"match_field"`  // title, tag, both
-	RequireFree bool   `json:"require_free"`
-	MinSizeGB   int    `json:"min_size_gb"`
-	MaxSizeGB   int    `json:"max_size_gb"`
-	Enabled     bool   `json:"enabled"`
-	SiteID      *uint  `json:"site_id"`
-	RSSID       *uint  `json:"rss_id"`
-	Priority    int    `json:"priority"`
+	Name            string `json:"name"`
+	Pattern         string `json:"pattern"`
+	PatternType     string `json:"pattern_type"` // keyword, wildcard, regex, glob
+	MatchField      string `json:"match_field"`  // title, tag, subtitle, both
+	RequireFree     bool   `json:"require_free"`
+	MinSizeGB       int    `json:"min_size_gb"`
+	MaxSizeGB       int    `json:"max_size_gb"`
+	MinSizeBytes    *int64 `json:"min_size_bytes,omitempty"` // 精确到字节的下限，省略时更新保留原值
+	MaxSizeBytes    *int64 `json:"max_size_bytes,omitempty"` // 精确到字节的上限，省略时更新保留原值
+	RequireOfficial bool   `json:"require_official"`         // 仅匹配官方/原创种子
+	Enabled         bool   `json:"enabled"`
+	SiteID          *uint  `json:"site_id"`
+	RSSID           *uint  `json:"rss_id"`
+	Priority        int    `json:"priority"`
 }
 
 // FilterRuleResponse 过滤规则响应结构
 type FilterRuleResponse struct {
-	ID          uint   `json:"id"`
-	Name        string `json:"name"`
-	Pattern     string `json:"pattern"`
-	PatternType string `json:"pattern_type"`
-	MatchField  string `json:"match_field"`
-	RequireFree bool   `json:"require_free"`
-	MinSizeGB   int    `json:"min_size_gb"`
-	MaxSizeGB   int    `json:"max_size_gb"`
-	Enabled     bool   `json:"enabled"`
-	SiteID      *uint  `json:"site_id"`
-	RSSID       *uint  `json:"rss_id"`
-	Priority    int    `json:"priority"`
-	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"updated_at"`
+	ID              uint   `json:"id"`
+	Name            string `json:"name"`
+	Pattern         string `json:"pattern"`
+	PatternType     string `json:"pattern_type"`
+	MatchField      string `json:"match_field"`
+	RequireFree     bool   `json:"require_free"`
+	MinSizeGB       int    `json:"min_size_gb"`
+	MaxSizeGB       int    `json:"max_size_gb"`
+	MinSizeBytes    int64  `json:"min_size_bytes"`
+	MaxSizeBytes    int64  `json:"max_size_bytes"`
+	RequireOfficial bool   `json:"require_official"` // 仅匹配官方/原创种子
+	Enabled         bool   `json:"enabled"`
+	SiteID          *uint  `json:"site_id"`
+	RSSID           *uint  `json:"rss_id"`
+	Priority        int    `json:"priority"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
 }
 
 // FilterRuleTestRequest 过滤规则测试请求
 type FilterRuleTestRequest struct {
-	Pattern     string  `json:"pattern"`
-	PatternType string  `json:"pattern_type"`
-	MatchField  string  `json:"match_field"`  // title, tag, both
-	RequireFree bool    `json:"require_free"` // 是否仅匹配免费种子
-	MinSizeGB   int     `json:"min_size_gb"`
-	MaxSizeGB   int     `json:"max_size_gb"`
-	TestSizeGB  float64 `json:"test_size_gb"` // 模拟种子大小，用于完整决策测试
-	TestIsFree  *bool   `json:"test_is_free"` // 覆盖 is_free 状态（nil=使用真实值）
-	GlobalSize  int     `json:"global_size"`  // 模拟全局大小上限
-	FilterMode  string  `json:"filter_mode"`  // 模拟 FilterMode
-	SiteID      *uint   `json:"site_id"`
-	RSSID       *uint   `json:"rss_id"`
-	Limit       int     `json:"limit"` // 最多返回多少条匹配结果
+	Pattern      string  `json:"pattern"`
+	PatternType  string  `json:"pattern_type"`
+	MatchField   string  `json:"match_field"`  // title, tag, subtitle, both
+	RequireFree  bool    `json:"require_free"` // 是否仅匹配免费种子
+	MinSizeGB    int     `json:"min_size_gb"`
+	MaxSizeGB    int     `json:"max_size_gb"`
+	MinSizeBytes int64   `json:"min_size_bytes"`
+	MaxSizeBytes int64   `json:"max_size_bytes"`
+	TestSizeGB   float64 `json:"test_size_gb"` // 模拟种子大小，用于完整决策测试
+	TestIsFree   *bool   `json:"test_is_free"` // 覆盖 is_free 状态（nil=使用真实值）
+	GlobalSize   int     `json:"global_size"`  // 模拟全局大小上限
+	FilterMode   string  `json:"filter_mode"`  // 模拟 FilterMode
+	SiteID       *uint   `json:"site_id"`
+	RSSID        *uint   `json:"rss_id"`
+	Limit        int     `json:"limit"` // 最多返回多少条匹配结果
 }
 
 // FilterRuleTestMatch 单个匹配结果
@@ -171,7 +179,7 @@ func (s *Server) createFilterRule(w http.ResponseWriter, r *http.Request) {
 	if patternType == "" {
 		patternType = models.PatternKeyword
 	}
-	if patternType != models.PatternKeyword && patternType != models.PatternWildcard && patternType != models.PatternRegex {
+	if patternType != models.PatternKeyword && patternType != models.PatternWildcard && patternType != models.PatternRegex && patternType != models.PatternGlob {
 		http.Error(w, "不支持的模式类型", http.StatusBadRequest)
 		return
 	}
@@ -206,23 +214,26 @@ func (s *Server) createFilterRule(w http.ResponseWriter, r *http.Request) {
 	if matchField == "" {
 		matchField = models.MatchFieldBoth
 	}
-	if matchField != models.MatchFieldTitle && matchField != models.MatchFieldTag && matchField != models.MatchFieldBoth {
+	if !matchField.IsValid() {
 		http.Error(w, "不支持的匹配字段类型", http.StatusBadRequest)
 		return
 	}
 
 	rule := &models.FilterRule{
-		Name:        req.Name,
-		Pattern:     req.Pattern,
-		PatternType: patternType,
-		MatchField:  matchField,
-		RequireFree: req.RequireFree,
-		MinSizeGB:   sanitizeRuleSize(req.MinSizeGB),
-		MaxSizeGB:   sanitizeRuleSize(req.MaxSizeGB),
-		Enabled:     req.Enabled,
-		SiteID:      req.SiteID,
-		RSSID:       req.RSSID,
-		Priority:    priority,
+		Name:            req.Name,
+		Pattern:         req.Pattern,
+		PatternType:     patternType,
+		MatchField:      matchField,
+		RequireFree:     req.RequireFree,
+		MinSizeGB:       sanitizeRuleSize(req.MinSizeGB),
+		MaxSizeGB:       sanitizeRuleSize(req.MaxSizeGB),
+		MinSizeBytes:    optionalRuleSizeBytes(req.MinSizeBytes),
+		MaxSizeBytes:    optionalRuleSizeBytes(req.MaxSizeBytes),
+		RequireOfficial: req.RequireOfficial,
+		Enabled:         req.Enabled,
+		SiteID:          req.SiteID,
+		RSSID:           req.RSSID,
+		Priority:        priority,
 	}
 
 	if err := filterDB.Create(rule); err != nil {
@@ -304,7 +315,7 @@ func (s *Server) updateFilterRule(w http.ResponseWriter, r *http.Request, id uin
 	// 更新匹配字段
 	if req.MatchField != "" {
 		matchField := models.MatchField(req.MatchField)
-		if matchField != models.MatchFieldTitle && matchField != models.MatchFieldTag && matchField != models.MatchFieldBoth {
+		if !matchField.IsValid() {
 			http.Error(w, "不支持的匹配字段类型", http.StatusBadRequest)
 			return
 		}
@@ -314,6 +325,13 @@ func (s *Server) updateFilterRule(w http.ResponseWriter, r *http.Request, id uin
 	rule.RequireFree = req.RequireFree
 	rule.MinSizeGB = sanitizeRuleSize(req.MinSizeGB)
 	rule.MaxSizeGB = sanitizeRuleSize(req.MaxSizeGB)
+	if req.MinSizeBytes != nil {
+		rule.MinSizeBytes = sanitizeRuleSizeBytes(*req.MinSizeBytes)
+	}
+	if req.MaxSizeBytes != nil {
+		rule.MaxSizeBytes = sanitizeRuleSizeBytes(*req.MaxSizeBytes)
+	}
+	rule.RequireOfficial = req.RequireOfficial
 	rule.Enabled = req.Enabled
 	rule.SiteID = req.SiteID
 	rule.RSSID = req.RSSID
@@ -437,13 +455,15 @@ func (s *Server) testFilterRule(w http.ResponseWriter, r *http.Request) {
 	global.GetSlogger().Debugf("[FilterRuleTest] 开始匹配种子，总数: %d", len(torrents))
 
 	testRule := &models.FilterRule{
-		Pattern:     req.Pattern,
-		PatternType: models.PatternType(patternType),
-		MatchField:  matchField,
-		RequireFree: req.RequireFree,
-		MinSizeGB:   sanitizeRuleSize(req.MinSizeGB),
-		MaxSizeGB:   sanitizeRuleSize(req.MaxSizeGB),
-		Enabled:     true,
+		Pattern:      req.Pattern,
+		PatternType:  models.PatternType(patternType),
+		MatchField:   matchField,
+		RequireFree:  req.RequireFree,
+		MinSizeGB:    sanitizeRuleSize(req.MinSizeGB),
+		MaxSizeGB:    sanitizeRuleSize(req.MaxSizeGB),
+		MinSizeBytes: sanitizeRuleSizeBytes(req.MinSizeBytes),
+		MaxSizeBytes: sanitizeRuleSizeBytes(req.MaxSizeBytes),
+		Enabled:      true,
 	}
 	mode := models.NormalizeFilterMode(models.FilterMode(req.FilterMode))
 
@@ -456,14 +476,16 @@ func (s *Server) testFilterRule(w http.ResponseWriter, r *http.Request) {
 
 		// 用当前种子数据评估完整决策（模拟 filter.Decide 流程）
 		torrentSizeGB := bytesToGB(t.TorrentSize)
+		torrentSizeBytes := t.TorrentSize
 		if req.TestSizeGB > 0 {
 			torrentSizeGB = req.TestSizeGB
+			torrentSizeBytes = int64(req.TestSizeGB * 1024 * 1024 * 1024)
 		}
 		isFree := t.IsFree
 		if req.TestIsFree != nil {
 			isFree = *req.TestIsFree
 		}
-		decision := evaluateTestDecision(testRule, mode, req.GlobalSize, torrentSizeGB, isFree)
+		decision := evaluateTestDecision(testRule, mode, req.GlobalSize, torrentSizeGB, torrentSizeBytes, isFree)
 
 		match := FilterRuleTestMatch{
 			Title:    t.Title,
@@ -623,7 +645,8 @@ func matchesField(matcher filter.PatternMatcher, matchField models.MatchField, t
 	switch matchField {
 	case models.MatchFieldTitle:
 		return matcher.Match(title)
-	case models.MatchFieldTag:
+	case models.MatchFieldTag, models.MatchFieldSubtitle:
+		// 测试数据中的标签即种子副标题
 		return matcher.Match(tag)
 	case models.MatchFieldBoth:
 		fallthrough
@@ -656,20 +679,23 @@ func toFilterRuleResponse(rule models.FilterRule) FilterRuleResponse {
 		matchField = string(models.MatchFieldBoth)
 	}
 	return FilterRuleResponse{
-		ID:          rule.ID,
-		Name:        rule.Name,
-		Pattern:     rule.Pattern,
-		PatternType: string(rule.PatternType),
-		MatchField:  matchField,
-		RequireFree: rule.RequireFree,
-		MinSizeGB:   rule.MinSizeGB,
-		MaxSizeGB:   rule.MaxSizeGB,
-		Enabled:     rule.Enabled,
-		SiteID:      rule.SiteID,
-		RSSID:       rule.RSSID,
-		Priority:    rule.Priority,
-		CreatedAt:   rule.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:   rule.UpdatedAt.Format("2006-01-02 15:04:05"),
+		ID:              rule.ID,
+		Name:            rule.Name,
+		Pattern:         rule.Pattern,
+		PatternType:     string(rule.PatternType),
+		MatchField:      matchField,
+		RequireFree:     rule.RequireFree,
+		MinSizeGB:       rule.MinSizeGB,
+		MaxSizeGB:       rule.MaxSizeGB,
+		MinSizeBytes:    rule.MinSizeBytes,
+		MaxSizeBytes:    rule.MaxSizeBytes,
+		RequireOfficial: rule.RequireOfficial,
+		Enabled:         rule.Enabled,
+		SiteID:          rule.SiteID,
+		RSSID:           rule.RSSID,
+		Priority:        rule.Priority,
+		CreatedAt:       rule.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       rule.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
 }
 
@@ -681,13 +707,29 @@ func sanitizeRuleSize(v int) int {
 	return v
 }
 
+// sanitizeRuleSizeBytes clamps negative byte bounds to 0 (meaning "no bound").
+func sanitizeRuleSizeBytes(v int64) int64 {
+	if v < 0 {
+		return 0
+	}
+	return v
+}
+
+// optionalRuleSizeBytes sanitizes an optional byte bound; nil means "no bound".
+func optionalRuleSizeBytes(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return sanitizeRuleSizeBytes(*v)
+}
+
 // evaluateTestDecision mirrors filter.Decide semantics for the rule-tester UI.
 // It returns the same Decision shape but operates on a single in-memory rule
 // candidate rather than the DB-backed rule cache.
 // Plan A: since the tester is always testing with a rule present, the "rules
 // associated" flag is conceptually true — non-matching conditions do NOT fall
 // back to the free channel under auto_free.
-func evaluateTestDecision(rule *models.FilterRule, mode models.FilterMode, globalSizeGB int, sizeGB float64, isFree bool) filter.Decision {
+func evaluateTestDecision(rule *models.FilterRule, mode models.FilterMode, globalSizeGB int, sizeGB float64, sizeBytes int64, isFree bool) filter.Decision {
 	if globalSizeGB > 0 && sizeGB > float64(globalSizeGB) {
 		return filter.Decision{ShouldDownload: false, Source: filter.SourceNone, Reason: "超出全局大小限制"}
 	}
@@ -700,7 +742,7 @@ func evaluateTestDecision(rule *models.FilterRule, mode models.FilterMode, globa
 	if rule.RequireFree && !isFree {
 		return filter.Decision{ShouldDownload: false, MatchedRule: rule, Source: filter.SourceNone, Reason: "匹配规则要求免费，但种子非免费"}
 	}
-	if !rule.MatchesSize(sizeGB) {
+	if !rule.MatchesSize(sizeGB) || !rule.MatchesSizeBytes(sizeBytes) {
 		return filter.Decision{ShouldDownload: false, MatchedRule: rule, Source: filter.SourceNone, Reason: "匹配规则但大小不符合规则约束"}
 	}
 	return filter.Decision{ShouldDownload: true, MatchedRule: rule, Source: filter.SourceFilterRule}
@@ -283,6 +283,45 @@ func TestUpdateFilterRule_MinMaxSize(t *testing.T) {
 	require.Equal(t, http.StatusOK, w.Code)
 }
 
+func TestUpdateFilterRule_SizeBytesPreservedWhenOmitted(t *testing.T) {
+	server, cleanup := setupFilterRuleTestServer(t)
+	defer cleanup()
+
+	minBytes, maxBytes := int64(40<<30), int64(120<<30)
+	body, _ := json.Marshal(FilterRuleRequest{
+		Name: "BytesRule", Pattern: "remux", Enabled: true,
+		MinSizeBytes: &minBytes, MaxSizeBytes: &maxBytes,
+	})
+	w := httptest.NewRecorder()
+	server.createFilterRule(w, httptest.NewRequest(http.MethodPost, "/api/filter-rules", bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code)
+	var created FilterRuleResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	assert.Equal(t, minBytes, created.MinSizeBytes)
+
+	update := func(req FilterRuleRequest) FilterRuleResponse {
+		t.Helper()
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		server.updateFilterRule(w, httptest.NewRequest(http.MethodPut, "/api/filter-rules/1", bytes.NewReader(body)), created.ID)
+		require.Equal(t, http.StatusOK, w.Code)
+		var resp FilterRuleResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return resp
+	}
+
+	// An edit that does not send the byte bounds keeps them
+	resp := update(FilterRuleRequest{Name: "BytesRule", Pattern: "remux", Enabled: false})
+	assert.Equal(t, minBytes, resp.MinSizeBytes)
+	assert.Equal(t, maxBytes, resp.MaxSizeBytes)
+
+	// Sending zero clears a bound
+	zero := int64(0)
+	resp = update(FilterRuleRequest{Name: "BytesRule", Pattern: "remux", Enabled: true, MaxSizeBytes: &zero})
+	assert.Equal(t, minBytes, resp.MinSizeBytes)
+	assert.Zero(t, resp.MaxSizeBytes)
+}
+
 // ==== merged from api_filter_rule_cov_test.go ====
 func TestApiFilterRuleDetail_Dispatch(t *testing.T) {
 	server, cleanup := setupFilterRuleTestServer(t)
@@ -409,34 +448,44 @@ func TestEvaluateTestDecision(t *testing.T) {
 	rule := &models.FilterRule{Pattern: "x", RequireFree: false, Enabled: true}
 
 	t.Run("global size exceeded", func(t *testing.T) {
-		d := evaluateTestDecision(rule, models.FilterModeAutoFree, 10, 20, true)
+		d := evaluateTestDecision(rule, models.FilterModeAutoFree, 10, 20, 20<<30, true)
 		assert.False(t, d.ShouldDownload)
 		assert.Equal(t, filter.SourceNone, d.Source)
 	})
 
 	t.Run("free only + free", func(t *testing.T) {
-		d := evaluateTestDecision(rule, models.FilterModeFreeOnly, 0, 5, true)
+		d := evaluateTestDecision(rule, models.FilterModeFreeOnly, 0, 5, 5<<30, true)
 		assert.True(t, d.ShouldDownload)
 		assert.Equal(t, filter.SourceFreeDownload, d.Source)
 	})
 
 	t.Run("free only + not free", func(t *testing.T) {
-		d := evaluateTestDecision(rule, models.FilterModeFreeOnly, 0, 5, false)
+		d := evaluateTestDecision(rule, models.FilterModeFreeOnly, 0, 5, 5<<30, false)
 		assert.False(t, d.ShouldDownload)
 	})
 
 	t.Run("require free but not free", func(t *testing.T) {
 		freeRule := &models.FilterRule{Pattern: "x", RequireFree: true, Enabled: true}
-		d := evaluateTestDecision(freeRule, models.FilterModeAutoFree, 0, 5, false)
+		d := evaluateTestDecision(freeRule, models.FilterModeAutoFree, 0, 5, 5<<30, false)
 		assert.False(t, d.ShouldDownload)
 		assert.Equal(t, freeRule, d.MatchedRule)
 	})
 
 	t.Run("matched and downloadable", func(t *testing.T) {
-		d := evaluateTestDecision(rule, models.FilterModeAutoFree, 0, 5, true)
+		d := evaluateTestDecision(rule, models.FilterModeAutoFree, 0, 5, 5<<30, true)
 		assert.True(t, d.ShouldDownload)
 		assert.Equal(t, filter.SourceFilterRule, d.Source)
 	})
+
+	t.Run("outside byte bounds", func(t *testing.T) {
+		bytesRule := &models.FilterRule{Pattern: "x", MinSizeBytes: 40 << 30, MaxSizeBytes: 120 << 30, Enabled: true}
+		d := evaluateTestDecision(bytesRule, models.FilterModeAutoFree, 0, 30, 30<<30, true)
+		assert.False(t, d.ShouldDownload)
+		assert.Equal(t, bytesRule, d.MatchedRule)
+
+		d = evaluateTestDecision(bytesRule, models.FilterModeAutoFree, 0, 40, 40<<30, true)
+		assert.True(t, d.ShouldDownload)
+	})
 }
 
 func TestTestFilterRuleWithRSS_NotFound(t *testing.T) {
@@ -30,6 +30,7 @@ type MultiSiteSearchRequest struct {
 	FreeOnly     bool                        `json:"freeOnly,omitempty"`
 	Sites        []string                    `json:"sites,omitempty"`
 	MinSeeders   int                         `json:"minSeeders,omitempty"`
+	MinSnatched  int                         `json:"minSnatched,omitempty"` // 最少完成数
 	MaxSizeBytes int64                       `json:"maxSizeBytes,omitempty"`
 	MinSizeBytes int64                       `json:"minSizeBytes,omitempty"`
 	Page         int                         `json:"page,omitempty"`
@@ -128,13 +129,14 @@ func (s *Server) apiMultiSiteSearch(w http.ResponseWriter, r *http.Request) {
 	// Build query
 	query := v2.MultiSiteSearchQuery{
 		SearchQuery: v2.SearchQuery{
-			Keyword:   req.Keyword,
-			Category:  req.Category,
-			FreeOnly:  req.FreeOnly,
-			Page:      req.Page,
-			PageSize:  req.PageSize,
-			SortBy:    req.SortBy,
-			OrderDesc: req.OrderDesc,
+			Keyword:     req.Keyword,
+			Category:    req.Category,
+			FreeOnly:    req.FreeOnly,
+			Page:        req.Page,
+			PageSize:    req.PageSize,
+			SortBy:      req.SortBy,
+			OrderDesc:   req.OrderDesc,
+			MinSnatched: req.MinSnatched,
 		},
 		Sites:        req.Sites,
 		Timeout:      timeout,
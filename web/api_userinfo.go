@@ -33,6 +33,7 @@ type UserInfoResponse struct {
 	BonusPerHour        float64 `json:"bonusPerHour,omitempty"`
 	SeedingBonus        float64 `json:"seedingBonus,omitempty"`
 	SeedingBonusPerHour float64 `json:"seedingBonusPerHour,omitempty"`
+	BonusExchangeRate   float64 `json:"bonusExchangeRate,omitempty"`
 	UnreadMessageCount  int     `json:"unreadMessageCount,omitempty"`
 	TotalMessageCount   int     `json:"totalMessageCount,omitempty"`
 	SeederCount         int     `json:"seederCount,omitempty"`
@@ -468,6 +469,7 @@ func toUserInfoResponse(info v2.UserInfo) UserInfoResponse {
 		BonusPerHour:        info.BonusPerHour,
 		SeedingBonus:        info.SeedingBonus,
 		SeedingBonusPerHour: info.SeedingBonusPerHour,
+		BonusExchangeRate:   info.BonusExchangeRate,
 		UnreadMessageCount:  info.UnreadMessageCount,
 		TotalMessageCount:   info.TotalMessageCount,
 		SeederCount:         info.SeederCount,
@@ -120,6 +120,7 @@ func (f *fakeDownloader) GetSpeedLimit() (downloader.SpeedLimit, error) {
 func (f *fakeDownloader) SetSpeedLimit(_ downloader.SpeedLimit) error { return nil }
 func (f *fakeDownloader) GetClientPaths() ([]string, error)           { return nil, nil }
 func (f *fakeDownloader) GetClientLabels() ([]string, error)          { return nil, nil }
+func (f *fakeDownloader) Capabilities() downloader.Capabilities       { return downloader.Capabilities{} }
 func (f *fakeDownloader) GetType() downloader.DownloaderType          { return f.dlType }
 func (f *fakeDownloader) GetName() string                             { return f.name }
 func (f *fakeDownloader) IsHealthy() bool                             { return true }
@@ -296,13 +296,14 @@ export const passwordApi = {
 export interface DownloaderSetting {
   id?: number;
   name: string;
-  type: string; // qbittorrent, transmission
+  type: string; // qbittorrent, transmission, deluge, aria2
   url: string;
   username: string;
   password?: string;
   is_default: boolean;
   enabled: boolean;
   auto_start?: boolean;
+  dry_run?: boolean;
   extra_config?: string;
 }
 
@@ -422,6 +423,9 @@ export interface FilterRule {
   require_free: boolean;
   min_size_gb?: number;
   max_size_gb?: number;
+  min_size_bytes?: number;
+  max_size_bytes?: number;
+  require_official?: boolean;
   enabled: boolean;
   site_id?: number;
   rss_id?: number;
@@ -438,6 +442,8 @@ export interface FilterRuleTestRequest {
   require_free?: boolean;
   min_size_gb?: number;
   max_size_gb?: number;
+  min_size_bytes?: number;
+  max_size_bytes?: number;
   test_size_gb?: number;
   test_is_free?: boolean | null;
   global_size?: number;
@@ -542,6 +548,7 @@ export interface UserInfoResponse {
   bonusPerHour?: number; // 时魔（每小时魔力值）
   seedingBonus?: number; // 做种积分
   seedingBonusPerHour?: number; // 每小时做种积分
+  bonusExchangeRate?: number; // 积分商城每 GiB 上传量所需魔力值
   unreadMessageCount?: number; // 未读消息数
   totalMessageCount?: number; // 消息总数
   seederCount?: number; // 做种数量（来自 peer statistics）